@@ -0,0 +1,373 @@
+// Package config loads the configuration of the moddoc server from
+// its defaults, a TOML file, environment variables and command-line
+// flags, each source overriding the previous ones.
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config is the configuration of moddoc. Every setting has a key in
+// the file, made of the toml tags of its section and field such as
+// server.listen, a flag of the same name, an environment variable
+// given by its env tag and a default value given by its default tag.
+type Config struct {
+	Upstream   Upstream   `toml:"upstream"`
+	Auth       Auth       `toml:"auth"`
+	Cache      Cache      `toml:"cache"`
+	Server     Server     `toml:"server"`
+	TLS        TLS        `toml:"tls"`
+	Theme      Theme      `toml:"theme"`
+	Index      Index      `toml:"index"`
+	ImportedBy ImportedBy `toml:"imported_by"`
+	Checksum   Checksum   `toml:"checksum"`
+	Limits     Limits     `toml:"limits"`
+	License    License    `toml:"license"`
+	Vuln       Vuln       `toml:"vuln"`
+	Metrics    Metrics    `toml:"metrics"`
+	Log        Log        `toml:"log"`
+}
+
+// Upstream configures the GOPROXY that moddoc documents.
+type Upstream struct {
+	GoProxy string `toml:"goproxy" env:"GOPROXY" usage:"the GOPROXY to document, only its first URL is used"`
+}
+
+// Auth configures the credentials sent to the GOPROXY.
+type Auth struct {
+	Token    string   `toml:"token" env:"MODDOC_AUTH_TOKEN" usage:"a bearer token to send to the GOPROXY"`
+	Username string   `toml:"username" env:"MODDOC_AUTH_USERNAME" usage:"a user name to send to the GOPROXY with basic authentication"`
+	Password string   `toml:"password" env:"MODDOC_AUTH_PASSWORD" usage:"the password of the user name"`
+	Hosts    []string `toml:"hosts" env:"MODDOC_AUTH_HOSTS" usage:"the hosts to send the credentials to, the GOPROXY host if empty"`
+}
+
+// Cache configures the documentation kept in memory.
+type Cache struct {
+	Size int `toml:"size" env:"MODDOC_CACHE_SIZE" default:"100" usage:"the number of package pages kept in memory, 0 disables the cache"`
+}
+
+// Server configures how moddoc is served.
+type Server struct {
+	Listen          string `toml:"listen" env:"MODDOC_LISTEN" default:":3001" usage:"the address to listen on"`
+	BasePath        string `toml:"base_path" env:"MODDOC_BASE_PATH" usage:"the URL path prefix to serve moddoc under, such as /godoc"`
+	ForwardedPrefix bool   `toml:"forwarded_prefix" env:"MODDOC_FORWARDED_PREFIX" usage:"trust the X-Forwarded-Prefix header of a reverse proxy"`
+	Socket          string `toml:"socket" env:"MODDOC_SOCKET" usage:"a unix socket to listen on instead of the listen address"`
+
+	ReadTimeout     time.Duration `toml:"read_timeout" env:"MODDOC_READ_TIMEOUT" default:"30s" usage:"the maximum duration to read a request, 0 for none"`
+	WriteTimeout    time.Duration `toml:"write_timeout" env:"MODDOC_WRITE_TIMEOUT" default:"5m" usage:"the maximum duration to build and write a page, 0 for none"`
+	IdleTimeout     time.Duration `toml:"idle_timeout" env:"MODDOC_IDLE_TIMEOUT" default:"2m" usage:"how long to keep idle connections open, 0 for none"`
+	ShutdownTimeout time.Duration `toml:"shutdown_timeout" env:"MODDOC_SHUTDOWN_TIMEOUT" default:"1m" usage:"how long to wait for the pages being built when stopping"`
+}
+
+// TLS configures HTTPS.
+type TLS struct {
+	Cert   string        `toml:"cert" env:"MODDOC_TLS_CERT" usage:"the certificate file to serve HTTPS with"`
+	Key    string        `toml:"key" env:"MODDOC_TLS_KEY" usage:"the private key file of the certificate"`
+	Reload time.Duration `toml:"reload" env:"MODDOC_TLS_RELOAD" default:"1m" usage:"how often to reload the changed certificate and key files, 0 disables it"`
+}
+
+// Theme configures the templates and assets.
+type Theme struct {
+	Dir    string        `toml:"dir" env:"MODDOC_THEME_DIR" usage:"a directory of templates and public assets overriding the embedded ones"`
+	Reload time.Duration `toml:"reload" env:"MODDOC_THEME_RELOAD" default:"2s" usage:"how often to reload the changed templates of the theme, 0 disables it"`
+}
+
+// Index configures the mirror of the module index.
+type Index struct {
+	URL      string        `toml:"url" env:"MODDOC_INDEX_URL" default:"https://index.golang.org/index" usage:"the module index feed, empty to disable the indexer"`
+	Dir      string        `toml:"dir" env:"MODDOC_INDEX_DIR" usage:"a directory to persist the index entries in"`
+	Interval time.Duration `toml:"interval" env:"MODDOC_INDEX_INTERVAL" default:"1m" usage:"how often to poll the index feed"`
+}
+
+// ImportedBy configures the reverse dependency index.
+type ImportedBy struct {
+	Enabled  bool          `toml:"enabled" env:"MODDOC_IMPORTED_BY" usage:"show the packages that import a package"`
+	Interval time.Duration `toml:"interval" env:"MODDOC_IMPORTED_BY_INTERVAL" default:"1h" usage:"how often to rebuild the reverse dependency index"`
+	Modules  []string      `toml:"modules" env:"MODDOC_IMPORTED_BY_MODULES" usage:"the modules to index, instead of the catalog of the GOPROXY"`
+}
+
+// Checksum configures the verification of module versions.
+type Checksum struct {
+	DB       string `toml:"db" env:"MODDOC_SUMDB" usage:"a checksum database such as https://sum.golang.org"`
+	Key      string `toml:"key" env:"MODDOC_SUMDB_KEY" usage:"the verifier key of the checksum database, not needed for sum.golang.org"`
+	File     string `toml:"file" env:"MODDOC_SUMDB_FILE" usage:"a go.sum file of known checksums"`
+	Mismatch string `toml:"mismatch" env:"MODDOC_SUMDB_MISMATCH" default:"warn" usage:"warn or refuse the module versions whose checksums do not match"`
+}
+
+// Limits configures the module zips that moddoc accepts.
+type Limits struct {
+	MaxZipSize   int64 `toml:"max_zip_size" env:"MODDOC_MAX_ZIP_SIZE" default:"524288000" usage:"the maximum size of a module zip in bytes"`
+	MaxTotalSize int64 `toml:"max_total_size" env:"MODDOC_MAX_TOTAL_SIZE" default:"524288000" usage:"the maximum uncompressed size of a module in bytes"`
+	MaxFileSize  int64 `toml:"max_file_size" env:"MODDOC_MAX_FILE_SIZE" default:"16777216" usage:"the maximum uncompressed size of a file in bytes"`
+	MaxFiles     int   `toml:"max_files" env:"MODDOC_MAX_FILES" default:"50000" usage:"the maximum number of files in a module zip"`
+}
+
+// License configures the license reports.
+type License struct {
+	Policy string `toml:"policy" env:"MODDOC_LICENSE_POLICY" usage:"a JSON file of allowed and denied licenses"`
+}
+
+// Vuln configures the vulnerability annotations.
+type Vuln struct {
+	Dir string `toml:"dir" env:"MODDOC_VULN_DIR" usage:"a directory of OSV entries such as a mirror of the Go vulnerability database"`
+}
+
+// Metrics configures the Prometheus metrics.
+type Metrics struct {
+	Enabled bool `toml:"enabled" env:"MODDOC_METRICS" usage:"serve Prometheus metrics at /metrics"`
+}
+
+// Log configures the logs and the spans of the requests.
+type Log struct {
+	Level          string `toml:"level" env:"MODDOC_LOG_LEVEL" default:"info" usage:"the minimum level of the logs: debug, info, warn or error"`
+	Format         string `toml:"format" env:"MODDOC_LOG_FORMAT" default:"text" usage:"the format of the logs: text or json"`
+	Spans          bool   `toml:"spans" env:"MODDOC_LOG_SPANS" usage:"log the request, fetch, unzip, parse and render spans of the requests"`
+	PropagateTrace bool   `toml:"propagate_trace" env:"MODDOC_LOG_PROPAGATE_TRACE" usage:"send the traceparent header of the spans to the GOPROXY and other upstream servers"`
+}
+
+// Load returns the configuration given by the command-line arguments,
+// the environment and the file of the -config flag or of MODDOC_CONFIG.
+// It returns flag.ErrHelp if the arguments ask for the usage. The
+// configuration is not validated.
+func Load(name string, args []string) (*Config, error) {
+	return load(name, args, os.LookupEnv)
+}
+
+func load(name string, args []string, lookupEnv func(string) (string, bool)) (*Config, error) {
+	c := &Config{}
+	settings := c.settings()
+	for _, s := range settings {
+		if s.def != "" {
+			if err := s.set(s.def); err != nil {
+				panic(fmt.Sprintf("invalid default of %v: %v", s.key, err))
+			}
+		}
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	file := fs.String("config", "", "a TOML configuration file (MODDOC_CONFIG)")
+	var flags []flagValue
+	for _, s := range settings {
+		fs.Var(&flagRecorder{s, &flags}, s.key, s.usage+" ("+s.env+")")
+	}
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %v [flags]\n\n", name)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %q, moddoc is configured with flags", fs.Arg(0))
+	}
+
+	if *file == "" {
+		*file, _ = lookupEnv("MODDOC_CONFIG")
+	}
+	if *file != "" {
+		if err := c.loadFile(*file, settings); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range settings {
+		if v, ok := lookupEnv(s.env); ok {
+			if err := s.set(v); err != nil {
+				return nil, fmt.Errorf("invalid %v: %v", s.env, err)
+			}
+		}
+	}
+	if _, ok := lookupEnv("MODDOC_LISTEN"); !ok {
+		// PORT is set by hosting platforms such as Cloud Run.
+		if port, ok := lookupEnv("PORT"); ok && port != "" {
+			c.Server.Listen = ":" + port
+		}
+	}
+	if env, _ := lookupEnv("MODDOC_ENV"); env == "DEV" && c.Theme.Dir == "" {
+		// MODDOC_ENV=DEV served the templates of the repository.
+		c.Theme.Dir = "frontend"
+	}
+	for _, f := range flags {
+		if err := f.s.set(f.value); err != nil {
+			return nil, fmt.Errorf("invalid -%v: %v", f.s.key, err)
+		}
+	}
+	return c, nil
+}
+
+func (c *Config) loadFile(name string, settings []*setting) error {
+	bts, err := ioutil.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	values, err := parseTOML(bts)
+	if err != nil {
+		return fmt.Errorf("%v:%v", name, err)
+	}
+	byKey := map[string]*setting{}
+	for _, s := range settings {
+		byKey[s.key] = s
+	}
+	for _, v := range values {
+		s, ok := byKey[v.key]
+		if !ok {
+			return fmt.Errorf("%v:%v: unknown setting %v", name, v.line, v.key)
+		}
+		if err := s.setValue(v.value); err != nil {
+			return fmt.Errorf("%v:%v: invalid %v: %v", name, v.line, v.key, err)
+		}
+	}
+	return nil
+}
+
+// GoProxyURL returns the first URL of the GOPROXY list.
+func (c *Config) GoProxyURL() string {
+	return strings.TrimSuffix(strings.TrimSpace(strings.Split(c.Upstream.GoProxy, ",")[0]), "/")
+}
+
+// setting is a field of the configuration.
+type setting struct {
+	key   string
+	env   string
+	def   string
+	usage string
+	v     reflect.Value
+}
+
+func (c *Config) settings() []*setting {
+	var settings []*setting
+	cv := reflect.ValueOf(c).Elem()
+	for i := 0; i < cv.NumField(); i++ {
+		section := cv.Type().Field(i).Tag.Get("toml")
+		sv := cv.Field(i)
+		for j := 0; j < sv.NumField(); j++ {
+			f := sv.Type().Field(j)
+			settings = append(settings, &setting{
+				key:   section + "." + f.Tag.Get("toml"),
+				env:   f.Tag.Get("env"),
+				def:   f.Tag.Get("default"),
+				usage: f.Tag.Get("usage"),
+				v:     sv.Field(j),
+			})
+		}
+	}
+	return settings
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// set parses v as the value of the setting. Lists are comma separated.
+func (s *setting) set(v string) error {
+	switch {
+	case s.v.Type() == durationType:
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		s.v.SetInt(int64(d))
+	case s.v.Kind() == reflect.String:
+		s.v.SetString(v)
+	case s.v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fmt.Errorf("%q is not true or false", v)
+		}
+		s.v.SetBool(b)
+	case s.v.Kind() == reflect.Int || s.v.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", v)
+		}
+		s.v.SetInt(n)
+	case s.v.Kind() == reflect.Slice:
+		var list []string
+		for _, e := range strings.Split(v, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				list = append(list, e)
+			}
+		}
+		s.v.Set(reflect.ValueOf(list))
+	default:
+		panic("unsupported setting type " + s.v.Type().String())
+	}
+	return nil
+}
+
+// setValue sets a value of the configuration file.
+func (s *setting) setValue(v interface{}) error {
+	switch v := v.(type) {
+	case string:
+		if s.v.Kind() == reflect.String || s.v.Type() == durationType {
+			return s.set(v)
+		}
+	case bool:
+		if s.v.Kind() == reflect.Bool {
+			s.v.SetBool(v)
+			return nil
+		}
+	case int64:
+		if s.v.Type() != durationType && (s.v.Kind() == reflect.Int || s.v.Kind() == reflect.Int64) {
+			s.v.SetInt(v)
+			return nil
+		}
+	case []string:
+		if s.v.Kind() == reflect.Slice {
+			s.v.Set(reflect.ValueOf(v))
+			return nil
+		}
+	}
+	return fmt.Errorf("expected %v", s.kind())
+}
+
+// kind describes the values of the setting.
+func (s *setting) kind() string {
+	switch {
+	case s.v.Type() == durationType:
+		return `a duration string such as "1m30s"`
+	case s.v.Kind() == reflect.String:
+		return "a string"
+	case s.v.Kind() == reflect.Bool:
+		return "true or false"
+	case s.v.Kind() == reflect.Slice:
+		return "an array of strings"
+	}
+	return "an integer"
+}
+
+// flagValue is a flag to apply once the file and the environment are loaded.
+type flagValue struct {
+	s     *setting
+	value string
+}
+
+type flagRecorder struct {
+	s     *setting
+	flags *[]flagValue
+}
+
+func (f *flagRecorder) String() string {
+	if f == nil || f.s == nil || f.s.def == "" {
+		return ""
+	}
+	return f.s.def
+}
+
+func (f *flagRecorder) Set(v string) error {
+	// parse the value now to report errors with the usage.
+	cp := &setting{v: reflect.New(f.s.v.Type()).Elem()}
+	if err := cp.set(v); err != nil {
+		return err
+	}
+	*f.flags = append(*f.flags, flagValue{f.s, v})
+	return nil
+}
+
+func (f *flagRecorder) IsBoolFlag() bool {
+	return f.s.v.Kind() == reflect.Bool
+}
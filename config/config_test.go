@@ -0,0 +1,282 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testFile = `
+# the upstream proxy
+[upstream]
+goproxy = "https://file.example.com,direct"
+
+[server]
+listen = ":8080"
+base_path = '/godoc' # a literal string
+
+[auth]
+hosts = [
+  "a.example.com",
+  "b.example.com",
+]
+
+[index]
+interval = "5m"
+
+[limits]
+max_files = 1_000
+`
+
+var loadTestCases = []struct {
+	name   string
+	args   []string
+	env    map[string]string
+	check  func(c *Config) interface{}
+	expect interface{}
+}{
+	{
+		name:   "default",
+		check:  func(c *Config) interface{} { return c.Server.Listen },
+		expect: ":3001",
+	},
+	{
+		name: "file",
+		args: []string{"-config", "FILE"},
+		check: func(c *Config) interface{} {
+			return []interface{}{c.GoProxyURL(), c.Server.BasePath, c.Auth.Hosts, c.Index.Interval, c.Limits.MaxFiles}
+		},
+		expect: []interface{}{"https://file.example.com", "/godoc", []string{"a.example.com", "b.example.com"}, 5 * time.Minute, 1000},
+	},
+	{
+		name:   "config env",
+		env:    map[string]string{"MODDOC_CONFIG": "FILE"},
+		check:  func(c *Config) interface{} { return c.Server.Listen },
+		expect: ":8080",
+	},
+	{
+		name:   "env overrides file",
+		args:   []string{"-config", "FILE"},
+		env:    map[string]string{"MODDOC_LISTEN": ":9090", "MODDOC_AUTH_HOSTS": "c.example.com, d.example.com"},
+		check:  func(c *Config) interface{} { return []interface{}{c.Server.Listen, c.Auth.Hosts} },
+		expect: []interface{}{":9090", []string{"c.example.com", "d.example.com"}},
+	},
+	{
+		name:   "flag overrides env",
+		args:   []string{"-config", "FILE", "-server.listen", ":7070", "-imported_by.enabled"},
+		env:    map[string]string{"MODDOC_LISTEN": ":9090"},
+		check:  func(c *Config) interface{} { return []interface{}{c.Server.Listen, c.ImportedBy.Enabled} },
+		expect: []interface{}{":7070", true},
+	},
+	{
+		name:   "port",
+		env:    map[string]string{"PORT": "4000"},
+		check:  func(c *Config) interface{} { return c.Server.Listen },
+		expect: ":4000",
+	},
+	{
+		name:   "listen overrides port",
+		env:    map[string]string{"PORT": "4000", "MODDOC_LISTEN": "localhost:5000"},
+		check:  func(c *Config) interface{} { return c.Server.Listen },
+		expect: "localhost:5000",
+	},
+	{
+		name:   "empty env",
+		env:    map[string]string{"MODDOC_INDEX_URL": ""},
+		check:  func(c *Config) interface{} { return c.Index.URL },
+		expect: "",
+	},
+	{
+		name:   "dev",
+		env:    map[string]string{"MODDOC_ENV": "DEV"},
+		check:  func(c *Config) interface{} { return c.Theme.Dir },
+		expect: "frontend",
+	},
+}
+
+func TestLoad(t *testing.T) {
+	file := tempFile(t, testFile)
+	defer os.RemoveAll(filepath.Dir(file))
+	for _, tc := range loadTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := make([]string, len(tc.args))
+			for i, a := range tc.args {
+				args[i] = strings.Replace(a, "FILE", file, 1)
+			}
+			c, err := load("moddoc", args, func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return strings.Replace(v, "FILE", file, 1), ok
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := tc.check(c); !reflect.DeepEqual(got, tc.expect) {
+				t.Fatalf("expected %v but got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+var loadErrorTestCases = []struct {
+	name   string
+	args   []string
+	env    map[string]string
+	file   string
+	expect string
+}{
+	{
+		name:   "flag",
+		args:   []string{"-cache.size", "many"},
+		expect: `invalid value "many" for flag -cache.size: "many" is not an integer`,
+	},
+	{
+		name:   "argument",
+		args:   []string{"serve"},
+		expect: `unexpected argument "serve"`,
+	},
+	{
+		name:   "env",
+		env:    map[string]string{"MODDOC_THEME_RELOAD": "often"},
+		expect: "invalid MODDOC_THEME_RELOAD",
+	},
+	{
+		name:   "unknown setting",
+		file:   "[server]\nport = 3000\n",
+		expect: ":2: unknown setting server.port",
+	},
+	{
+		name:   "wrong type",
+		file:   "[cache]\nsize = \"big\"\n",
+		expect: ":2: invalid cache.size: expected an integer",
+	},
+	{
+		name:   "unquoted string",
+		file:   "[upstream]\ngoproxy = https://proxy.golang.org\n",
+		expect: ":2: goproxy: invalid value https://proxy.golang.org, strings must be quoted",
+	},
+	{
+		name:   "no section",
+		file:   "listen = \":3000\"\n",
+		expect: ":1: listen must be in a [section]",
+	},
+	{
+		name:   "duplicate",
+		file:   "[server]\nlisten = \":3000\"\n\nlisten = \":4000\"\n",
+		expect: ":4: server.listen is already set on line 2",
+	},
+	{
+		name:   "unterminated string",
+		file:   "[theme]\ndir = \"themes\n",
+		expect: ":2: dir: unterminated string",
+	},
+}
+
+func TestLoadError(t *testing.T) {
+	for _, tc := range loadErrorTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := tc.args
+			if tc.file != "" {
+				file := tempFile(t, tc.file)
+				defer os.RemoveAll(filepath.Dir(file))
+				args = append(args, "-config", file)
+			}
+			_, err := load("moddoc", args, func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return v, ok
+			})
+			if err == nil || !strings.Contains(err.Error(), tc.expect) {
+				t.Fatalf("expected an error containing %q but got %v", tc.expect, err)
+			}
+		})
+	}
+}
+
+var validateTestCases = []struct {
+	name   string
+	env    map[string]string
+	expect []string
+}{
+	{
+		name: "valid",
+		env:  map[string]string{"GOPROXY": "https://proxy.golang.org"},
+	},
+	{
+		name:   "missing goproxy",
+		expect: []string{"upstream.goproxy (GOPROXY, -upstream.goproxy) is required"},
+	},
+	{
+		name:   "direct",
+		env:    map[string]string{"GOPROXY": "direct"},
+		expect: []string{`upstream.goproxy (GOPROXY, -upstream.goproxy) cannot start with "direct"`},
+	},
+	{
+		name: "many",
+		env: map[string]string{
+			"GOPROXY":               "proxy.golang.org",
+			"MODDOC_LISTEN":         "3000",
+			"MODDOC_TLS_CERT":       "cert.pem",
+			"MODDOC_SUMDB_MISMATCH": "ignore",
+			"MODDOC_AUTH_PASSWORD":  "secret",
+		},
+		expect: []string{
+			`upstream.goproxy (GOPROXY, -upstream.goproxy) must be an http or https URL, got "proxy.golang.org"`,
+			`server.listen (MODDOC_LISTEN, -server.listen) must be a host:port address`,
+			"tls.cert (MODDOC_TLS_CERT, -tls.cert) and tls.key must be set together",
+			"tls.cert (MODDOC_TLS_CERT, -tls.cert) cannot be used: stat cert.pem",
+			`checksum.mismatch (MODDOC_SUMDB_MISMATCH, -checksum.mismatch) must be warn or refuse, got "ignore"`,
+			"auth.password (MODDOC_AUTH_PASSWORD, -auth.password) needs auth.username to be set",
+		},
+	},
+	{
+		name: "sumdb key",
+		env:  map[string]string{"GOPROXY": "https://proxy.golang.org", "MODDOC_SUMDB": "https://sum.example.com"},
+		expect: []string{
+			"checksum.key (MODDOC_SUMDB_KEY, -checksum.key) is required to verify the tree heads signed by sum.example.com",
+		},
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range validateTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := load("moddoc", nil, func(key string) (string, bool) {
+				v, ok := tc.env[key]
+				return v, ok
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = c.Validate()
+			if len(tc.expect) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error but got %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error but got nil")
+			}
+			for _, e := range tc.expect {
+				if !strings.Contains(err.Error(), e) {
+					t.Fatalf("expected the error to contain %q but got %v", e, err)
+				}
+			}
+		})
+	}
+}
+
+func tempFile(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "moddoc-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := filepath.Join(dir, "moddoc.toml")
+	if err := ioutil.WriteFile(name, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fileValue is a value of the configuration file.
+type fileValue struct {
+	key   string
+	value interface{}
+	line  int
+}
+
+// parseTOML parses the subset of TOML that configuration files need:
+// comments, [section] headers and key = value pairs whose values are
+// strings, integers, booleans or arrays of strings, which may span
+// several lines. Values are string, int64, bool or []string.
+func parseTOML(data []byte) ([]fileValue, error) {
+	var values []fileValue
+	seen := map[string]int{}
+	section := ""
+	lines := strings.Split(string(data), "\n")
+	for i := 0; i < len(lines); i++ {
+		lineno := i + 1
+		line := strings.TrimSpace(stripComment(lines[i]))
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") || strings.HasPrefix(line, "[[") {
+				return nil, fmt.Errorf("%v: invalid section header %v", lineno, line)
+			}
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if !isBareKey(section) {
+				return nil, fmt.Errorf("%v: invalid section name %q", lineno, section)
+			}
+			continue
+		}
+		eq := strings.Index(line, "=")
+		if eq < 0 {
+			return nil, fmt.Errorf("%v: expected key = value, got %v", lineno, line)
+		}
+		key, raw := strings.TrimSpace(line[:eq]), strings.TrimSpace(line[eq+1:])
+		if !isBareKey(key) {
+			return nil, fmt.Errorf("%v: invalid key %q", lineno, key)
+		}
+		if section == "" {
+			return nil, fmt.Errorf("%v: %v must be in a [section]", lineno, key)
+		}
+		// arrays may continue on the next lines.
+		for strings.HasPrefix(raw, "[") && !strings.HasSuffix(raw, "]") && i+1 < len(lines) {
+			i++
+			raw += " " + strings.TrimSpace(stripComment(lines[i]))
+		}
+		v, err := parseValue(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v: %v", lineno, key, err)
+		}
+		key = section + "." + key
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%v: %v is already set on line %v", lineno, key, prev)
+		}
+		seen[key] = lineno
+		values = append(values, fileValue{key, v, lineno})
+	}
+	return values, nil
+}
+
+func parseValue(raw string) (interface{}, error) {
+	switch {
+	case raw == "":
+		return nil, fmt.Errorf("missing value")
+	case raw == "true", raw == "false":
+		return raw == "true", nil
+	case raw[0] == '"' || raw[0] == '\'':
+		s, rest, err := parseString(raw)
+		if err != nil {
+			return nil, err
+		}
+		if rest != "" {
+			return nil, fmt.Errorf("unexpected %v after the string", rest)
+		}
+		return s, nil
+	case raw[0] == '[':
+		return parseArray(raw)
+	}
+	n, err := strconv.ParseInt(strings.Replace(raw, "_", "", -1), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %v, strings must be quoted", raw)
+	}
+	return n, nil
+}
+
+// parseString parses the quoted string at the start of raw
+// and returns it with the rest of raw.
+func parseString(raw string) (string, string, error) {
+	quote := raw[0]
+	if quote == '\'' {
+		end := strings.IndexByte(raw[1:], '\'')
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated string")
+		}
+		return raw[1 : end+1], strings.TrimSpace(raw[end+2:]), nil
+	}
+	var sb strings.Builder
+	for i := 1; i < len(raw); i++ {
+		switch c := raw[i]; c {
+		case '"':
+			return sb.String(), strings.TrimSpace(raw[i+1:]), nil
+		case '\\':
+			i++
+			if i == len(raw) {
+				return "", "", fmt.Errorf("unterminated string")
+			}
+			switch raw[i] {
+			case '"', '\\':
+				sb.WriteByte(raw[i])
+			case 'n':
+				sb.WriteByte('\n')
+			case 't':
+				sb.WriteByte('\t')
+			default:
+				return "", "", fmt.Errorf(`invalid escape \%c`, raw[i])
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string")
+}
+
+func parseArray(raw string) ([]string, error) {
+	if !strings.HasSuffix(raw, "]") {
+		return nil, fmt.Errorf("unterminated array")
+	}
+	rest := strings.TrimSpace(raw[1 : len(raw)-1])
+	list := []string{}
+	for rest != "" {
+		if rest[0] != '"' && rest[0] != '\'' {
+			return nil, fmt.Errorf("arrays may only contain strings")
+		}
+		s, r, err := parseString(rest)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+		rest = r
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("expected a comma between the array values")
+		}
+		rest = strings.TrimSpace(rest[1:])
+	}
+	return list, nil
+}
+
+// stripComment removes the comment at the end of a line,
+// leaving the # characters that are in strings.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == 0 && c == '#':
+			return line[:i]
+		case quote == 0 && (c == '"' || c == '\''):
+			quote = c
+		case quote == '"' && c == '\\':
+			i++
+		case c == quote:
+			quote = 0
+		}
+	}
+	return line
+}
+
+func isBareKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"marwan.io/moddoc/logging"
+)
+
+// Validate checks the configuration and returns an
+// error that describes every problem and how to fix it.
+func (c *Config) Validate() error {
+	v := &validator{envs: map[string]string{}}
+	for _, s := range c.settings() {
+		v.envs[s.key] = s.env
+	}
+
+	switch proxyURL := c.GoProxyURL(); proxyURL {
+	case "":
+		v.add("upstream.goproxy", "is required, set it to the URL of a GOPROXY such as https://proxy.golang.org")
+	case "direct", "off":
+		v.add("upstream.goproxy", "cannot start with %q, moddoc needs the URL of a GOPROXY such as https://proxy.golang.org", proxyURL)
+	default:
+		v.url("upstream.goproxy", proxyURL)
+	}
+	if c.Index.URL != "" {
+		v.url("index.url", c.Index.URL)
+		v.positive("index.interval", int64(c.Index.Interval))
+	}
+	if c.Checksum.DB != "" {
+		v.url("checksum.db", c.Checksum.DB)
+		if u, err := url.Parse(c.Checksum.DB); err == nil && u.Host != "sum.golang.org" && c.Checksum.Key == "" {
+			v.add("checksum.key", "is required to verify the tree heads signed by %v", u.Host)
+		}
+	}
+
+	if c.Auth.Token != "" && c.Auth.Username != "" {
+		v.add("auth.token", "cannot be used with auth.username, remove one of them")
+	}
+	if c.Auth.Password != "" && c.Auth.Username == "" {
+		v.add("auth.password", "needs auth.username to be set")
+	}
+
+	if c.Cache.Size < 0 {
+		v.add("cache.size", "must be 0 or more, got %v", c.Cache.Size)
+	}
+
+	if _, _, err := net.SplitHostPort(c.Server.Listen); err != nil {
+		v.add("server.listen", "must be a host:port address such as :3001 or localhost:3001, got %q", c.Server.Listen)
+	}
+	if c.Server.Socket != "" {
+		v.file("server.socket", filepath.Dir(c.Server.Socket), true)
+	}
+	v.notNegative("server.read_timeout", c.Server.ReadTimeout)
+	v.notNegative("server.write_timeout", c.Server.WriteTimeout)
+	v.notNegative("server.idle_timeout", c.Server.IdleTimeout)
+	v.positive("server.shutdown_timeout", int64(c.Server.ShutdownTimeout))
+	if p := c.Server.BasePath; p != "" && (!strings.HasPrefix(p, "/") || strings.ContainsAny(p, "?#\"'<> ")) {
+		v.add("server.base_path", "must be a URL path such as /godoc, got %q", p)
+	}
+
+	if (c.TLS.Cert == "") != (c.TLS.Key == "") {
+		v.add("tls.cert", "and tls.key must be set together to serve HTTPS")
+	}
+	v.file("tls.cert", c.TLS.Cert, false)
+	v.file("tls.key", c.TLS.Key, false)
+	v.notNegative("tls.reload", c.TLS.Reload)
+
+	v.file("theme.dir", c.Theme.Dir, true)
+	v.notNegative("theme.reload", c.Theme.Reload)
+	if c.ImportedBy.Enabled {
+		v.positive("imported_by.interval", int64(c.ImportedBy.Interval))
+	}
+
+	v.file("checksum.file", c.Checksum.File, false)
+	if c.Checksum.Mismatch != "warn" && c.Checksum.Mismatch != "refuse" {
+		v.add("checksum.mismatch", "must be warn or refuse, got %q", c.Checksum.Mismatch)
+	}
+
+	v.positive("limits.max_zip_size", c.Limits.MaxZipSize)
+	v.positive("limits.max_total_size", c.Limits.MaxTotalSize)
+	v.positive("limits.max_file_size", c.Limits.MaxFileSize)
+	v.positive("limits.max_files", int64(c.Limits.MaxFiles))
+
+	v.file("license.policy", c.License.Policy, false)
+	v.file("vuln.dir", c.Vuln.Dir, true)
+
+	if _, err := logging.ParseLevel(c.Log.Level); err != nil {
+		v.add("log.level", "must be debug, info, warn or error, got %q", c.Log.Level)
+	}
+	if c.Log.Format != "text" && c.Log.Format != "json" {
+		v.add("log.format", "must be text or json, got %q", c.Log.Format)
+	}
+
+	if len(v.problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid configuration:\n\t%v", strings.Join(v.problems, "\n\t"))
+}
+
+type validator struct {
+	envs     map[string]string
+	problems []string
+}
+
+// add records a problem with the setting key,
+// naming its environment variable and flag.
+func (v *validator) add(key, format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	v.problems = append(v.problems, fmt.Sprintf("%v (%v, -%v) %v", key, v.envs[key], key, msg))
+}
+
+func (v *validator) url(key, value string) {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v.add(key, "must be an http or https URL, got %q", value)
+	}
+}
+
+func (v *validator) positive(key string, n int64) {
+	if n <= 0 {
+		v.add(key, "must be more than 0")
+	}
+}
+
+func (v *validator) notNegative(key string, d time.Duration) {
+	if d < 0 {
+		v.add(key, "must be 0 or more, got %v", d)
+	}
+}
+
+// file checks that the file or directory name exists, if set.
+func (v *validator) file(key, name string, dir bool) {
+	if name == "" {
+		return
+	}
+	fi, err := os.Stat(name)
+	switch {
+	case err != nil:
+		v.add(key, "cannot be used: %v", err)
+	case dir && !fi.IsDir():
+		v.add(key, "must be a directory, %v is a file", name)
+	case !dir && fi.IsDir():
+		v.add(key, "must be a file, %v is a directory", name)
+	}
+}
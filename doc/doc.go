@@ -2,6 +2,9 @@ package doc
 
 import (
 	"html/template"
+	"path"
+	"sort"
+	"time"
 )
 
 // Documentation is the data structure
@@ -10,6 +13,8 @@ type Documentation struct {
 	PackageName   string
 	ModuleVersion string
 	Versions      []string
+	VersionTimes  map[string]time.Time
+	Published     time.Time
 	ModuleRoot    string
 	ImportPath    string
 	PackageDoc    template.HTML
@@ -22,6 +27,48 @@ type Documentation struct {
 	Subdirs       []*Subdir
 	NavLinks      []string
 	GoMod         template.HTML
+	Imports       Imports
+	Checksum      *Checksum
+	ImportedBy    []*Importer
+	RequiredBy    []*Importer
+	// Command is the name of the binary built from
+	// a main package, empty for other packages.
+	Command string
+	// Readme is the rendered README of the module,
+	// only set on the page of the module root.
+	Readme template.HTML
+	// Licenses are the license files found in the
+	// package directory and its parents in the module.
+	Licenses []*License
+	// Vulns are the known vulnerabilities that
+	// affect the documented module version.
+	Vulns []*Vuln
+	// VulnerableVersions are the versions of the module that
+	// are affected by at least one known vulnerability.
+	VulnerableVersions map[string]bool
+	// DependencyVulns are the requirements of the go.mod
+	// that are affected by known vulnerabilities.
+	DependencyVulns []*DependencyVulns
+	// PackageDocText is the package comment as plain text,
+	// for the renderers of formats other than HTML.
+	PackageDocText string
+}
+
+// LicenseTypes returns the SPDX identifiers of
+// all the licenses that apply to the package.
+func (d *Documentation) LicenseTypes() []string {
+	seen := map[string]bool{}
+	var types []string
+	for _, l := range d.Licenses {
+		for _, t := range l.Types {
+			if !seen[t] {
+				seen[t] = true
+				types = append(types, t)
+			}
+		}
+	}
+	sort.Strings(types)
+	return types
 }
 
 // Value represents one or a group of constants/variables
@@ -31,6 +78,7 @@ type Value struct {
 	Value           string
 	Type            string
 	Doc             template.HTML
+	DocText         string
 	IsGroup         bool
 	Values          []*Value
 }
@@ -42,9 +90,13 @@ type Func struct {
 	// Signature       *FunctionSignature //TODO: later
 	SignatureString string
 	Doc             template.HTML
+	DocText         string
 	// MethodReceiver  *MethodReceiver // TODO: later
 	MethodReceiverString string
 	Examples             []*Example
+	// Vulns are the IDs of the known vulnerabilities
+	// that affect the function.
+	Vulns []string
 }
 
 // FunctionSignature represents a function or method signature
@@ -75,12 +127,16 @@ type Type struct {
 	Doc             template.HTML
 	Type            string
 	SignatureString string
+	DocText         string
 	Fields          []*Field
 	Examples        []*Example
 	Methods         []*Func
 	Funcs           []*Func
 	Constants       []*Value
 	Variables       []*Value
+	// Vulns are the IDs of the known vulnerabilities
+	// that affect the type itself.
+	Vulns []string
 }
 
 // Field is a struct filed
@@ -102,14 +158,101 @@ type MethodReceiver struct {
 // File represents a go file inside a package
 type File struct {
 	Name string
-	// Future: link
+	// Link points to the raw content of the file.
+	Link string
 }
 
-// Subdir represents a potential sub package.
-// Caller assumes they know how to link to a module
-// to one of its sub-directories.
+// License is a license file of a module.
+type License struct {
+	// Path is the path of the file relative to the module root.
+	Path string
+	// Types are the SPDX identifiers of the licenses
+	// detected in the file, or UNKNOWN.
+	Types []string
+	Text  string
+}
+
+// Subdir represents a directory below the documented
+// package. Subdirs are listed in tree order: every directory
+// comes right before its own sub directories.
 type Subdir struct {
+	// Name is the path relative to the documented package.
 	Name     string
 	Synopsis string
-	Link     string
+	// Link is empty for directories that only
+	// hold other directories and no package.
+	Link string
+	// Depth is the number of parent directories
+	// of Name that are also listed.
+	Depth int
+	// Module is true if the directory is the root of a
+	// nested module, which is documented on its own.
+	Module bool
+	// Command is true if the directory holds a main package.
+	Command bool
+	// Warning explains why the Synopsis may be incomplete,
+	// such as files of the package that could not be parsed.
+	Warning string
+}
+
+// Base returns the last element of the Subdir's name.
+func (s *Subdir) Base() string {
+	return path.Base(s.Name)
+}
+
+// Vuln is a known vulnerability of a module version.
+type Vuln struct {
+	ID      string
+	Aliases []string
+	Summary string
+	URL     string
+	// Fixed is the first version that fixes the
+	// vulnerability, empty if there is none yet.
+	Fixed string
+	// Package is true if the documented package is affected,
+	// either as a whole or through its Symbols.
+	Package bool
+	Symbols []string
+}
+
+// DependencyVulns are the known vulnerabilities
+// of a module version required by a go.mod.
+type DependencyVulns struct {
+	Path    string
+	Version string
+	Vulns   []*Vuln
+}
+
+// Checksum is the result of verifying the downloaded
+// module version against a checksum database.
+type Checksum struct {
+	Zip      string
+	GoMod    string
+	Verified bool
+	Mismatch bool
+	Message  string
+}
+
+// Imports are the packages imported by the documented
+// package, grouped by where they come from.
+type Imports struct {
+	Stdlib     []*Import
+	Module     []*Import
+	ThirdParty []*Import
+}
+
+// Import is an imported package. Version is the one
+// required by the closest go.mod, if any.
+type Import struct {
+	Path    string
+	Version string
+	Link    string
+}
+
+// Importer is a package or a module that depends on the
+// one being documented, at the latest version known.
+type Importer struct {
+	Path    string
+	Module  string
+	Version string
 }
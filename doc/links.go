@@ -0,0 +1,53 @@
+package doc
+
+import (
+	"context"
+	"path"
+)
+
+// Links builds the links between the pages of moddoc. Base is
+// the URL path prefix that moddoc is served under, such as /godoc,
+// or "" when it is served from the root.
+type Links struct {
+	Base string
+}
+
+// Path links to p, a path from the root of moddoc such as /public/main.css.
+func (l Links) Path(p string) string {
+	return l.Base + path.Join("/", p)
+}
+
+// Version links to the documentation of importPath at version.
+func (l Links) Version(importPath, version string) string {
+	return l.Path(path.Join(importPath, "@v", version))
+}
+
+// Latest links to importPath, which redirects to its latest version.
+func (l Links) Latest(importPath string) string {
+	return l.Path(importPath)
+}
+
+// Raw links to the file name, relative to the
+// root of the module mod, in the given version.
+func (l Links) Raw(mod, version, name string) string {
+	return l.Path(path.Join(mod, "@v", version, "raw", name))
+}
+
+// Importers links to the packages that import importPath.
+func (l Links) Importers(importPath string) string {
+	return l.Path(path.Join("importers", importPath))
+}
+
+type linksKey struct{}
+
+// WithLinks returns a context whose documentation links with l.
+func WithLinks(ctx context.Context, l Links) context.Context {
+	return context.WithValue(ctx, linksKey{}, l)
+}
+
+// LinksFromContext returns the Links of the context,
+// which link from the root if it does not carry any.
+func LinksFromContext(ctx context.Context) Links {
+	l, _ := ctx.Value(linksKey{}).(Links)
+	return l
+}
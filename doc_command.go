@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	proxydoc "marwan.io/moddoc/doc"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/proxy"
+	"marwan.io/moddoc/render"
+)
+
+const docUsage = `usage: moddoc doc [flags] package[@version] [Symbol[.Method]]
+
+Doc prints the documentation of a package fetched from the GOPROXY,
+like go doc does for the packages of the local module graph. Without
+a symbol, it prints the package comment and a summary of the exported
+declarations. A package without a version is shown at the latest
+version of its module.
+
+`
+
+func docMain(args []string) {
+	flags := flag.NewFlagSet("doc", flag.ExitOnError)
+	all := flags.Bool("all", false, "show the documentation of every declaration")
+	src := flags.Bool("src", false, "show the full source of the symbol")
+	unexported := flags.Bool("u", false, "show the unexported declarations as well")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, docUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	var opts []proxy.Option
+	if *unexported {
+		opts = append(opts, proxy.WithUnexported())
+	}
+	if *src {
+		opts = append(opts, proxy.WithSource())
+	}
+	d := getDocOrExit(flags.Arg(0), opts...)
+	err := render.Text(os.Stdout, d, render.TextOptions{
+		Symbol: flags.Arg(1),
+		All:    *all,
+		Source: *src,
+	})
+	if err != nil {
+		fatalf("%v", err)
+	}
+}
+
+const markdownUsage = `usage: moddoc markdown [flags] package[@version]
+
+Markdown writes the documentation of a package fetched from the GOPROXY
+as GitHub flavored Markdown, to publish API references in wikis and
+READMEs. A package without a version is shown at the latest version of
+its module.
+
+`
+
+func markdownMain(args []string) {
+	flags := flag.NewFlagSet("markdown", flag.ExitOnError)
+	out := flags.String("o", "", "the file to write to instead of the standard output")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, markdownUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	d := getDocOrExit(flags.Arg(0))
+	var buf bytes.Buffer
+	if err := render.Markdown(&buf, d); err != nil {
+		fatalf("%v", err)
+	}
+	if *out == "" {
+		os.Stdout.Write(buf.Bytes())
+		return
+	}
+	if err := ioutil.WriteFile(*out, buf.Bytes(), 0644); err != nil {
+		fatalf("%v", err)
+	}
+}
+
+// getDocOrExit builds the documentation of a package[@version]
+// argument, resolving a missing or non canonical version first.
+func getDocOrExit(arg string, extra ...proxy.Option) *proxydoc.Documentation {
+	opts, err := serviceOptions()
+	if err != nil {
+		fatalf("%v", err)
+	}
+	srv := proxy.NewService(cfg.GoProxyURL(), append(opts, extra...)...)
+
+	pkg, ver := arg, "latest"
+	if i := strings.Index(pkg, "@"); i >= 0 {
+		pkg, ver = pkg[:i], pkg[i+1:]
+	}
+	mod, err := gomodule.EncodePath(pkg)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	ctx := context.Background()
+	if gomodule.CanonicalVersion(ver) != ver {
+		info, err := srv.GetInfo(ctx, mod, ver)
+		if err != nil {
+			fatalf("could not resolve version %q of %v: %v", ver, pkg, err)
+		}
+		ver = info.Version
+	}
+	d, err := srv.GetDoc(ctx, mod, ver)
+	if err != nil {
+		fatalf("%v", err)
+	}
+	return d
+}
+
+func fatalf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "moddoc: "+format+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"html"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/render"
+	"marwan.io/moddoc/server"
+)
+
+const exportUsage = `usage: moddoc export [flags] [module[@version] ...]
+
+Export renders the documentation of module versions into a directory
+of static HTML files with relative links, which can be published on
+any static file host. Every package page of the module version is
+exported along with the source files of its packages, its dependency
+graph, license report, the files its README links to and the public
+assets. A module without a version is exported at its latest version.
+
+`
+
+func exportMain(args []string) {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("o", "moddoc-site", "the directory to write the site to")
+	serverURL := flags.String("server", "", "a moddoc URL that the links to pages which are not exported point to; such links are removed if empty")
+	fromCatalog := flags.Bool("catalog", false, "export the latest version of every module of the GOPROXY catalog")
+	flags.Usage = func() {
+		fmt.Fprint(os.Stderr, exportUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	targets := flags.Args()
+	if len(targets) == 0 && !*fromCatalog {
+		flags.Usage()
+		os.Exit(2)
+	}
+	s, err := newServer()
+	if err != nil {
+		fatal("could not start the server", err)
+	}
+	ex := &exporter{
+		s:      s,
+		out:    *out,
+		server: strings.TrimSuffix(*serverURL, "/"),
+		files:  map[string]string{},
+		pages:  map[string][]byte{},
+	}
+	if *fromCatalog {
+		mods, err := ex.catalog()
+		if err != nil {
+			fatal("could not get the catalog", err)
+		}
+		for _, m := range mods {
+			targets = append(targets, m.Module+"@"+m.Latest)
+		}
+	}
+	if err := ex.run(targets); err != nil {
+		fatal("could not export", err)
+	}
+	fmt.Printf("exported %d files to %v\n", ex.written, ex.out)
+}
+
+// exporter crawls the routes of moddoc, starting from the module
+// versions to export and following the links that stay within them.
+type exporter struct {
+	s      *server.Server
+	out    string
+	server string
+	scopes []*exportModule
+	queue  []string
+	// files maps the URL paths that were crawled to the files they
+	// are written to, relative to out, or to "" if they failed.
+	files map[string]string
+	// pages are the HTML files, which are written once every
+	// file is known so that their links can be made relative.
+	pages   map[string][]byte
+	written int
+}
+
+// exportModule is a module version to export, as
+// listed by the catalog and on the home page.
+type exportModule struct {
+	Module string `json:"module"`
+	Latest string `json:"latest"`
+}
+
+var linkAttrRx = regexp.MustCompile(`(href|src)="([^"]*)"`)
+
+func (e *exporter) run(targets []string) error {
+	for _, t := range targets {
+		mod, ver, err := e.resolve(t)
+		if err != nil {
+			return fmt.Errorf("could not export %v: %v", t, err)
+		}
+		e.scopes = append(e.scopes, &exportModule{Module: mod, Latest: ver})
+		e.queue = append(e.queue, versionLink(mod, ver))
+	}
+	var buf bytes.Buffer
+	html, _ := e.s.Renderers().Lookup("html")
+	err := html.Render(&buf, &render.Page{
+		Kind: render.KindHome,
+		Data: map[string]interface{}{
+			"Modules": e.scopes,
+			"Static":  true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	e.addPage("/", "index.html", buf.Bytes())
+	for len(e.queue) > 0 {
+		p := e.queue[0]
+		e.queue = e.queue[1:]
+		if _, ok := e.files[p]; ok {
+			continue
+		}
+		if err := e.get(p); err != nil {
+			return err
+		}
+	}
+	for name, page := range e.pages {
+		if err := e.write(name, e.rewrite(name, page)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// catalog returns the latest version of the modules of the catalog.
+func (e *exporter) catalog() ([]*exportModule, error) {
+	rec := e.serve("/catalog")
+	if rec.Code != http.StatusOK {
+		return nil, fmt.Errorf("%v: %v", rec.Code, strings.TrimSpace(rec.Body.String()))
+	}
+	var mods []*exportModule
+	err := json.Unmarshal(rec.Body.Bytes(), &mods)
+	return mods, err
+}
+
+// resolve returns the module path and the canonical version of a
+// module[@version] target, following the redirects of moddoc.
+func (e *exporter) resolve(target string) (string, string, error) {
+	p := "/" + target
+	if i := strings.Index(target, "@"); i >= 0 {
+		p = versionLink(target[:i], target[i+1:])
+	}
+	for i := 0; i < 5; i++ {
+		rec := e.serve(p)
+		switch {
+		case rec.Code == http.StatusOK:
+			mod, ver, _, ok := splitVersionPath(p)
+			if !ok {
+				return "", "", fmt.Errorf("unexpected page %v", p)
+			}
+			return mod, ver, nil
+		case rec.Code >= 300 && rec.Code < 400:
+			loc := rec.Header().Get("Location")
+			mod, ver, rest, ok := splitVersionPath(loc)
+			if !ok {
+				return "", "", fmt.Errorf("unexpected redirect to %v", loc)
+			}
+			// the latest version redirect uses the escaped module path.
+			if dec, err := gomodule.DecodePath(mod); err == nil {
+				mod = dec
+			}
+			p = versionLink(mod, ver) + rest
+		default:
+			return "", "", fmt.Errorf("%v: %v", rec.Code, strings.TrimSpace(rec.Body.String()))
+		}
+	}
+	return "", "", fmt.Errorf("too many redirects")
+}
+
+// get crawls the URL path p.
+func (e *exporter) get(p string) error {
+	rec := e.serve(p)
+	if rec.Code != http.StatusOK {
+		kv := []interface{}{"path", p, "status", rec.Code}
+		if strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+			// http.Error messages, as opposed to rendered error pages
+			kv = append(kv, "error", strings.TrimSpace(rec.Body.String()))
+		}
+		logger.Warn("could not export a page, skipping it", kv...)
+		e.files[p] = ""
+		return nil
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html") {
+		name := strings.TrimPrefix(p, "/")
+		e.files[p] = name
+		return e.write(name, rec.Body.Bytes())
+	}
+	e.addPage(p, path.Join(strings.TrimPrefix(p, "/"), "index.html"), rec.Body.Bytes())
+	return nil
+}
+
+func (e *exporter) serve(p string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", (&url.URL{Path: p}).String(), nil)
+	e.s.ServeHTTP(rec, req)
+	return rec
+}
+
+// addPage records the HTML page of the URL path p
+// and queues the links it has to exported pages.
+func (e *exporter) addPage(p, name string, page []byte) {
+	e.files[p] = name
+	e.pages[name] = page
+	for _, m := range linkAttrRx.FindAllSubmatch(page, -1) {
+		if lp, ok := localPath(string(m[2])); ok && e.inScope(lp) {
+			e.queue = append(e.queue, lp)
+		}
+	}
+}
+
+// inScope reports whether the URL path p is exported: the public
+// assets, and the pages and raw files, such as the source files that
+// package pages link to, of the exported module versions.
+func (e *exporter) inScope(p string) bool {
+	if strings.HasPrefix(p, "/public/") {
+		return true
+	}
+	mod, ver, rest, ok := splitVersionPath(p)
+	if !ok {
+		return false
+	}
+	switch {
+	case rest == "", rest == "/graph", rest == "/licenses", strings.HasPrefix(rest, "/raw/"):
+	default:
+		return false
+	}
+	for _, s := range e.scopes {
+		if ver == s.Latest && (mod == s.Module || strings.HasPrefix(mod, s.Module+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// rewrite makes the links of the page name relative to it. Links
+// to pages that were not exported point to the server, if any, or
+// are removed.
+func (e *exporter) rewrite(name string, page []byte) []byte {
+	return linkAttrRx.ReplaceAllFunc(page, func(attr []byte) []byte {
+		m := linkAttrRx.FindSubmatch(attr)
+		val := html.UnescapeString(string(m[2]))
+		lp, ok := localPath(val)
+		if !ok {
+			return attr
+		}
+		if file := e.files[lp]; file != "" {
+			rel, err := filepath.Rel(path.Dir(name), file)
+			if err == nil {
+				u, _ := url.Parse(val)
+				link := (&url.URL{Path: filepath.ToSlash(rel), Fragment: u.Fragment}).String()
+				return []byte(string(m[1]) + `="` + html.EscapeString(link) + `"`)
+			}
+		}
+		if e.server != "" {
+			return []byte(string(m[1]) + `="` + html.EscapeString(e.server+val) + `"`)
+		}
+		return nil
+	})
+}
+
+func (e *exporter) write(name string, content []byte) error {
+	file := filepath.Join(e.out, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	e.written++
+	return ioutil.WriteFile(file, content, 0644)
+}
+
+// localPath returns the URL path of a link to
+// a moddoc page, as opposed to another site.
+func localPath(link string) (string, bool) {
+	u, err := url.Parse(link)
+	if err != nil || u.Scheme != "" || u.Host != "" || !strings.HasPrefix(u.Path, "/") {
+		return "", false
+	}
+	return u.Path, true
+}
+
+// versionLink returns the URL path of a module version.
+func versionLink(mod, ver string) string {
+	return path.Join("/", mod, "@v", ver)
+}
+
+// splitVersionPath splits a URL path of the form
+// /module/@v/version/rest into its parts.
+func splitVersionPath(p string) (mod, ver, rest string, ok bool) {
+	i := strings.Index(p, "/@v/")
+	if i <= 0 {
+		return "", "", "", false
+	}
+	mod, ver = p[1:i], p[i+len("/@v/"):]
+	if j := strings.Index(ver, "/"); j >= 0 {
+		ver, rest = ver[:j], ver[j:]
+	}
+	return mod, ver, rest, true
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"marwan.io/moddoc/server"
+)
+
+// fakeGoProxy serves example.com/mod v1.0.0, a module
+// of a root package and of a sub package.
+func fakeGoProxy(t *testing.T) *httptest.Server {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range map[string]string{
+		"go.mod":     "module example.com/mod\n",
+		"mod.go":     "// Package mod is exported.\npackage mod\n",
+		"sub/sub.go": "// Package sub is exported too.\npackage sub\n",
+	} {
+		w, err := zw.Create("example.com/mod@v1.0.0/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(content))
+	}
+	zw.Close()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/example.com/mod/@v/list":
+			fmt.Fprint(w, "v1.0.0\n")
+		case "/example.com/mod/@v/v1.0.0.info":
+			fmt.Fprint(w, `{"Version":"v1.0.0","Time":"2019-04-10T00:00:00Z"}`)
+		case "/example.com/mod/@v/v1.0.0.mod":
+			fmt.Fprint(w, "module example.com/mod\n")
+		case "/example.com/mod/@v/v1.0.0.zip":
+			w.Write(buf.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestExport(t *testing.T) {
+	goproxy := fakeGoProxy(t)
+	defer goproxy.Close()
+	s, err := server.New(goproxy.URL, server.WithLogger(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	out, err := ioutil.TempDir("", "moddoc-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(out)
+	ex := &exporter{s: s, out: out, files: map[string]string{}, pages: map[string][]byte{}}
+	if err := ex.run([]string{"example.com/mod"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{
+		"index.html",
+		"example.com/mod/@v/v1.0.0/index.html",
+		"example.com/mod/sub/@v/v1.0.0/index.html",
+		"example.com/mod/@v/v1.0.0/raw/mod.go",
+		"example.com/mod/@v/v1.0.0/raw/sub/sub.go",
+	} {
+		if _, err := os.Stat(filepath.Join(out, filepath.FromSlash(name))); err != nil {
+			t.Fatalf("expected %v to be exported: %v", name, err)
+		}
+	}
+	page, err := ioutil.ReadFile(filepath.Join(out, "example.com/mod/sub/@v/v1.0.0/index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link := `href="../../../@v/v1.0.0/raw/sub/sub.go"`; !strings.Contains(string(page), link) {
+		t.Fatalf("expected the sub package page to link to its source with %v", link)
+	}
+}
@@ -5,22 +5,84 @@ package fetch
 import (
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	neturl "net/url"
 	"os"
 	"strings"
+	"time"
+
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/metrics"
+)
+
+var (
+	fetchDuration = metrics.NewHistogram(
+		"moddoc_upstream_request_duration_seconds",
+		"Time to the response headers of upstream requests, by endpoint.",
+		metrics.DefBuckets, "endpoint",
+	)
+	fetchErrors = metrics.NewCounter(
+		"moddoc_upstream_errors_total",
+		"Upstream requests that failed or returned an error other than not found, by endpoint.",
+		"endpoint",
+	)
+	fetchBytes = metrics.NewCounter(
+		"moddoc_upstream_bytes_total",
+		"Bytes downloaded from upstream servers, by endpoint.",
+		"endpoint",
+	)
 )
 
-// Fetch makes a GET request to the given URL. It also appends
-// an authentication token if GCP_SERVERLESS env is set to true.
-// In the future, this should support any auth header and not just GCP.
+// Auth holds the credentials that fetches send to upstream servers.
+type Auth struct {
+	// Token is sent as a bearer token.
+	Token string
+	// Username and Password are sent with basic authentication.
+	Username, Password string
+	// Hosts are the host names that the credentials are sent to.
+	Hosts []string
+}
+
+var auth Auth
+
+// SetAuth sets the credentials of the fetches. It is
+// meant to be called once, before any fetch is made.
+func SetAuth(a Auth) {
+	auth = a
+}
+
+var propagateTrace bool
+
+// SetTracePropagation sets whether fetches send the traceparent
+// header of their span upstream, which is off by default so that
+// trace IDs are not leaked to third party servers. Like SetAuth, it
+// is meant to be called once, before any fetch is made.
+func SetTracePropagation(enabled bool) {
+	propagateTrace = enabled
+}
+
+// Fetch makes a GET request to the given URL. It also appends an
+// authentication token if GCP_SERVERLESS env is set to true, or
+// the credentials of SetAuth if they are meant for the URL host.
 func Fetch(ctx context.Context, url string) (*http.Response, error) {
+	return FetchWithHeader(ctx, url, nil)
+}
+
+// FetchWithHeader is like Fetch but adds the given
+// headers to the request, such as a Range header.
+func FetchWithHeader(ctx context.Context, url string, header http.Header) (*http.Response, error) {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return nil, err
 	}
 	req = req.WithContext(ctx)
+	for k, vv := range header {
+		for _, v := range vv {
+			req.Header.Add(k, v)
+		}
+	}
 
 	if os.Getenv("GCP_SERVERLESS") == "true" {
 		u, err := neturl.Parse(url)
@@ -32,9 +94,96 @@ func Fetch(ctx context.Context, url string) (*http.Response, error) {
 			return nil, err
 		}
 		req.Header.Set("Authorization", "Bearer "+tok)
+	} else if auth.authorizes(req.URL.Hostname()) {
+		if auth.Token != "" {
+			req.Header.Set("Authorization", "Bearer "+auth.Token)
+		} else {
+			req.SetBasicAuth(auth.Username, auth.Password)
+		}
+	}
+
+	ep := endpoint(req.URL.Path)
+	logURL := redact(req.URL)
+	ctx, span := logging.StartSpan(ctx, "fetch", "endpoint", ep, "url", logURL)
+	if sc, ok := logging.SpanContextFromContext(ctx); ok && propagateTrace {
+		req.Header.Set("traceparent", sc.TraceParent())
+	}
+	start := time.Now()
+	resp, err := http.DefaultClient.Do(req)
+	if ue, ok := err.(*neturl.Error); ok {
+		// the error is logged and returned all the way to the client
+		ue.URL = logURL
+	}
+	fetchDuration.Observe(time.Since(start).Seconds(), ep)
+	span.End(err)
+	logger := logging.FromContext(ctx)
+	if err != nil {
+		fetchErrors.Inc(ep)
+		logger.Debug("fetch failed", "url", logURL, "duration", time.Since(start), "error", err)
+		return nil, err
+	}
+	logger.Debug("fetched", "url", logURL, "status", resp.StatusCode, "duration", time.Since(start))
+	if resp.StatusCode >= 400 && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusGone {
+		fetchErrors.Inc(ep)
 	}
+	resp.Body = &countingBody{resp.Body, ep}
+	return resp, nil
+}
+
+// redact returns the URL without the user information or the
+// query, which may hold credentials, to be logged or traced.
+func redact(u *neturl.URL) string {
+	return u.Scheme + "://" + u.Host + u.Path
+}
 
-	return http.DefaultClient.Do(req)
+// endpoint returns the type of the GOPROXY, checksum
+// database or index endpoint of a URL path.
+func endpoint(path string) string {
+	switch {
+	case strings.HasSuffix(path, ".zip"):
+		return "zip"
+	case strings.HasSuffix(path, "/@v/list"):
+		return "list"
+	case strings.HasSuffix(path, "/@latest"):
+		return "latest"
+	case strings.HasSuffix(path, ".info"):
+		return "info"
+	case strings.HasSuffix(path, ".mod"):
+		return "mod"
+	case strings.HasSuffix(path, "/catalog"):
+		return "catalog"
+	case strings.Contains(path, "/lookup/"), strings.Contains(path, "/tile/"):
+		return "sumdb"
+	case strings.HasSuffix(path, "/index"):
+		return "index"
+	}
+	return "other"
+}
+
+// countingBody counts the bytes read from a response body.
+type countingBody struct {
+	io.ReadCloser
+	endpoint string
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		fetchBytes.Add(float64(n), b.endpoint)
+	}
+	return n, err
+}
+
+func (a *Auth) authorizes(host string) bool {
+	if a.Token == "" && a.Username == "" {
+		return false
+	}
+	for _, h := range a.Hosts {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
 }
 
 func getToken(audience string) (string, error) {
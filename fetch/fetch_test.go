@@ -0,0 +1,39 @@
+package fetch
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"marwan.io/moddoc/logging"
+)
+
+func TestFetchTraceAndRedaction(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+	}))
+	defer srv.Close()
+	defer SetTracePropagation(false)
+	for _, propagate := range []bool{false, true} {
+		SetTracePropagation(propagate)
+		var buf bytes.Buffer
+		ctx := logging.NewContext(context.Background(), logging.New(&buf, logging.Debug, false))
+		ctx = logging.WithTracer(ctx, logging.LogTracer())
+		url := strings.Replace(srv.URL, "http://", "http://user:secret@", 1) + "/example.com/mod/@v/list?token=secret"
+		resp, err := Fetch(ctx, url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if (traceparent != "") != propagate {
+			t.Fatalf("expected the traceparent to be sent: %v but got %q", propagate, traceparent)
+		}
+		if strings.Contains(buf.String(), "secret") || !strings.Contains(buf.String(), "/example.com/mod/@v/list") {
+			t.Fatalf("expected the logs to have the URL without its credentials but got:\n%v", buf.String())
+		}
+	}
+}
@@ -4,6 +4,5 @@ go 1.12
 
 require (
 	github.com/gorilla/mux v1.7.0
-	github.com/kelseyhightower/envconfig v1.3.0
 	github.com/rakyll/statik v0.1.6
 )
@@ -2,50 +2,40 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"time"
 
-	"marwan.io/moddoc/fetch"
+	"marwan.io/moddoc/index"
 )
 
-type indexResponse struct {
-	Path      string
-	Version   string
-	Timestamp time.Time
-}
-
-func index(ctx context.Context) ([]*moduleIndex, error) {
-	url := "https://index.golang.org/index"
-	resp, err := fetch.Fetch(ctx, url)
+func newIndexer() (*index.Indexer, error) {
+	s, err := index.Open(cfg.Index.Dir)
 	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("unexpected status: %v", resp.Status)
-	}
-
-	mp := map[string][]string{}
-	dec := json.NewDecoder(resp.Body)
-	for dec.More() {
-		var ir indexResponse
-		err := dec.Decode(&ir)
-		if err != nil {
-			return nil, err
-		}
-		mp[ir.Path] = append(mp[ir.Path], ir.Version)
+		return nil, fmt.Errorf("could not open index.dir: %v", err)
 	}
+	return &index.Indexer{
+		URL:      cfg.Index.URL,
+		Store:    s,
+		Interval: cfg.Index.Interval,
+	}, nil
+}
 
-	mods := []*moduleIndex{}
-
-	for mod, vers := range mp {
-		mods = append(mods, &moduleIndex{
-			Module:   mod,
-			Versions: vers,
-			Latest:   latestVer(vers),
+// runIndexer syncs the module index in the background until stop is
+// called. stop waits for the sync in progress to end and closes the
+// store, so that the entries written so far are on disk.
+func runIndexer(ix *index.Indexer) (stop func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ix.Run(ctx, func(err error) {
+			logger.Error("could not sync the module index", "error", err)
 		})
+	}()
+	return func() {
+		cancel()
+		<-done
+		if err := ix.Store.Close(); err != nil {
+			logger.Error("could not close the module index", "error", err)
+		}
 	}
-
-	return mods, nil
 }
@@ -0,0 +1,121 @@
+// Package index mirrors a Go module index feed such as
+// https://index.golang.org/index into a local store. The feed
+// is walked incrementally using its since cursor so that every
+// entry is eventually seen, not just the first page.
+package index
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"marwan.io/moddoc/fetch"
+)
+
+// DefaultLimit is the page size requested from the feed. It is
+// the maximum that index.golang.org accepts.
+const DefaultLimit = 2000
+
+// Entry is one line of an index feed.
+type Entry struct {
+	Path      string
+	Version   string
+	Timestamp time.Time
+}
+
+// Indexer walks an index feed and records its entries in a Store.
+type Indexer struct {
+	// URL is the index feed, for example https://index.golang.org/index
+	URL string
+	// Store receives the entries and provides the resume cursor.
+	Store *Store
+	// Interval is how long to wait between polls once
+	// the indexer has caught up with the feed.
+	Interval time.Duration
+	// Limit is the page size, DefaultLimit if zero.
+	Limit int
+}
+
+// Run syncs the store with the feed every Interval until
+// the context is canceled. Sync errors are reported to
+// onErr, if given, and do not stop the indexer.
+func (ix *Indexer) Run(ctx context.Context, onErr func(error)) error {
+	interval := ix.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	for {
+		_, err := ix.Sync(ctx)
+		if err != nil && onErr != nil && ctx.Err() == nil {
+			onErr(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// Sync fetches pages from the feed, starting at the store's cursor,
+// until it has caught up. It returns the number of new entries.
+func (ix *Indexer) Sync(ctx context.Context) (int, error) {
+	limit := ix.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	total := 0
+	for {
+		since := ix.Store.Cursor()
+		entries, err := ix.page(ctx, since, limit)
+		if err != nil {
+			return total, err
+		}
+		n, err := ix.Store.Add(entries)
+		total += n
+		if err != nil {
+			return total, err
+		}
+		// A short page means we are at the end of the feed. A full page that
+		// did not move the cursor means more than limit entries share one
+		// timestamp, which the since parameter cannot page through.
+		if len(entries) < limit || !ix.Store.Cursor().After(since) {
+			return total, nil
+		}
+	}
+}
+
+func (ix *Indexer) page(ctx context.Context, since time.Time, limit int) ([]Entry, error) {
+	u, err := url.Parse(ix.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid index url %q: %v", ix.URL, err)
+	}
+	q := u.Query()
+	if !since.IsZero() {
+		q.Set("since", since.Format(time.RFC3339Nano))
+	}
+	q.Set("limit", fmt.Sprint(limit))
+	u.RawQuery = q.Encode()
+	resp, err := fetch.Fetch(ctx, u.String())
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("unexpected status from %v: %v", u, resp.Status)
+	}
+	entries := []Entry{}
+	dec := json.NewDecoder(bufio.NewReader(resp.Body))
+	for dec.More() {
+		var e Entry
+		err := dec.Decode(&e)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode index entry: %v", err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
@@ -0,0 +1,193 @@
+package index
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func feed(t *testing.T, entries []Entry) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var since time.Time
+		if s := r.URL.Query().Get("since"); s != "" {
+			var err error
+			since, err = time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				t.Errorf("bad since %q: %v", s, err)
+			}
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		enc := json.NewEncoder(w)
+		n := 0
+		for _, e := range entries {
+			if e.Timestamp.Before(since) {
+				continue
+			}
+			if n == limit {
+				break
+			}
+			enc.Encode(e)
+			n++
+		}
+	}))
+}
+
+func testEntries(n int) []Entry {
+	start := time.Date(2019, 4, 10, 0, 0, 0, 0, time.UTC)
+	entries := []Entry{}
+	for i := 0; i < n; i++ {
+		entries = append(entries, Entry{
+			Path:      "example.com/mod" + strconv.Itoa(i%3),
+			Version:   "v1.0." + strconv.Itoa(i),
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+		})
+	}
+	return entries
+}
+
+func TestSyncPagesThroughFeed(t *testing.T) {
+	entries := testEntries(7)
+	srv := feed(t, entries)
+	defer srv.Close()
+
+	s, _ := Open("")
+	ix := &Indexer{URL: srv.URL, Store: s, Limit: 2}
+	n, err := ix.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(entries) || s.Len() != len(entries) {
+		t.Fatalf("expected %d entries but got %d new and %d stored", len(entries), n, s.Len())
+	}
+	if !s.Cursor().Equal(entries[6].Timestamp) {
+		t.Fatalf("expected cursor %v but got %v", entries[6].Timestamp, s.Cursor())
+	}
+	if got := len(s.Modules()["example.com/mod1"]); got != 2 {
+		t.Fatalf("expected 2 versions of example.com/mod1 but got %d", got)
+	}
+}
+
+func TestStoreResumes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moddoc-index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	entries := testEntries(5)
+
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Add(entries[:3])
+	s.Close()
+
+	s, err = Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	if s.Len() != 3 || !s.Cursor().Equal(entries[2].Timestamp) {
+		t.Fatalf("expected 3 entries up to %v but got %d up to %v", entries[2].Timestamp, s.Len(), s.Cursor())
+	}
+
+	srv := feed(t, entries)
+	defer srv.Close()
+	n, err := (&Indexer{URL: srv.URL, Store: s}).Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 || s.Len() != 5 {
+		t.Fatalf("expected 2 new entries for a total of 5 but got %d and %d", n, s.Len())
+	}
+}
+
+func TestStoreDropsTruncatedLine(t *testing.T) {
+	entries := testEntries(3)
+	var log []byte
+	for _, e := range entries {
+		bts, _ := json.Marshal(e)
+		log = append(append(log, bts...), '\n')
+	}
+	good := len(log)
+	for _, tc := range []struct {
+		name    string
+		tail    string
+		entries int
+		err     bool
+	}{
+		{"complete", "", 3, false},
+		{"cut short", `{"Path":"example.com/mod0","Vers`, 3, false},
+		{"missing newline", `{"Path":"example.com/mod0","Version":"v1.0.9"}`, 3, false},
+		{"garbage", "\x00\x00\x00\n", 3, false},
+		{"malformed middle line", "nope\n" + string(log[:good/3]), 0, true},
+	} {
+		dir, err := ioutil.TempDir("", "moddoc-index")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		name := filepath.Join(dir, storeFile)
+		ioutil.WriteFile(name, append(append([]byte{}, log...), tc.tail...), 0644)
+		s, err := Open(dir)
+		if tc.err != (err != nil) {
+			t.Fatalf("%v: expected an error: %v but got %v", tc.name, tc.err, err)
+		}
+		if err != nil {
+			continue
+		}
+		if s.Len() != tc.entries {
+			t.Fatalf("%v: expected %d entries but got %d", tc.name, tc.entries, s.Len())
+		}
+		s.Add(testEntries(4)[3:])
+		s.Close()
+		s, err = Open(dir)
+		if err != nil {
+			t.Fatalf("%v: expected the store to reopen but got %v", tc.name, err)
+		}
+		if s.Len() != tc.entries+1 {
+			t.Fatalf("%v: expected %d entries after a reopen but got %d", tc.name, tc.entries+1, s.Len())
+		}
+		s.Close()
+	}
+}
+
+func TestStoreAddFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moddoc-index")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s, err := Open(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.f.Close()
+	n, err := s.Add(testEntries(2))
+	if err == nil || n != 0 || s.Len() != 0 {
+		t.Fatalf("expected a failed write to add nothing but added %d with %v", n, err)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	s, _ := Open("")
+	s.Add([]Entry{
+		{Path: "github.com/pkg/errors", Version: "v0.8.1"},
+		{Path: "github.com/Pkg/Term", Version: "v1.0.0"},
+		{Path: "golang.org/x/text", Version: "v0.3.0"},
+	})
+	if got := len(s.Search("PKG", 0)); got != 2 {
+		t.Fatalf("expected 2 results but got %d", got)
+	}
+	res := s.Search("", 1)
+	if _, ok := res["github.com/Pkg/Term"]; !ok || len(res) != 1 {
+		t.Fatalf("expected the first module alphabetically but got %v", res)
+	}
+}
@@ -0,0 +1,204 @@
+package index
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"marwan.io/moddoc/logging"
+)
+
+const storeFile = "index.jsonl"
+
+// Store holds every entry seen by an Indexer. When backed by a
+// directory, entries are appended to a log file so that the
+// indexer resumes from where it left off after a restart.
+type Store struct {
+	mu     sync.RWMutex
+	f      *os.File
+	seen   map[string]struct{}
+	mods   map[string][]string
+	cursor time.Time
+}
+
+// Open returns a Store persisted in dir. The directory is created
+// if needed and any existing entries are loaded. An empty dir
+// returns a Store that only lives in memory.
+func Open(dir string) (*Store, error) {
+	s := &Store{
+		seen: map[string]struct{}{},
+		mods: map[string][]string{},
+	}
+	if dir == "" {
+		return s, nil
+	}
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+	name := filepath.Join(dir, storeFile)
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	// A write that was cut short, by a crash for instance, leaves a
+	// malformed last line behind: it is dropped so that the next sync
+	// of the feed fetches its entries again.
+	r := bufio.NewReader(f)
+	var off int64
+	for line := 1; ; line++ {
+		bts, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			f.Close()
+			return nil, err
+		}
+		if len(bytes.TrimSpace(bts)) != 0 {
+			var e Entry
+			jsonErr := json.Unmarshal(bts, &e)
+			if jsonErr == nil && err == io.EOF {
+				jsonErr = errors.New("missing newline")
+			}
+			if jsonErr != nil {
+				if _, peekErr := r.Peek(1); peekErr != io.EOF {
+					f.Close()
+					return nil, fmt.Errorf("%v:%d: %v", name, line, jsonErr)
+				}
+				logging.Default().Warn("dropping the malformed last line of the index", "file", name, "line", line, "error", jsonErr)
+				if err := f.Truncate(off); err != nil {
+					f.Close()
+					return nil, fmt.Errorf("could not truncate %v: %v", name, err)
+				}
+				break
+			}
+			s.add(e)
+		}
+		if err == io.EOF {
+			break
+		}
+		off += int64(len(bts))
+	}
+	s.f = f
+	return s, nil
+}
+
+// Add records the given entries, skipping the ones already
+// in the store, and returns how many were new. Entries are
+// only marked as seen once they were written to the log file.
+func (s *Store) Add(entries []Entry) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	added := []Entry{}
+	batch := map[string]struct{}{}
+	for _, e := range entries {
+		key := e.key()
+		if _, ok := s.seen[key]; ok {
+			continue
+		}
+		if _, ok := batch[key]; ok {
+			continue
+		}
+		batch[key] = struct{}{}
+		added = append(added, e)
+	}
+	if s.f != nil && len(added) > 0 {
+		w := bufio.NewWriter(s.f)
+		for _, e := range added {
+			bts, err := json.Marshal(e)
+			if err != nil {
+				return 0, err
+			}
+			w.Write(bts)
+			w.WriteByte('\n')
+		}
+		err := w.Flush()
+		if err != nil {
+			return 0, err
+		}
+	}
+	for _, e := range added {
+		s.add(e)
+	}
+	return len(added), nil
+}
+
+func (e Entry) key() string {
+	return e.Path + "@" + e.Version
+}
+
+func (s *Store) add(e Entry) {
+	key := e.key()
+	if _, ok := s.seen[key]; ok {
+		return
+	}
+	s.seen[key] = struct{}{}
+	s.mods[e.Path] = append(s.mods[e.Path], e.Version)
+	if e.Timestamp.After(s.cursor) {
+		s.cursor = e.Timestamp
+	}
+}
+
+// Cursor returns the timestamp of the newest entry in the store,
+// which is where the next sync of the feed should start.
+func (s *Store) Cursor() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.cursor
+}
+
+// Len returns the number of module versions in the store.
+func (s *Store) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.seen)
+}
+
+// Modules returns every module path in the store
+// mapped to its known versions.
+func (s *Store) Modules() map[string][]string {
+	return s.Search("", 0)
+}
+
+// Search returns the modules whose path contains q, case insensitively,
+// mapped to their known versions. A limit greater than zero caps the
+// number of modules returned, keeping the alphabetically first ones.
+func (s *Store) Search(q string, limit int) map[string][]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	q = strings.ToLower(q)
+	paths := []string{}
+	for path := range s.mods {
+		if strings.Contains(strings.ToLower(path), q) {
+			paths = append(paths, path)
+		}
+	}
+	if limit > 0 && len(paths) > limit {
+		sort.Strings(paths)
+		paths = paths[:limit]
+	}
+	mp := make(map[string][]string, len(paths))
+	for _, path := range paths {
+		mp[path] = append([]string(nil), s.mods[path]...)
+	}
+	return mp
+}
+
+// Close releases the underlying log file, if any.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
@@ -0,0 +1,122 @@
+// Package license detects which licenses the LICENSE files of a module
+// grant, identifying them by their SPDX identifier. Files are compared
+// with the bundled texts of common licenses word by word, so that
+// differences in formatting, copyright lines and small edits do not
+// get in the way.
+package license
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Unknown is reported for files that do not match any known license.
+const Unknown = "UNKNOWN"
+
+// threshold is the share of a license text that must be
+// found in a file for the file to be detected as that license.
+const threshold = 0.88
+
+// shingleSize is the number of consecutive words compared at once.
+const shingleSize = 3
+
+type template struct {
+	id       string
+	shingles map[string]bool
+}
+
+var templates = func() []*template {
+	ts := make([]*template, 0, len(texts))
+	for _, t := range texts {
+		ts = append(ts, &template{id: t.id, shingles: shingles(t.text)})
+	}
+	return ts
+}()
+
+// Detect returns the sorted SPDX identifiers of the licenses found in
+// the contents of a license file, or Unknown if there are none.
+func Detect(content []byte) []string {
+	file := shingles(string(content))
+	type match struct {
+		id      string
+		matched map[string]bool
+	}
+	var matches []*match
+	for _, t := range templates {
+		matched := map[string]bool{}
+		for s := range t.shingles {
+			if file[s] {
+				matched[s] = true
+			}
+		}
+		if float64(len(matched)) >= threshold*float64(len(t.shingles)) {
+			matches = append(matches, &match{id: t.id, matched: matched})
+		}
+	}
+	// prefer the licenses that explain most of the file, and skip the
+	// ones whose text is mostly a part of an already detected one, like
+	// BSD-2-Clause is a part of BSD-3-Clause.
+	sort.Slice(matches, func(i, j int) bool {
+		return len(matches[i].matched) > len(matches[j].matched)
+	})
+	covered := map[string]bool{}
+	ids := map[string]bool{}
+	for _, m := range matches {
+		overlap := 0
+		for s := range m.matched {
+			if covered[s] {
+				overlap++
+			}
+		}
+		if 2*overlap > len(m.matched) {
+			continue
+		}
+		for s := range m.matched {
+			covered[s] = true
+		}
+		ids[m.id] = true
+	}
+	if len(ids) == 0 {
+		return []string{Unknown}
+	}
+	list := make([]string, 0, len(ids))
+	for id := range ids {
+		list = append(list, id)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// IsLicenseFile reports whether the base name of
+// a file is one that usually holds a license.
+func IsLicenseFile(base string) bool {
+	stem := strings.ToUpper(base)
+	if i := strings.IndexAny(stem, ".-_"); i >= 0 {
+		stem = stem[:i]
+	}
+	return stem == "LICENSE" || stem == "LICENCE" || stem == "COPYING"
+}
+
+var wordRx = regexp.MustCompile(`[a-z0-9]+`)
+
+// words normalizes text to its lower case words,
+// with British spellings of license made American.
+func words(text string) []string {
+	ws := wordRx.FindAllString(strings.ToLower(text), -1)
+	for i, w := range ws {
+		if w == "licence" {
+			ws[i] = "license"
+		}
+	}
+	return ws
+}
+
+func shingles(text string) map[string]bool {
+	ws := words(text)
+	set := make(map[string]bool, len(ws))
+	for i := 0; i+shingleSize <= len(ws); i++ {
+		set[strings.Join(ws[i:i+shingleSize], " ")] = true
+	}
+	return set
+}
@@ -0,0 +1,171 @@
+package license
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/modgraph"
+)
+
+const bsd3Go = `Copyright (c) 2009 The Go Authors. All rights reserved.
+
+Redistribution and use in source and binary forms, with or without
+modification, are permitted provided that the following conditions are
+met:
+
+   * Redistributions of source code must retain the above copyright
+notice, this list of conditions and the following disclaimer.
+   * Redistributions in binary form must reproduce the above
+copyright notice, this list of conditions and the following disclaimer
+in the documentation and/or other materials provided with the
+distribution.
+   * Neither the name of Google Inc. nor the names of its
+contributors may be used to endorse or promote products derived from
+this software without specific prior written permission.
+
+THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND CONTRIBUTORS
+"AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES, INCLUDING, BUT NOT
+LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY AND FITNESS FOR
+A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT
+OWNER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF USE,
+DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND ON ANY
+THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+(INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+`
+
+func text(id string) string {
+	for _, t := range texts {
+		if t.id == id {
+			return t.text
+		}
+	}
+	panic("no text for " + id)
+}
+
+var detectTestCases = []struct {
+	name     string
+	content  string
+	expected []string
+}{
+	{"go bsd", bsd3Go, []string{"BSD-3-Clause"}},
+	{"bsd 2", "Copyright 2019 Someone\n" + text("BSD-2-Clause"), []string{"BSD-2-Clause"}},
+	{"mit", "MIT License\n\nCopyright (c) 2019 Someone\n\n" + text("MIT"), []string{"MIT"}},
+	{"isc", "Copyright (c) 2019 Someone\n" + text("ISC"), []string{"ISC"}},
+	{"0bsd", text("0BSD"), []string{"0BSD"}},
+	{"agpl", text("AGPL-3.0"), []string{"AGPL-3.0"}},
+	{"apache notice", "Copyright 2019 Someone\n\n" + text("Apache-2.0"), []string{"Apache-2.0"}},
+	{"dual", text("MIT") + "\n---\n" + text("Unlicense"), []string{"MIT", "Unlicense"}},
+	{"british", "Permission is hereby granted under this licence:\n" + text("MIT"), []string{"MIT"}},
+	{"unknown", "All rights reserved. Do not copy.", []string{Unknown}},
+	{"truncated", text("MIT")[:300], []string{Unknown}},
+}
+
+func TestDetect(t *testing.T) {
+	for _, tc := range detectTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Detect([]byte(tc.content))
+			if fmt.Sprint(got) != fmt.Sprint(tc.expected) {
+				t.Fatalf("expected %v but got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsLicenseFile(t *testing.T) {
+	for name, expected := range map[string]bool{
+		"LICENSE":        true,
+		"LICENSE.md":     true,
+		"license.txt":    true,
+		"LICENCE":        true,
+		"COPYING":        true,
+		"LICENSE-APACHE": true,
+		"README.md":      false,
+		"licenses.go":    false,
+	} {
+		if got := IsLicenseFile(name); got != expected {
+			t.Fatalf("expected IsLicenseFile(%q) to be %v", name, expected)
+		}
+	}
+}
+
+var policyTestCases = []struct {
+	policy   *Policy
+	types    []string
+	expected Status
+}{
+	{nil, []string{"GPL-3.0"}, StatusAllowed},
+	{nil, nil, StatusUnknown},
+	{&Policy{Deny: []string{"gpl-3.0-or-later"}}, []string{"MIT", "GPL-3.0"}, StatusDenied},
+	{&Policy{Deny: []string{"AGPL-3.0"}}, []string{"MIT", Unknown}, StatusUnknown},
+	{&Policy{Allow: []string{"MIT"}}, []string{"MIT"}, StatusAllowed},
+	{&Policy{Allow: []string{"MIT"}}, []string{"ISC"}, StatusDenied},
+	{&Policy{Allow: []string{"MIT"}}, []string{Unknown, "ISC"}, StatusDenied},
+}
+
+func TestPolicy(t *testing.T) {
+	for idx, tc := range policyTestCases {
+		if got := tc.policy.Check(tc.types); got != tc.expected {
+			t.Fatalf("%d: expected %v but got %v", idx, tc.expected, got)
+		}
+	}
+}
+
+type fakeSource map[string][]*proxydoc.License
+
+func (fs fakeSource) GetLicenses(ctx context.Context, mod, ver string) ([]*proxydoc.License, error) {
+	l, ok := fs[mod+"@"+ver]
+	if !ok {
+		return nil, fmt.Errorf("%v@%v not found", mod, ver)
+	}
+	return l, nil
+}
+
+func TestReport(t *testing.T) {
+	src := fakeSource{
+		"example.com/app@v1.0.0":  {{Path: "LICENSE", Types: []string{"MIT"}}},
+		"example.com/a@v1.0.0":    {{Path: "LICENSE", Types: []string{"Apache-2.0"}}},
+		"example.com/b@v1.2.0":    {{Path: "COPYING", Types: []string{"AGPL-3.0"}}},
+		"example.com/fork@v1.0.1": {{Path: "LICENSE", Types: []string{"MIT"}}, {Path: "LICENSE-APACHE", Types: []string{"Apache-2.0"}}},
+		"example.com/d@v0.1.0":    nil,
+	}
+	g := &modgraph.Graph{
+		Main: module.Version{Path: "example.com/app", Version: "v1.0.0"},
+		Modules: []*modgraph.Module{
+			{Path: "example.com/a", Version: "v1.0.0"},
+			{Path: "example.com/b", Version: "v1.2.0"},
+			{Path: "example.com/c", Version: "v1.0.0", Replace: &module.Version{Path: "example.com/fork", Version: "v1.0.1"}},
+			{Path: "example.com/d", Version: "v0.1.0"},
+			{Path: "example.com/e", Version: "v1.0.0"},
+			{Path: "example.com/f", Version: "v1.0.0", Replace: &module.Version{Path: "../f"}},
+		},
+	}
+	r := NewReport(context.Background(), src, g, &Policy{Deny: []string{"AGPL-3.0"}})
+	got := []string{fmt.Sprintf("%v%v %v", r.Main.Path, r.Main.Types, r.Main.Status)}
+	for _, m := range r.Modules {
+		got = append(got, fmt.Sprintf("%v%v %v", m.Path, m.Types, m.Status))
+	}
+	expected := []string{
+		"example.com/app[MIT] allowed",
+		"example.com/a[Apache-2.0] allowed",
+		"example.com/b[AGPL-3.0] denied",
+		"example.com/c[Apache-2.0 MIT] allowed",
+		"example.com/d[] unknown",
+		"example.com/e[] unknown",
+		"example.com/f[] unknown",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Fatalf("expected %v but got %v", expected, got)
+	}
+	if r.Denied != 1 || r.Unknown != 3 {
+		t.Fatalf("expected 1 denied and 3 unknown but got %v and %v", r.Denied, r.Unknown)
+	}
+	if r.Modules[4].Error == "" || r.Modules[5].Error == "" {
+		t.Fatal("expected errors for modules without licenses to read")
+	}
+}
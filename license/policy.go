@@ -0,0 +1,86 @@
+package license
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// Status is the verdict of a Policy on a set of licenses.
+type Status string
+
+// The statuses of a module under a Policy.
+const (
+	StatusAllowed Status = "allowed"
+	StatusDenied  Status = "denied"
+	StatusUnknown Status = "unknown"
+)
+
+// Policy lists the licenses that are allowed and denied. It is read
+// from a JSON file such as:
+//
+//	{
+//		"allow": ["MIT", "BSD-3-Clause", "Apache-2.0"],
+//		"deny": ["AGPL-3.0"]
+//	}
+//
+// Licenses are SPDX identifiers, compared case insensitively and
+// regardless of -only and -or-later suffixes. When the allow list is
+// empty, every license that is not denied is allowed.
+type Policy struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// LoadPolicy reads a Policy from a JSON file.
+func LoadPolicy(file string) (*Policy, error) {
+	bts, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var p Policy
+	err = json.Unmarshal(bts, &p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid license policy %v: %v", file, err)
+	}
+	return &p, nil
+}
+
+// Check returns the status of a module given the licenses detected in
+// its license files. It is denied if any of them is denied or not in
+// the allow list, and unknown if there are none or one is unknown. A
+// nil Policy allows every known license.
+func (p *Policy) Check(types []string) Status {
+	if len(types) == 0 {
+		return StatusUnknown
+	}
+	status := StatusAllowed
+	for _, t := range types {
+		switch {
+		case t == Unknown:
+			status = StatusUnknown
+		case p == nil:
+		case contains(p.Deny, t), len(p.Allow) > 0 && !contains(p.Allow, t):
+			return StatusDenied
+		}
+	}
+	return status
+}
+
+func contains(list []string, id string) bool {
+	id = normalizeID(id)
+	for _, l := range list {
+		if normalizeID(l) == id {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeID(id string) string {
+	id = strings.ToLower(strings.TrimSpace(id))
+	id = strings.TrimSuffix(id, "+")
+	id = strings.TrimSuffix(id, "-only")
+	return strings.TrimSuffix(id, "-or-later")
+}
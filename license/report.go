@@ -0,0 +1,117 @@
+package license
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/modgraph"
+)
+
+// parallelism is the number of modules whose licenses are fetched at once.
+const parallelism = 8
+
+// Source returns the license files at the root of a module version,
+// given the encoded module path and version. It is satisfied by
+// proxy.Service.
+type Source interface {
+	GetLicenses(ctx context.Context, mod, ver string) ([]*proxydoc.License, error)
+}
+
+// Report lists the licenses of a main module and of every
+// module version MVS selects in its dependency graph.
+type Report struct {
+	Main    *ModuleLicenses
+	Modules []*ModuleLicenses
+	// Denied and Unknown count the dependencies with those statuses.
+	Denied  int
+	Unknown int
+}
+
+// ModuleLicenses are the licenses of a module version and
+// the status the policy gives them.
+type ModuleLicenses struct {
+	Path    string
+	Version string
+	// Replace is the module version the licenses were read
+	// from, if the main module replaces this one.
+	Replace *module.Version
+	Types   []string
+	Files   []string
+	Status  Status
+	// Error explains why the licenses could not be read, in
+	// which case the status is unknown.
+	Error string
+}
+
+// NewReport reads the licenses of the modules of g and checks them against p.
+func NewReport(ctx context.Context, src Source, g *modgraph.Graph, p *Policy) *Report {
+	r := &Report{Main: &ModuleLicenses{Path: g.Main.Path, Version: g.Main.Version}}
+	for _, m := range g.Modules {
+		r.Modules = append(r.Modules, &ModuleLicenses{Path: m.Path, Version: m.Version, Replace: m.Replace})
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+	for _, ml := range append([]*ModuleLicenses{r.Main}, r.Modules...) {
+		wg.Add(1)
+		go func(ml *ModuleLicenses) {
+			defer wg.Done()
+			sem <- struct{}{}
+			ml.read(ctx, src, p)
+			<-sem
+		}(ml)
+	}
+	wg.Wait()
+	for _, ml := range r.Modules {
+		switch ml.Status {
+		case StatusDenied:
+			r.Denied++
+		case StatusUnknown:
+			r.Unknown++
+		}
+	}
+	return r
+}
+
+func (ml *ModuleLicenses) read(ctx context.Context, src Source, p *Policy) {
+	target := module.Version{Path: ml.Path, Version: ml.Version}
+	if ml.Replace != nil {
+		target = *ml.Replace
+	}
+	ml.Status = StatusUnknown
+	if target.Version == "" {
+		ml.Error = "replaced by the local directory " + target.Path
+		return
+	}
+	licenses, err := getLicenses(ctx, src, target)
+	if err != nil {
+		ml.Error = err.Error()
+		return
+	}
+	seen := map[string]bool{}
+	for _, l := range licenses {
+		ml.Files = append(ml.Files, l.Path)
+		for _, t := range l.Types {
+			if !seen[t] {
+				seen[t] = true
+				ml.Types = append(ml.Types, t)
+			}
+		}
+	}
+	sort.Strings(ml.Types)
+	ml.Status = p.Check(ml.Types)
+}
+
+func getLicenses(ctx context.Context, src Source, m module.Version) ([]*proxydoc.License, error) {
+	encPath, err := module.EncodePath(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	encVer, err := module.EncodeVersion(m.Version)
+	if err != nil {
+		return nil, err
+	}
+	return src.GetLicenses(ctx, encPath, encVer)
+}
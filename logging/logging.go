@@ -0,0 +1,208 @@
+// Package logging writes leveled, structured logs and traces
+// the stages of the requests of moddoc with spans.
+//
+// A Logger is carried by the context of a request, so that every
+// log line of the request has its fields, such as its request ID and
+// the module version being documented.
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// Level is the severity of a log line.
+type Level int
+
+// The levels, from the most verbose.
+const (
+	Debug Level = iota
+	Info
+	Warn
+	Error
+)
+
+var levelNames = []string{"debug", "info", "warn", "error"}
+
+func (l Level) String() string {
+	if l < Debug || l > Error {
+		return "level(" + strconv.Itoa(int(l)) + ")"
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the level of the name debug, info, warn or error.
+func ParseLevel(name string) (Level, error) {
+	for i, n := range levelNames {
+		if strings.EqualFold(name, n) {
+			return Level(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", name)
+}
+
+// Logger writes the log lines of its level or more severe ones,
+// with its fields. A nil *Logger discards every line.
+type Logger struct {
+	out    *output
+	level  Level
+	json   bool
+	fields []interface{}
+}
+
+type output struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// New returns a Logger that writes the lines of level or more
+// severe ones to w, as logfmt text or as JSON objects if json is set.
+func New(w io.Writer, level Level, json bool) *Logger {
+	return &Logger{out: &output{w: w}, level: level, json: json}
+}
+
+// With returns a Logger that adds the key value pairs to every line.
+func (l *Logger) With(kv ...interface{}) *Logger {
+	if l == nil {
+		return nil
+	}
+	cp := *l
+	cp.fields = append(append([]interface{}(nil), l.fields...), kv...)
+	return &cp
+}
+
+// Enabled reports whether the lines of level are written.
+func (l *Logger) Enabled(level Level) bool {
+	return l != nil && level >= l.level
+}
+
+// Debug logs details meant for debugging moddoc.
+func (l *Logger) Debug(msg string, kv ...interface{}) { l.log(Debug, msg, kv) }
+
+// Info logs the normal operation of moddoc.
+func (l *Logger) Info(msg string, kv ...interface{}) { l.log(Info, msg, kv) }
+
+// Warn logs errors that moddoc works around.
+func (l *Logger) Warn(msg string, kv ...interface{}) { l.log(Warn, msg, kv) }
+
+// Error logs errors that fail a request or a task.
+func (l *Logger) Error(msg string, kv ...interface{}) { l.log(Error, msg, kv) }
+
+func (l *Logger) log(level Level, msg string, kv []interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+	fields := append([]interface{}{
+		"time", time.Now().UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		"level", level.String(),
+		"msg", msg,
+	}, l.fields...)
+	fields = append(fields, kv...)
+	if len(fields)%2 != 0 {
+		fields = append(fields, "(missing)")
+	}
+	var sb strings.Builder
+	if l.json {
+		writeJSON(&sb, fields)
+	} else {
+		writeText(&sb, fields)
+	}
+	sb.WriteByte('\n')
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	io.WriteString(l.out.w, sb.String())
+}
+
+func writeText(sb *strings.Builder, fields []interface{}) {
+	for i := 0; i < len(fields); i += 2 {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(fmt.Sprint(fields[i]))
+		sb.WriteByte('=')
+		v := fmt.Sprint(value(fields[i+1]))
+		if v == "" || strings.IndexFunc(v, needsQuote) >= 0 {
+			v = strconv.Quote(v)
+		}
+		sb.WriteString(v)
+	}
+}
+
+func needsQuote(r rune) bool {
+	return r == '=' || r == '"' || unicode.IsSpace(r) || !unicode.IsPrint(r)
+}
+
+func writeJSON(sb *strings.Builder, fields []interface{}) {
+	sb.WriteByte('{')
+	for i := 0; i < len(fields); i += 2 {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		k, _ := json.Marshal(fmt.Sprint(fields[i]))
+		sb.Write(k)
+		sb.WriteByte(':')
+		v, err := json.Marshal(value(fields[i+1]))
+		if err != nil {
+			v, _ = json.Marshal(fmt.Sprint(fields[i+1]))
+		}
+		sb.Write(v)
+	}
+	sb.WriteByte('}')
+}
+
+// value returns the logged form of v.
+func value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case error:
+		return v.Error()
+	case time.Duration:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	}
+	return v
+}
+
+type loggerKey struct{}
+
+// NewContext returns a context that carries l.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the Logger of the context, or the
+// Default one if the context does not carry a Logger.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*Logger); ok {
+		return l
+	}
+	return Default()
+}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger *Logger
+)
+
+// Default returns the Logger set by SetDefault, which is
+// nil, and so discards every line, until it is called.
+func Default() *Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// SetDefault sets the Logger of the work that
+// is not done for a request, such as startup.
+func SetDefault(l *Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
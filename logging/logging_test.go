@@ -0,0 +1,122 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var textTestCases = []struct {
+	name   string
+	kv     []interface{}
+	expect string
+}{
+	{"plain", []interface{}{"module", "example.com/lib"}, "module=example.com/lib"},
+	{"quoted", []interface{}{"error", errors.New("not found: x")}, `error="not found: x"`},
+	{"empty", []interface{}{"version", ""}, `version=""`},
+	{"duration", []interface{}{"duration", 1500 * time.Millisecond}, "duration=1.5s"},
+	{"number", []interface{}{"status", 404}, "status=404"},
+	{"missing value", []interface{}{"key"}, "key=(missing)"},
+}
+
+func TestText(t *testing.T) {
+	for _, tc := range textTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			New(&buf, Info, false).With("request_id", "abc").Info("built doc", tc.kv...)
+			got := buf.String()
+			if !strings.Contains(got, `level=info msg="built doc" request_id=abc `+tc.expect+"\n") {
+				t.Fatalf("expected the line to end with %v but got %v", tc.expect, got)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var buf bytes.Buffer
+	New(&buf, Debug, true).With("module", "example.com/lib").Warn("could not list versions", "error", errors.New("timeout"))
+	var line map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected a JSON line but got %v: %v", buf.String(), err)
+	}
+	if line["level"] != "warn" || line["module"] != "example.com/lib" || line["error"] != "timeout" {
+		t.Fatalf("expected the fields of the line but got %v", line)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	var buf bytes.Buffer
+	l := New(&buf, Warn, false)
+	l.Debug("debug")
+	l.Info("info")
+	l.Error("error")
+	if got := strings.Count(buf.String(), "\n"); got != 1 || !strings.Contains(buf.String(), "level=error") {
+		t.Fatalf("expected only the error line but got %v", buf.String())
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+	if lvl, err := ParseLevel("DEBUG"); err != nil || lvl != Debug {
+		t.Fatalf("expected debug but got %v, %v", lvl, err)
+	}
+
+	var nilLogger *Logger
+	nilLogger.With("a", "b").Error("discarded")
+	if FromContext(context.Background()) != Default() {
+		t.Fatal("expected the Default logger of a context without one")
+	}
+}
+
+var traceParentTestCases = []struct {
+	header string
+	ok     bool
+}{
+	{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", true},
+	{"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future", true},
+	{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", false},
+	{"00-00000000000000000000000000000000-00f067aa0ba902b7-01", false},
+	{"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa-01", false},
+	{"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", false},
+	{"garbage", false},
+}
+
+func TestParseTraceParent(t *testing.T) {
+	for _, tc := range traceParentTestCases {
+		sc, ok := ParseTraceParent(tc.header)
+		if ok != tc.ok {
+			t.Fatalf("expected %v to be valid: %v", tc.header, tc.ok)
+		}
+		if ok && tc.header[:2] == "00" && sc.TraceParent() != tc.header {
+			t.Fatalf("expected %v but got %v", tc.header, sc.TraceParent())
+		}
+	}
+}
+
+func TestLogTracer(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := NewContext(context.Background(), New(&buf, Info, false))
+	if _, span := StartSpan(ctx, "fetch"); span == nil {
+		t.Fatal("expected a span without a tracer")
+	}
+	if buf.Len() != 0 {
+		t.Fatalf("expected no span to be logged without a tracer but got %v", buf.String())
+	}
+
+	parent, _ := ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx = ContextWithSpanContext(WithTracer(ctx, LogTracer()), parent)
+	childCtx, span := StartSpan(ctx, "parse", "files", 3)
+	child, ok := SpanContextFromContext(childCtx)
+	if !ok || child.TraceID != parent.TraceID || child.SpanID == parent.SpanID {
+		t.Fatalf("expected a child span of %v but got %v", parent.TraceParent(), child.TraceParent())
+	}
+	span.End(errors.New("bad file"))
+	for _, expect := range []string{"span=parse", "trace_id=4bf92f3577b34da6a3ce929d0e0e4736", "parent_id=00f067aa0ba902b7", "files=3", `error="bad file"`} {
+		if !strings.Contains(buf.String(), expect) {
+			t.Fatalf("expected the span to contain %v but got %v", expect, buf.String())
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// Tracer starts the spans of the stages of requests, such as fetch,
+// unzip, parse and render. Spans are identified in the W3C Trace
+// Context format, so that a Tracer can wrap an OpenTelemetry one to
+// export the spans of moddoc.
+type Tracer interface {
+	Start(ctx context.Context, name string, kv ...interface{}) (context.Context, Span)
+}
+
+// Span is a stage of a request.
+type Span interface {
+	// End ends the span, which failed if err is not nil.
+	End(err error)
+}
+
+type tracerKey struct{}
+
+// WithTracer returns a context whose spans are started by t.
+func WithTracer(ctx context.Context, t Tracer) context.Context {
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+// StartSpan starts a span with the Tracer of the context. The
+// span does nothing if the context does not carry a Tracer.
+func StartSpan(ctx context.Context, name string, kv ...interface{}) (context.Context, Span) {
+	t, ok := ctx.Value(tracerKey{}).(Tracer)
+	if !ok || t == nil {
+		return ctx, noopSpan{}
+	}
+	return t.Start(ctx, name, kv...)
+}
+
+type noopSpan struct{}
+
+func (noopSpan) End(error) {}
+
+// SpanContext identifies a span and its trace.
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpanContext returns a context whose
+// spans are children of the span of sc.
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, sc)
+}
+
+// SpanContextFromContext returns the current span of the context.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// TraceParent formats sc as a traceparent header.
+func (sc SpanContext) TraceParent() string {
+	return "00-" + hex.EncodeToString(sc.TraceID[:]) + "-" + hex.EncodeToString(sc.SpanID[:]) + "-01"
+}
+
+// ParseTraceParent parses a traceparent header,
+// such as the one of a request to moddoc.
+func ParseTraceParent(h string) (SpanContext, bool) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(h), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return sc, false
+	}
+	tid, err := hex.DecodeString(parts[1])
+	if err != nil || len(tid) != len(sc.TraceID) {
+		return sc, false
+	}
+	sid, err := hex.DecodeString(parts[2])
+	if err != nil || len(sid) != len(sc.SpanID) {
+		return sc, false
+	}
+	copy(sc.TraceID[:], tid)
+	copy(sc.SpanID[:], sid)
+	if sc.TraceID == ([16]byte{}) || sc.SpanID == ([8]byte{}) {
+		return sc, false
+	}
+	return sc, true
+}
+
+// LogTracer returns a Tracer that logs every span when it ends, at
+// Info level with the Logger of the context, along with its trace
+// and span IDs, the ID of its parent and its duration.
+func LogTracer() Tracer {
+	return logTracer{}
+}
+
+type logTracer struct{}
+
+func (logTracer) Start(ctx context.Context, name string, kv ...interface{}) (context.Context, Span) {
+	parent, hasParent := SpanContextFromContext(ctx)
+	sc := SpanContext{TraceID: parent.TraceID}
+	if !hasParent {
+		rand.Read(sc.TraceID[:])
+	}
+	rand.Read(sc.SpanID[:])
+	fields := []interface{}{
+		"span", name,
+		"trace_id", hex.EncodeToString(sc.TraceID[:]),
+		"span_id", hex.EncodeToString(sc.SpanID[:]),
+	}
+	if hasParent {
+		fields = append(fields, "parent_id", hex.EncodeToString(parent.SpanID[:]))
+	}
+	s := &logSpan{
+		logger: FromContext(ctx),
+		fields: append(fields, kv...),
+		start:  time.Now(),
+	}
+	return ContextWithSpanContext(ctx, sc), s
+}
+
+type logSpan struct {
+	logger *Logger
+	fields []interface{}
+	start  time.Time
+}
+
+func (s *logSpan) End(err error) {
+	fields := append(s.fields, "duration", time.Since(s.start))
+	if err != nil {
+		fields = append(fields, "error", err)
+	}
+	s.logger.Info("span", fields...)
+}
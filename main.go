@@ -1,195 +1,189 @@
 package main
 
 import (
-	"context"
-	"encoding/json"
+	"flag"
 	"fmt"
-	"html/template"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"path/filepath"
-	"sort"
-	"strings"
+	"net/url"
+	"os"
 
-	// embedded files
-	_ "marwan.io/moddoc/statik"
-
-	"github.com/gorilla/mux"
-	"github.com/kelseyhightower/envconfig"
-	"github.com/rakyll/statik/fs"
+	"marwan.io/moddoc/config"
 	"marwan.io/moddoc/fetch"
-	"marwan.io/moddoc/gocopy/semver"
+	"marwan.io/moddoc/license"
+	"marwan.io/moddoc/logging"
 	"marwan.io/moddoc/proxy"
+	"marwan.io/moddoc/server"
+	"marwan.io/moddoc/sumdb"
+	"marwan.io/moddoc/vuln"
 )
 
 //go:generate statik -src=frontend
-var config struct {
-	GoProxyURL string `envconfig:"GOPROXY" required:"true"`
-	Port       string `envconfig:"PORT" default:"3001"`
-	ENV        string `envconfig:"MODDOC_ENV"`
-}
-
-func init() {
-	envconfig.MustProcess("", &config)
-	config.GoProxyURL = parseProxyURL(config.GoProxyURL)
-}
 
-var tt *template.Template
-
-func parseDev() {
-	tt = template.Must(template.New("root").Funcs(template.FuncMap{
-		"toLower":        strings.ToLower,
-		"subOne":         subOne,
-		"getVerLink":     getVerLink,
-		"json":           getJSON,
-		"latestVer":      latestVer,
-		"methodReceiver": methodReceiver,
-	}).ParseGlob("frontend/templates/*.html"))
-}
+var (
+	// cfg is the configuration, loaded by loadConfig.
+	cfg *config.Config
+	// logger writes the logs to the standard error.
+	logger *logging.Logger
+)
 
-func parse() http.FileSystem {
-	root := template.New("root").Funcs(template.FuncMap{
-		"toLower":        strings.ToLower,
-		"subOne":         subOne,
-		"getVerLink":     getVerLink,
-		"json":           getJSON,
-		"latestVer":      latestVer,
-		"methodReceiver": methodReceiver,
+// loadConfig loads and validates the configuration of the
+// server flags in args, or exits with an actionable message.
+// The commands other than the server only log warnings and errors.
+func loadConfig(args []string, command bool) {
+	c, err := config.Load("moddoc", args)
+	if err == flag.ErrHelp {
+		os.Exit(0)
+	}
+	if err == nil {
+		err = c.Validate()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	level, _ := logging.ParseLevel(c.Log.Level)
+	if command && level < logging.Warn {
+		level = logging.Warn
+	}
+	logger = logging.New(os.Stderr, level, c.Log.Format == "json")
+	logging.SetDefault(logger)
+	if os.Getenv("MODDOC_ENV") == "DEV" {
+		logger.Warn("MODDOC_ENV=DEV is deprecated, use MODDOC_THEME_DIR=frontend instead")
+	}
+	hosts := c.Auth.Hosts
+	if len(hosts) == 0 {
+		if u, err := url.Parse(c.GoProxyURL()); err == nil {
+			hosts = []string{u.Hostname()}
+		}
+	}
+	fetch.SetAuth(fetch.Auth{
+		Token:    c.Auth.Token,
+		Username: c.Auth.Username,
+		Password: c.Auth.Password,
+		Hosts:    hosts,
 	})
-	dist, err := fs.New()
-	must(err)
-	hf, err := dist.Open("/templates")
-	must(err)
-	dir, err := hf.Readdir(-1)
-	must(err)
-	for _, fi := range dir {
-		f, err := dist.Open("/templates/" + fi.Name())
-		must(err)
-		defer f.Close()
-		bts, err := ioutil.ReadAll(f)
-		must(err)
-		root, err = root.New(fi.Name()).Parse(string(bts))
-		must(err)
-	}
-	tt = root
-	return dist
-}
-
-func parseProxyURL(s string) string {
-	proxyURL := strings.Split(s, ",")[0]
-	switch proxyURL {
-	case "":
-		log.Fatal("GOPROXY's first argument must not be empty")
-	case "direct":
-		log.Fatal("cannot use 'direct' as a GOPROXY destination")
-	case "off":
-		log.Fatal("cannot use 'off' as a GOPROXY destination")
-	}
-	return proxyURL
+	fetch.SetTracePropagation(c.Log.PropagateTrace)
+	cfg = c
 }
 
 func main() {
-	r := mux.NewRouter()
-	srv := proxy.NewService(config.GoProxyURL)
-	dist := parse()
-	r.Handle("/", home(dist))
-	r.Handle(docPath, getDoc(srv))
-	r.HandleFunc("/catalog", catalog)
-	if config.ENV == "DEV" {
-		parseDev()
-		r.PathPrefix("/public/").Handler(http.FileServer(http.Dir("frontend")))
-	} else {
-		r.PathPrefix("/public/").Handler(http.FileServer(dist))
-	}
-	r.NotFoundHandler = http.HandlerFunc(getModule)
-
-	fmt.Println("listening on port :" + config.Port)
-	http.ListenAndServe(":"+config.Port, r)
-}
-
-func home(fs http.FileSystem) http.HandlerFunc {
-	return func(w http.ResponseWriter, r *http.Request) {
-		mods, err := getCatalogModules(r.Context())
-		if err != nil {
-			fmt.Printf("Error while retrieving catalog from proxy: [%s]\nFallback to public index\n", err)
-			mods, _ = index(r.Context())
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "export":
+			loadConfig(nil, true)
+			exportMain(os.Args[2:])
+			return
+		case "doc":
+			loadConfig(nil, true)
+			docMain(os.Args[2:])
+			return
+		case "markdown":
+			loadConfig(nil, true)
+			markdownMain(os.Args[2:])
+			return
 		}
-		err = tt.Lookup("index.html").Execute(w, map[string]interface{}{
-			"index": true,
-			"data":  mods,
-		})
+	}
+	loadConfig(os.Args[1:], false)
+	opts := []server.Option{server.WithBasePath(cfg.Server.BasePath)}
+	if cfg.Server.ForwardedPrefix {
+		opts = append(opts, server.WithForwardedPrefix())
+	}
+	stopIndexer := func() {}
+	if cfg.Index.URL != "" {
+		ix, err := newIndexer()
 		if err != nil {
-			fmt.Println(err)
+			fatal("could not start the server", err)
 		}
+		opts = append(opts, server.WithIndex(ix.Store))
+		stopIndexer = runIndexer(ix)
 	}
-}
-
-func getCatalogModules(ctx context.Context) ([]*moduleIndex, error) {
-	url := strings.TrimSuffix(config.GoProxyURL, "/") + "/catalog"
-	resp, err := fetch.Fetch(ctx, url)
-	if err != nil {
-		return nil, err
+	if cfg.Metrics.Enabled {
+		opts = append(opts, server.WithMetrics())
 	}
-	defer resp.Body.Close()
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("unexpected status: %v", resp.StatusCode)
+	if cfg.ImportedBy.Enabled {
+		opts = append(opts, server.WithImportedBy(cfg.ImportedBy.Interval, cfg.ImportedBy.Modules...))
 	}
-	var lr listResp
-	err = json.NewDecoder(resp.Body).Decode(&lr)
+	s, err := newServer(opts...)
 	if err != nil {
-		return nil, err
+		stopIndexer()
+		fatal("could not start the server", err)
 	}
-	mp := map[string][]string{}
-	for _, m := range lr.Modules {
-		mp[m.Module] = append(mp[m.Module], m.Version)
-	}
-	mods := []*moduleIndex{}
-	for mod, vers := range mp {
-		mods = append(mods, &moduleIndex{
-			mod,
-			vers,
-			latestVer(vers),
-		})
-	}
-	return mods, nil
-}
-
-func subOne(i int) int {
-	return i - 1
-}
-
-func getVerLink(importPath, version string) string {
-	return filepath.Join("/", importPath, "@v", version)
-}
 
-func getJSON(i interface{}) string {
-	bts, _ := json.Marshal(i)
-	return string(bts)
-}
-
-func latestVer(vers []string) string {
-	sortVersions(vers)
-	if len(vers) == 0 {
-		return "latest"
+	// the indexer stops once the server is shut down,
+	// closing the store after its last writes.
+	err = serve(s)
+	stopIndexer()
+	if err != nil {
+		fatal("could not serve", err)
 	}
-	return vers[0]
 }
 
-func sortVersions(list []string) {
-	sort.Slice(list, func(i, j int) bool { return semver.Compare(list[i], list[j]) > 0 })
+// fatal logs the error and exits.
+func fatal(msg string, err error) {
+	logger.Error(msg, "error", err)
+	os.Exit(1)
 }
 
-func methodReceiver(receiver string) string {
-	if receiver == "" {
-		return ""
+// newServer returns a server.Server with the proxy service,
+// theme and license policy described by the configuration.
+func newServer(opts ...server.Option) (*server.Server, error) {
+	sopts, err := serviceOptions()
+	if err != nil {
+		return nil, err
 	}
-
-	return "(" + receiver + ")"
+	opts = append([]server.Option{
+		server.WithService(proxy.NewService(cfg.GoProxyURL(), sopts...)),
+		server.WithTheme(cfg.Theme.Dir, cfg.Theme.Reload),
+		server.WithLogger(logger),
+	}, opts...)
+	if cfg.Log.Spans {
+		opts = append(opts, server.WithTracer(logging.LogTracer()))
+	}
+	if cfg.License.Policy != "" {
+		p, err := license.LoadPolicy(cfg.License.Policy)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, server.WithLicensePolicy(p))
+	}
+	return server.New(cfg.GoProxyURL(), opts...)
 }
 
-func must(err error) {
-	if err != nil {
-		panic(err)
+func serviceOptions() ([]proxy.Option, error) {
+	opts := []proxy.Option{
+		proxy.WithLimits(proxy.Limits{
+			MaxZipSize:   cfg.Limits.MaxZipSize,
+			MaxTotalSize: cfg.Limits.MaxTotalSize,
+			MaxFileSize:  cfg.Limits.MaxFileSize,
+			MaxFiles:     cfg.Limits.MaxFiles,
+		}),
+		proxy.WithCache(cfg.Cache.Size),
+	}
+	if cfg.Vuln.Dir != "" {
+		db, err := vuln.Load(cfg.Vuln.Dir)
+		if err != nil {
+			return nil, fmt.Errorf("could not load vuln.dir: %v", err)
+		}
+		logger.Info("loaded the vulnerability database", "entries", db.Len())
+		opts = append(opts, proxy.WithVulnDB(db))
+	}
+	dbs := []sumdb.DB{}
+	if cfg.Checksum.File != "" {
+		gs, err := sumdb.LoadGoSum(cfg.Checksum.File)
+		if err != nil {
+			return nil, fmt.Errorf("could not load checksum.file: %v", err)
+		}
+		dbs = append(dbs, gs)
+	}
+	if cfg.Checksum.DB != "" {
+		db, err := sumdb.NewClient(cfg.Checksum.DB, cfg.Checksum.Key)
+		if err != nil {
+			return nil, fmt.Errorf("could not use checksum.db: %v", err)
+		}
+		dbs = append(dbs, db)
+	}
+	if len(dbs) == 0 {
+		return opts, nil
 	}
+	opts = append(opts, proxy.WithChecksumDB(sumdb.Chain(dbs...), cfg.Checksum.Mismatch == "refuse"))
+	return opts, nil
 }
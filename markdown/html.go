@@ -0,0 +1,119 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	htmlTokenRx = regexp.MustCompile(`<!--[\s\S]*?-->|</?[a-zA-Z][a-zA-Z0-9-]*(?:\s[^<>]*)?/?>`)
+	htmlTagRx   = regexp.MustCompile(`^<(/?)([a-zA-Z][a-zA-Z0-9-]*)`)
+	htmlAttrRx  = regexp.MustCompile(`([a-zA-Z_:][-a-zA-Z0-9_:.]*)(?:\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'=<>` + "`" + `]+)))?`)
+)
+
+// allowedTags maps the raw HTML tags that are kept to the
+// attributes they may keep, on top of align and title.
+var allowedTags = map[string][]string{
+	"a": {"href"}, "abbr": nil, "b": nil, "blockquote": nil, "br": nil,
+	"code": nil, "dd": nil, "del": nil, "details": {"open"}, "div": nil,
+	"dl": nil, "dt": nil, "em": nil, "h1": nil, "h2": nil, "h3": nil,
+	"h4": nil, "h5": nil, "h6": nil, "hr": nil, "i": nil, "img": {"src", "alt", "width", "height"},
+	"ins": nil, "kbd": nil, "li": nil, "ol": {"start"}, "p": nil, "pre": nil,
+	"s": nil, "samp": nil, "span": nil, "strong": nil, "sub": nil,
+	"summary": nil, "sup": nil, "table": nil, "tbody": nil, "td": {"colspan", "rowspan"},
+	"tfoot": nil, "th": {"colspan", "rowspan"}, "thead": nil, "tr": nil, "tt": nil, "ul": nil,
+}
+
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// sanitizer reduces raw HTML to the allowed tags and attributes. It
+// keeps track of the tags it let through so that a document cannot
+// leave them open, or close tags it did not open.
+type sanitizer struct {
+	open []string
+}
+
+// sanitize rewrites raw HTML. URLs in attributes go through
+// resolve, which returns an empty string for unsafe ones.
+func (s *sanitizer) sanitize(raw string, resolve func(dest string, image bool) string) string {
+	var b bytes.Buffer
+	last := 0
+	for _, loc := range htmlTokenRx.FindAllStringIndex(raw, -1) {
+		b.WriteString(html.EscapeString(html.UnescapeString(raw[last:loc[0]])))
+		last = loc[1]
+		s.tag(&b, raw[loc[0]:loc[1]], resolve)
+	}
+	b.WriteString(html.EscapeString(html.UnescapeString(raw[last:])))
+	return b.String()
+}
+
+func (s *sanitizer) tag(b *bytes.Buffer, tok string, resolve func(string, bool) string) {
+	m := htmlTagRx.FindStringSubmatch(tok)
+	if m == nil {
+		// comments are dropped
+		return
+	}
+	name := strings.ToLower(m[2])
+	attrs, ok := allowedTags[name]
+	if !ok {
+		return
+	}
+	if m[1] == "/" {
+		s.close(b, name)
+		return
+	}
+	b.WriteString("<" + name)
+	body := strings.TrimSuffix(strings.TrimSuffix(tok[len(m[0]):], ">"), "/")
+	for _, am := range htmlAttrRx.FindAllStringSubmatch(body, -1) {
+		attr := strings.ToLower(am[1])
+		if attr != "align" && attr != "title" && !contains(attrs, attr) {
+			continue
+		}
+		val := html.UnescapeString(am[2] + am[3] + am[4])
+		if attr == "href" || attr == "src" {
+			val = resolve(val, name == "img")
+			if val == "" {
+				continue
+			}
+		}
+		b.WriteString(" " + attr + `="` + html.EscapeString(val) + `"`)
+	}
+	b.WriteString(">")
+	if !voidTags[name] {
+		s.open = append(s.open, name)
+	}
+}
+
+// close closes name and the tags opened after it,
+// if name was opened at all.
+func (s *sanitizer) close(b *bytes.Buffer, name string) {
+	for i := len(s.open) - 1; i >= 0; i-- {
+		if s.open[i] != name {
+			continue
+		}
+		for j := len(s.open) - 1; j >= i; j-- {
+			b.WriteString("</" + s.open[j] + ">")
+		}
+		s.open = s.open[:i]
+		return
+	}
+}
+
+// closeAll closes the tags that were left open.
+func (s *sanitizer) closeAll(b *bytes.Buffer) {
+	for j := len(s.open) - 1; j >= 0; j-- {
+		b.WriteString("</" + s.open[j] + ">")
+	}
+	s.open = nil
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
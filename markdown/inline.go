@@ -0,0 +1,424 @@
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	autolinkRx   = regexp.MustCompile(`^<([a-zA-Z][a-zA-Z0-9+.-]{1,31}:[^\s<>]*)>`)
+	emailRx      = regexp.MustCompile(`^<([^\s@<>\\]+@[a-zA-Z0-9-]+(?:\.[a-zA-Z0-9-]+)+)>`)
+	inlineHTMLRx = regexp.MustCompile(`^(?:<!--[\s\S]*?-->|</?[a-zA-Z][a-zA-Z0-9-]*(?:\s[^<>]*)?/?>)`)
+	entityRx     = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[a-zA-Z][a-zA-Z0-9]{1,31});`)
+	bareURLRx    = regexp.MustCompile(`^(?:https?://|www\.)[^\s<]*[^\s<?!.,:*_~)'"]`)
+	tagRx        = regexp.MustCompile(`<[^>]*>`)
+)
+
+const specialChars = "\\\n`![]<*_~&hw"
+
+func (r *renderer) inline(s string) string {
+	var b bytes.Buffer
+	r.inlineTo(&b, s, false)
+	return b.String()
+}
+
+// inlineTo renders the inline content s. Links
+// are not allowed inside of links.
+func (r *renderer) inlineTo(b *bytes.Buffer, s string, inLink bool) {
+	for i := 0; i < len(s); {
+		j := strings.IndexAny(s[i:], specialChars)
+		if j < 0 {
+			b.WriteString(html.EscapeString(s[i:]))
+			return
+		}
+		b.WriteString(html.EscapeString(s[i : i+j]))
+		i += j
+		n := r.inlineAt(b, s, i, inLink)
+		if n == 0 {
+			b.WriteString(html.EscapeString(s[i : i+1]))
+			n = 1
+		}
+		i += n
+	}
+}
+
+// inlineAt renders the construct that starts at s[i], if
+// any, and returns the number of bytes it consumed.
+func (r *renderer) inlineAt(b *bytes.Buffer, s string, i int, inLink bool) int {
+	rest := s[i:]
+	switch s[i] {
+	case '\\':
+		if len(rest) > 1 && rest[1] == '\n' {
+			b.WriteString("<br>\n")
+			return 2
+		}
+		if len(rest) > 1 && isPunct(rest[1]) {
+			b.WriteString(html.EscapeString(rest[1:2]))
+			return 2
+		}
+	case '\n':
+		// two trailing spaces make a hard line break
+		data := b.Bytes()
+		trimmed := bytes.TrimRight(data, " ")
+		hard := len(data)-len(trimmed) >= 2
+		b.Truncate(len(trimmed))
+		if hard {
+			b.WriteString("<br>")
+		}
+		b.WriteString("\n")
+		return 1
+	case '`':
+		n := runLength(rest, '`')
+		end := findCodeEnd(rest, n)
+		if end < 0 {
+			b.WriteString(rest[:n])
+			return n
+		}
+		code := strings.Replace(rest[n:end], "\n", " ", -1)
+		if len(code) > 2 && code[0] == ' ' && code[len(code)-1] == ' ' && strings.TrimSpace(code) != "" {
+			code = code[1 : len(code)-1]
+		}
+		b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+		return end + n
+	case '!':
+		if len(rest) < 2 || rest[1] != '[' {
+			return 0
+		}
+		label, dest, title, n, ok := r.parseLink(rest[1:])
+		if !ok {
+			return 0
+		}
+		alt := html.UnescapeString(tagRx.ReplaceAllString(r.inline(label), ""))
+		src := r.url(dest, true)
+		if src == "" {
+			b.WriteString(html.EscapeString(alt))
+			return n + 1
+		}
+		b.WriteString(`<img src="` + html.EscapeString(src) + `" alt="` + html.EscapeString(alt) + `"`)
+		if title != "" {
+			b.WriteString(` title="` + html.EscapeString(title) + `"`)
+		}
+		b.WriteString(">")
+		return n + 1
+	case '[':
+		if inLink {
+			return 0
+		}
+		label, dest, title, n, ok := r.parseLink(rest)
+		if !ok {
+			return 0
+		}
+		href := r.url(dest, false)
+		if href == "" {
+			r.inlineTo(b, label, true)
+			return n
+		}
+		b.WriteString(`<a href="` + html.EscapeString(href) + `"`)
+		if title != "" {
+			b.WriteString(` title="` + html.EscapeString(title) + `"`)
+		}
+		b.WriteString(">")
+		r.inlineTo(b, label, true)
+		b.WriteString("</a>")
+		return n
+	case '<':
+		if m := autolinkRx.FindStringSubmatch(rest); m != nil && !inLink {
+			if href := r.url(m[1], false); href != "" {
+				b.WriteString(`<a href="` + html.EscapeString(href) + `">` + html.EscapeString(m[1]) + "</a>")
+				return len(m[0])
+			}
+		}
+		if m := emailRx.FindStringSubmatch(rest); m != nil && !inLink {
+			b.WriteString(`<a href="mailto:` + html.EscapeString(m[1]) + `">` + html.EscapeString(m[1]) + "</a>")
+			return len(m[0])
+		}
+		if m := inlineHTMLRx.FindString(rest); m != "" {
+			b.WriteString(r.san.sanitize(m, r.url))
+			return len(m)
+		}
+	case '*', '_', '~':
+		return r.emphasis(b, s, i, inLink)
+	case '&':
+		if m := entityRx.FindString(rest); m != "" {
+			b.WriteString(html.EscapeString(html.UnescapeString(m)))
+			return len(m)
+		}
+	case 'h', 'w':
+		if inLink || i > 0 && !strings.ContainsRune(" \t\n(*_~", rune(s[i-1])) {
+			return 0
+		}
+		m := bareURLRx.FindString(rest)
+		if m == "" {
+			return 0
+		}
+		dest := m
+		if strings.HasPrefix(m, "www.") {
+			dest = "http://" + m
+		}
+		if href := r.url(dest, false); href != "" {
+			b.WriteString(`<a href="` + html.EscapeString(href) + `">` + html.EscapeString(m) + "</a>")
+			return len(m)
+		}
+	}
+	return 0
+}
+
+// emphasis renders *em*, **strong**, ***both*** and ~~strikethrough~~.
+func (r *renderer) emphasis(b *bytes.Buffer, s string, i int, inLink bool) int {
+	c := s[i]
+	n := runLength(s[i:], c)
+	literal := func() int {
+		b.WriteString(s[i : i+n])
+		return n
+	}
+	if c == '~' && n != 2 || n > 3 {
+		return literal()
+	}
+	if c == '_' && i > 0 && isWordChar(s[i-1]) {
+		// no intraword emphasis with underscores
+		return literal()
+	}
+	if i+n >= len(s) || isSpace(s[i+n]) {
+		return literal()
+	}
+	end := findCloser(s, i+n, c, n)
+	if end < 0 {
+		return literal()
+	}
+	open, close := "<em>", "</em>"
+	switch {
+	case c == '~':
+		open, close = "<del>", "</del>"
+	case n == 2:
+		open, close = "<strong>", "</strong>"
+	case n == 3:
+		open, close = "<strong><em>", "</em></strong>"
+	}
+	b.WriteString(open)
+	r.inlineTo(b, s[i+n:end], inLink)
+	b.WriteString(close)
+	return end + n - i
+}
+
+// findCloser returns the index of the run of exactly n c
+// that closes the emphasis opened before s[from], or -1.
+func findCloser(s string, from int, c byte, n int) int {
+	for j := from; j < len(s); {
+		switch s[j] {
+		case '`':
+			m := runLength(s[j:], '`')
+			if end := findCodeEnd(s[j:], m); end >= 0 {
+				j += end + m
+				continue
+			}
+			j += m
+		case '\\':
+			j += 2
+		case c:
+			m := runLength(s[j:], c)
+			if m == n && !isSpace(s[j-1]) && (c != '_' || j+m == len(s) || !isWordChar(s[j+m])) {
+				return j
+			}
+			j += m
+		default:
+			j++
+		}
+	}
+	return -1
+}
+
+// parseLink parses a link starting at the [ of s, either inline
+// [text](dest "title") or a reference [text][ref], [text][] or
+// [text]. It returns the number of bytes of the whole link.
+func (r *renderer) parseLink(s string) (label, dest, title string, n int, ok bool) {
+	depth := 0
+	end := -1
+	for j := 0; j < len(s) && end < 0; j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case '`':
+			m := runLength(s[j:], '`')
+			if e := findCodeEnd(s[j:], m); e >= 0 {
+				j += e + m - 1
+			} else {
+				j += m - 1
+			}
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth == 0 {
+				end = j
+			}
+		}
+	}
+	if end < 0 {
+		return "", "", "", 0, false
+	}
+	label = s[1:end]
+	rest := s[end+1:]
+	if strings.HasPrefix(rest, "(") {
+		if dest, title, m, ok := parseDest(rest); ok {
+			return label, dest, title, end + 1 + m, true
+		}
+	}
+	ref := label
+	m := 0
+	if strings.HasPrefix(rest, "[") {
+		if k := strings.IndexByte(rest, ']'); k >= 0 {
+			m = k + 1
+			if k > 1 {
+				ref = rest[1:k]
+			}
+		}
+	}
+	lr, found := r.refs[normalizeLabel(ref)]
+	if !found {
+		return "", "", "", 0, false
+	}
+	return label, lr.dest, lr.title, end + 1 + m, true
+}
+
+// parseDest parses the (dest "title") part of an inline link.
+func parseDest(s string) (dest, title string, n int, ok bool) {
+	j := 1
+	skip := func() {
+		for j < len(s) && isSpace(s[j]) {
+			j++
+		}
+	}
+	skip()
+	if j < len(s) && s[j] == '<' {
+		k := strings.IndexAny(s[j:], ">\n")
+		if k < 0 || s[j+k] != '>' {
+			return "", "", 0, false
+		}
+		dest = s[j+1 : j+k]
+		j += k + 1
+	} else {
+		start, depth := j, 0
+		for ; j < len(s) && !isSpace(s[j]); j++ {
+			if s[j] == '\\' {
+				j++
+				continue
+			}
+			if s[j] == '(' {
+				depth++
+			}
+			if s[j] == ')' {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+		}
+		if j > len(s) {
+			j = len(s)
+		}
+		dest = s[start:j]
+	}
+	skip()
+	if j < len(s) && (s[j] == '"' || s[j] == '\'' || s[j] == '(') {
+		closer := s[j]
+		if closer == '(' {
+			closer = ')'
+		}
+		k := strings.IndexByte(s[j+1:], closer)
+		if k < 0 {
+			return "", "", 0, false
+		}
+		title = s[j+1 : j+1+k]
+		j += k + 2
+		skip()
+	}
+	if j >= len(s) || s[j] != ')' {
+		return "", "", 0, false
+	}
+	return unescape(dest), unescape(title), j + 1, true
+}
+
+// url checks the scheme of a link or image destination and resolves
+// relative ones. It returns an empty string for unsafe destinations.
+func (r *renderer) url(dest string, image bool) string {
+	dest = strings.TrimSpace(html.UnescapeString(dest))
+	if dest == "" {
+		return ""
+	}
+	if strings.HasPrefix(dest, "#") {
+		return "#" + r.opts.IDPrefix + dest[1:]
+	}
+	u, err := url.Parse(dest)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		return dest
+	case "mailto":
+		if image {
+			return ""
+		}
+		return dest
+	case "":
+		if u.Host != "" || r.opts.ResolveURL == nil {
+			return dest
+		}
+		return r.opts.ResolveURL(dest, image)
+	}
+	return ""
+}
+
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for j := 0; j < len(s); j++ {
+		if s[j] == '\\' && j+1 < len(s) && isPunct(s[j+1]) {
+			j++
+		}
+		sb.WriteByte(s[j])
+	}
+	return sb.String()
+}
+
+// findCodeEnd returns the index of the run of exactly n
+// backticks that closes the code span opened at s[0], or -1.
+func findCodeEnd(s string, n int) int {
+	for j := n; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		m := runLength(s[j:], '`')
+		if m == n {
+			return j
+		}
+		j += m
+	}
+	return -1
+}
+
+func runLength(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func isPunct(c byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", c) >= 0
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n'
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c >= 0x80
+}
@@ -0,0 +1,483 @@
+// Package markdown renders the Markdown found in module READMEs to
+// sanitized HTML. It implements the commonly used parts of CommonMark
+// and GitHub Flavored Markdown: headings, paragraphs, lists, block
+// quotes, code blocks, tables, links, images and emphasis. Raw HTML is
+// reduced to a small allowlist of tags and attributes and every URL is
+// checked, so the output can be embedded in a page as is.
+package markdown
+
+import (
+	"bytes"
+	"html"
+	"html/template"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Options configure how a document is rendered.
+type Options struct {
+	// ResolveURL, if set, rewrites the destination of relative links
+	// and images, such as paths to other files of a repository.
+	ResolveURL func(dest string, image bool) string
+	// IDPrefix is prepended to the ids of headings and
+	// to the fragments of links to them, to keep them
+	// apart from the ids of the embedding page.
+	IDPrefix string
+}
+
+// ToHTML renders the Markdown document src.
+func ToHTML(src []byte, opts Options) template.HTML {
+	r := &renderer{opts: opts, refs: map[string]linkRef{}, ids: map[string]int{}}
+	text := strings.Replace(string(src), "\r\n", "\n", -1)
+	text = strings.Replace(text, "\r", "\n", -1)
+	lines := r.collectRefs(strings.Split(text, "\n"))
+	r.blocks(lines, false)
+	r.san.closeAll(&r.out)
+	return template.HTML(r.out.String())
+}
+
+type linkRef struct {
+	dest, title string
+}
+
+type renderer struct {
+	opts Options
+	refs map[string]linkRef
+	ids  map[string]int
+	san  sanitizer
+	out  bytes.Buffer
+}
+
+var (
+	atxHeadingRx  = regexp.MustCompile(`^ {0,3}(#{1,6})(?:[ \t]+(.*?))?(?:[ \t]+#+)?[ \t]*$`)
+	setextRx      = regexp.MustCompile(`^ {0,3}(=+|-+)[ \t]*$`)
+	hrRx          = regexp.MustCompile(`^ {0,3}((\*[ \t]*){3,}|(-[ \t]*){3,}|(_[ \t]*){3,})$`)
+	fenceRx       = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	listItemRx    = regexp.MustCompile(`^( {0,3})([-*+]|[0-9]{1,9}[.)])([ \t]+|$)`)
+	refDefRx      = regexp.MustCompile(`^ {0,3}\[([^\]]+)\]:[ \t]*<?([^\s>]+)>?(?:[ \t]+(?:"([^"]*)"|'([^']*)'|\(([^)]*)\)))?[ \t]*$`)
+	htmlBlockRx   = regexp.MustCompile(`^ {0,3}<(?:[a-zA-Z][a-zA-Z0-9-]*(?:[\s/>]|$)|/[a-zA-Z][a-zA-Z0-9-]*[\s>]|!--)`)
+	tableDelimRx  = regexp.MustCompile(`^ {0,3}\|?[ \t]*:?-+:?[ \t]*(\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+	taskListRx    = regexp.MustCompile(`^\[([ xX])\][ \t]+`)
+	headingTrimRx = regexp.MustCompile(`[^\p{L}\p{N}\s_-]`)
+)
+
+// collectRefs removes the link reference definitions
+// found outside of code blocks and remembers them.
+func (r *renderer) collectRefs(lines []string) []string {
+	out := lines[:0:0]
+	fence := ""
+	for _, line := range lines {
+		if m := fenceRx.FindStringSubmatch(line); m != nil {
+			if fence == "" {
+				fence = m[2]
+			} else if strings.HasPrefix(m[2], fence[:1]) && len(m[2]) >= len(fence) && strings.TrimSpace(m[3]) == "" {
+				fence = ""
+			}
+		}
+		if fence == "" {
+			if m := refDefRx.FindStringSubmatch(line); m != nil {
+				label := normalizeLabel(m[1])
+				if _, ok := r.refs[label]; !ok {
+					r.refs[label] = linkRef{dest: m[2], title: m[3] + m[4] + m[5]}
+				}
+				continue
+			}
+		}
+		out = append(out, line)
+	}
+	return out
+}
+
+func normalizeLabel(label string) string {
+	return strings.ToLower(strings.Join(strings.Fields(label), " "))
+}
+
+// blocks renders a sequence of lines as block elements. In a
+// tight list, paragraphs are rendered without their <p> tags.
+func (r *renderer) blocks(lines []string, tight bool) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		switch {
+		case isBlank(line):
+			i++
+		case fenceRx.MatchString(line):
+			i = r.fencedCode(lines, i)
+		case indentOf(line) >= 4:
+			i = r.indentedCode(lines, i)
+		case atxHeadingRx.MatchString(line):
+			m := atxHeadingRx.FindStringSubmatch(line)
+			r.heading(len(m[1]), m[2])
+			i++
+		case hrRx.MatchString(line):
+			r.out.WriteString("<hr>\n")
+			i++
+		case strings.HasPrefix(strings.TrimLeft(line, " "), ">"):
+			i = r.blockquote(lines, i)
+		case listItemRx.MatchString(line):
+			i = r.list(lines, i)
+		case htmlBlockRx.MatchString(line):
+			i = r.htmlBlock(lines, i)
+		case i+1 < len(lines) && strings.Contains(line, "|") && tableDelimRx.MatchString(lines[i+1]) &&
+			len(splitRow(line)) == len(splitRow(lines[i+1])):
+			i = r.table(lines, i)
+		default:
+			i = r.paragraph(lines, i, tight)
+		}
+	}
+}
+
+func (r *renderer) fencedCode(lines []string, i int) int {
+	m := fenceRx.FindStringSubmatch(lines[i])
+	indent, fence := len(m[1]), m[2]
+	lang := strings.Fields(m[3])
+	var code []string
+	i++
+	for ; i < len(lines); i++ {
+		if cm := fenceRx.FindStringSubmatch(lines[i]); cm != nil &&
+			strings.HasPrefix(cm[2], fence[:1]) && len(cm[2]) >= len(fence) && strings.TrimSpace(cm[3]) == "" {
+			i++
+			break
+		}
+		code = append(code, trimIndent(lines[i], indent))
+	}
+	r.out.WriteString("<pre><code")
+	if len(lang) > 0 {
+		r.out.WriteString(` class="language-` + html.EscapeString(html.UnescapeString(lang[0])) + `"`)
+	}
+	r.out.WriteString(">")
+	for _, c := range code {
+		r.out.WriteString(html.EscapeString(c) + "\n")
+	}
+	r.out.WriteString("</code></pre>\n")
+	return i
+}
+
+func (r *renderer) indentedCode(lines []string, i int) int {
+	var code []string
+	for ; i < len(lines) && (isBlank(lines[i]) || indentOf(lines[i]) >= 4); i++ {
+		code = append(code, trimIndent(lines[i], 4))
+	}
+	for len(code) > 0 && isBlank(code[len(code)-1]) {
+		code = code[:len(code)-1]
+	}
+	r.out.WriteString("<pre><code>")
+	for _, c := range code {
+		r.out.WriteString(html.EscapeString(c) + "\n")
+	}
+	r.out.WriteString("</code></pre>\n")
+	return i
+}
+
+func (r *renderer) heading(level int, text string) {
+	inline := r.inline(text)
+	tag := "h" + strconv.Itoa(level)
+	r.out.WriteString("<" + tag + ` id="` + html.EscapeString(r.headingID(text)) + `">`)
+	r.out.WriteString(inline)
+	r.out.WriteString("</" + tag + ">\n")
+}
+
+// headingID returns a unique id for a heading, the way GitHub
+// makes them: lower case, without punctuation and with dashes
+// in place of spaces.
+func (r *renderer) headingID(text string) string {
+	id := strings.ToLower(strings.TrimSpace(text))
+	id = headingTrimRx.ReplaceAllString(id, "")
+	id = strings.Join(strings.Fields(id), "-")
+	n := r.ids[id]
+	r.ids[id]++
+	if n > 0 {
+		id += "-" + strconv.Itoa(n)
+	}
+	return r.opts.IDPrefix + id
+}
+
+func (r *renderer) blockquote(lines []string, i int) int {
+	var inner []string
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimLeft(lines[i], " ")
+		if !strings.HasPrefix(trimmed, ">") {
+			// lazy continuation of a paragraph
+			if isBlank(lines[i]) || len(inner) == 0 || isBlank(inner[len(inner)-1]) || startsBlock(lines[i]) {
+				break
+			}
+			inner = append(inner, lines[i])
+			continue
+		}
+		trimmed = trimmed[1:]
+		if strings.HasPrefix(trimmed, " ") {
+			trimmed = trimmed[1:]
+		}
+		inner = append(inner, trimmed)
+	}
+	r.out.WriteString("<blockquote>\n")
+	r.blocks(inner, false)
+	r.out.WriteString("</blockquote>\n")
+	return i
+}
+
+func (r *renderer) list(lines []string, i int) int {
+	first := listItemRx.FindStringSubmatch(lines[i])
+	ordered := !strings.ContainsAny(first[2], "-*+")
+	delim := first[2][len(first[2])-1:]
+	var items [][]string
+	loose := false
+	blank := false
+	contentIndent := 0
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if hrRx.MatchString(line) && indentOf(line) < contentIndent {
+			break
+		}
+		if m := listItemRx.FindStringSubmatch(line); m != nil && (len(items) == 0 || indentOf(line) < contentIndent) {
+			if ordered == strings.ContainsAny(m[2], "-*+") || m[2][len(m[2])-1:] != delim {
+				break
+			}
+			if blank && len(items) > 0 {
+				loose = true
+			}
+			blank = false
+			spaces := len(m[3])
+			if spaces > 4 || spaces == 0 {
+				spaces = 1
+			}
+			contentIndent = len(m[1]) + len(m[2]) + spaces
+			rest := line[minInt(len(line), len(m[1])+len(m[2])+len(m[3])):]
+			if len(m[3]) > 4 {
+				rest = strings.Repeat(" ", len(m[3])-1) + rest
+			}
+			items = append(items, []string{rest})
+			continue
+		}
+		if isBlank(line) {
+			blank = true
+			items[len(items)-1] = append(items[len(items)-1], "")
+			continue
+		}
+		if indentOf(line) >= contentIndent {
+			if blank {
+				loose = loose || hasContent(items[len(items)-1][1:])
+			}
+			blank = false
+			items[len(items)-1] = append(items[len(items)-1], trimIndent(line, contentIndent))
+			continue
+		}
+		if blank || startsBlock(line) {
+			break
+		}
+		// lazy continuation of the item's paragraph
+		items[len(items)-1] = append(items[len(items)-1], line)
+	}
+	tag := "ul"
+	if ordered {
+		tag = "ol"
+		if start, _ := strconv.Atoi(first[2][:len(first[2])-1]); start != 1 {
+			tag = `ol start="` + strconv.Itoa(start) + `"`
+		}
+	}
+	r.out.WriteString("<" + tag + ">\n")
+	for _, item := range items {
+		for len(item) > 0 && isBlank(item[len(item)-1]) {
+			item = item[:len(item)-1]
+		}
+		r.out.WriteString("<li>")
+		if len(item) > 0 && !ordered {
+			if m := taskListRx.FindStringSubmatch(item[0]); m != nil {
+				checked := ""
+				if m[1] != " " {
+					checked = " checked"
+				}
+				r.out.WriteString(`<input type="checkbox" disabled` + checked + `> `)
+				item[0] = item[0][len(m[0]):]
+			}
+		}
+		r.blocks(item, !loose)
+		r.out.WriteString("</li>\n")
+	}
+	r.out.WriteString("</" + tag[:2] + ">\n")
+	return i
+}
+
+func hasContent(lines []string) bool {
+	for _, l := range lines {
+		if !isBlank(l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *renderer) htmlBlock(lines []string, i int) int {
+	start := i
+	for ; i < len(lines) && !isBlank(lines[i]); i++ {
+	}
+	r.out.WriteString(r.san.sanitize(strings.Join(lines[start:i], "\n"), r.url))
+	r.out.WriteString("\n")
+	return i
+}
+
+func (r *renderer) table(lines []string, i int) int {
+	header := splitRow(lines[i])
+	var aligns []string
+	for _, cell := range splitRow(lines[i+1]) {
+		left, right := strings.HasPrefix(cell, ":"), strings.HasSuffix(cell, ":")
+		switch {
+		case left && right:
+			aligns = append(aligns, "center")
+		case left:
+			aligns = append(aligns, "left")
+		case right:
+			aligns = append(aligns, "right")
+		default:
+			aligns = append(aligns, "")
+		}
+	}
+	row := func(cells []string, tag string) {
+		r.out.WriteString("<tr>")
+		for j := range header {
+			cell := ""
+			if j < len(cells) {
+				cell = cells[j]
+			}
+			r.out.WriteString("<" + tag)
+			if aligns[j] != "" {
+				r.out.WriteString(` style="text-align: ` + aligns[j] + `"`)
+			}
+			r.out.WriteString(">" + r.inline(cell) + "</" + tag + ">")
+		}
+		r.out.WriteString("</tr>\n")
+	}
+	r.out.WriteString("<table>\n<thead>\n")
+	row(header, "th")
+	r.out.WriteString("</thead>\n<tbody>\n")
+	for i += 2; i < len(lines) && !isBlank(lines[i]) && strings.Contains(lines[i], "|") && !startsBlock(lines[i]); i++ {
+		row(splitRow(lines[i]), "td")
+	}
+	r.out.WriteString("</tbody>\n</table>\n")
+	return i
+}
+
+// splitRow splits a table row into its trimmed
+// cells, ignoring escaped and code span pipes.
+func splitRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var cell strings.Builder
+	inCode := false
+	for j := 0; j < len(line); j++ {
+		c := line[j]
+		switch {
+		case c == '\\' && j+1 < len(line) && line[j+1] == '|':
+			cell.WriteByte('|')
+			j++
+			continue
+		case c == '`':
+			inCode = !inCode
+		case c == '|' && !inCode:
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+			continue
+		}
+		cell.WriteByte(c)
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func (r *renderer) paragraph(lines []string, i int, tight bool) int {
+	start := i
+	for i++; i < len(lines); i++ {
+		if m := setextRx.FindStringSubmatch(lines[i]); m != nil {
+			level := 1
+			if m[1][0] == '-' {
+				level = 2
+			}
+			r.heading(level, strings.TrimSpace(strings.Join(lines[start:i], " ")))
+			return i + 1
+		}
+		if isBlank(lines[i]) || startsBlock(lines[i]) {
+			break
+		}
+	}
+	text := make([]string, 0, i-start)
+	for _, l := range lines[start:i] {
+		text = append(text, strings.TrimLeft(l, " \t"))
+	}
+	if !tight {
+		r.out.WriteString("<p>")
+	}
+	r.out.WriteString(r.inline(strings.Join(text, "\n")))
+	if !tight {
+		r.out.WriteString("</p>")
+	}
+	r.out.WriteString("\n")
+	return i
+}
+
+// startsBlock reports whether line interrupts a paragraph.
+func startsBlock(line string) bool {
+	if indentOf(line) >= 4 {
+		return false
+	}
+	if m := listItemRx.FindStringSubmatch(line); m != nil {
+		// only non empty items starting with 1 interrupt a paragraph
+		n := m[2][:len(m[2])-1]
+		return len(strings.TrimSpace(line[len(m[0]):])) > 0 && (strings.ContainsAny(m[2], "-*+") || n == "1")
+	}
+	return fenceRx.MatchString(line) || atxHeadingRx.MatchString(line) || hrRx.MatchString(line) ||
+		strings.HasPrefix(strings.TrimLeft(line, " "), ">") || htmlBlockRx.MatchString(line)
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// indentOf returns the width of the leading
+// white space of line, with tab stops of 4.
+func indentOf(line string) int {
+	n := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			n++
+		case '\t':
+			n += 4 - n%4
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// trimIndent removes up to n columns of leading white space.
+func trimIndent(line string, n int) string {
+	col := 0
+	for j, c := range line {
+		if col >= n {
+			return line[j:]
+		}
+		switch c {
+		case ' ':
+			col++
+		case '\t':
+			w := 4 - col%4
+			if col+w > n {
+				return strings.Repeat(" ", col+w-n) + line[j+1:]
+			}
+			col += w
+		default:
+			return line[j:]
+		}
+	}
+	return ""
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
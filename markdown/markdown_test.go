@@ -0,0 +1,112 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+var toHTMLTestCases = []struct {
+	name     string
+	src      string
+	expected string
+}{
+	{
+		name:     "heading and paragraph",
+		src:      "# Hello *world*\n\nSome `code` and **bold**\ntext.",
+		expected: "<h1 id=\"readme-hello-world\">Hello <em>world</em></h1>\n<p>Some <code>code</code> and <strong>bold</strong>\ntext.</p>\n",
+	},
+	{
+		name:     "setext heading",
+		src:      "Title\n=====\n\nSub\n---",
+		expected: "<h1 id=\"readme-title\">Title</h1>\n<h2 id=\"readme-sub\">Sub</h2>\n",
+	},
+	{
+		name:     "fenced code",
+		src:      "```go\nfunc main() {\n\tfmt.Println(\"<hi>\")\n}\n```",
+		expected: "<pre><code class=\"language-go\">func main() {\n\tfmt.Println(&#34;&lt;hi&gt;&#34;)\n}\n</code></pre>\n",
+	},
+	{
+		name:     "tight list",
+		src:      "- one\n- two\n  - nested\n- three",
+		expected: "<ul>\n<li>one\n</li>\n<li>two\n<ul>\n<li>nested\n</li>\n</ul>\n</li>\n<li>three\n</li>\n</ul>\n",
+	},
+	{
+		name:     "ordered loose list",
+		src:      "3. one\n\n4. two",
+		expected: "<ol start=\"3\">\n<li><p>one</p>\n</li>\n<li><p>two</p>\n</li>\n</ol>\n",
+	},
+	{
+		name:     "relative links and images",
+		src:      "[docs](docs/guide.md) ![logo](img/logo.png) [abs](https://example.com) [top](#usage)",
+		expected: "<p><a href=\"/resolved/docs/guide.md\">docs</a> <img src=\"/raw/img/logo.png\" alt=\"logo\"> <a href=\"https://example.com\">abs</a> <a href=\"#readme-usage\">top</a></p>\n",
+	},
+	{
+		name:     "badge with references",
+		src:      "[![Build][badge]][ci]\n\n[badge]: https://ci.example.com/badge.svg\n[ci]: https://ci.example.com \"CI\"",
+		expected: "<p><a href=\"https://ci.example.com\" title=\"CI\"><img src=\"https://ci.example.com/badge.svg\" alt=\"Build\"></a></p>\n",
+	},
+	{
+		name:     "unsafe urls",
+		src:      "[x](javascript:alert(1)) ![y](data:image/png;base64,AAAA) <vbscript:foo>",
+		expected: "<p>x y &lt;vbscript:foo&gt;</p>\n",
+	},
+	{
+		name:     "raw html",
+		src:      "<p align=\"center\" onclick=\"evil()\">\n<img src=\"logo.png\" onerror=\"evil()\" width=\"100\">\n<script>alert(1)</script>\n</p>",
+		expected: "<p align=\"center\">\n<img src=\"/raw/logo.png\" width=\"100\">\nalert(1)\n</p>\n",
+	},
+	{
+		name:     "unbalanced html",
+		src:      "<div>\n\nopen </span> *end*",
+		expected: "<div>\n<p>open  <em>end</em></p>\n</div>",
+	},
+	{
+		name:     "table",
+		src:      "| a | b |\n|:--|--:|\n| `x\\|y` | 2 |",
+		expected: "<table>\n<thead>\n<tr><th style=\"text-align: left\">a</th><th style=\"text-align: right\">b</th></tr>\n</thead>\n<tbody>\n<tr><td style=\"text-align: left\"><code>x|y</code></td><td style=\"text-align: right\">2</td></tr>\n</tbody>\n</table>\n",
+	},
+	{
+		name:     "blockquote and rule",
+		src:      "> quoted\ntext\n\n***",
+		expected: "<blockquote>\n<p>quoted\ntext</p>\n</blockquote>\n<hr>\n",
+	},
+	{
+		name:     "autolinks and emphasis edge cases",
+		src:      "see https://example.com/a_b. snake_case_name and 2 * 3 * 4",
+		expected: "<p>see <a href=\"https://example.com/a_b\">https://example.com/a_b</a>. snake_case_name and 2 * 3 * 4</p>\n",
+	},
+	{
+		name:     "duplicate headings",
+		src:      "## Usage\n## Usage",
+		expected: "<h2 id=\"readme-usage\">Usage</h2>\n<h2 id=\"readme-usage-1\">Usage</h2>\n",
+	},
+}
+
+func TestToHTML(t *testing.T) {
+	opts := Options{
+		IDPrefix: "readme-",
+		ResolveURL: func(dest string, image bool) string {
+			if image {
+				return "/raw/" + dest
+			}
+			return "/resolved/" + dest
+		},
+	}
+	for _, tc := range toHTMLTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := string(ToHTML([]byte(tc.src), opts))
+			if got != tc.expected {
+				t.Fatalf("expected\n%q\nbut got\n%q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestToHTMLRawHTMLResolves(t *testing.T) {
+	got := string(ToHTML([]byte(`<a href="javascript:x"><img src="a.png"></a>`), Options{
+		ResolveURL: func(dest string, image bool) string { return "/raw/" + dest },
+	}))
+	if strings.Contains(got, "javascript") || !strings.Contains(got, `<img src="/raw/a.png">`) {
+		t.Fatalf("unexpected html %q", got)
+	}
+}
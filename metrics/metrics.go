@@ -0,0 +1,259 @@
+// Package metrics implements the counters, gauges and histograms of
+// moddoc and serves them in the Prometheus text exposition format.
+// Packages declare their metrics in package variables, which are
+// registered in the Default registry.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Default is the registry of the metrics created by
+// NewCounter, NewGauge and NewHistogram.
+var Default = &Registry{}
+
+// DefBuckets are the upper bounds, in seconds, of the
+// histogram buckets of durations.
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// Registry is a set of metrics.
+type Registry struct {
+	mu      sync.Mutex
+	metrics []*metric
+}
+
+// Counter is a value that only goes up, partitioned by labels.
+type Counter struct{ m *metric }
+
+// Gauge is a value that goes up and down, partitioned by labels.
+type Gauge struct{ m *metric }
+
+// Histogram counts observations in buckets, partitioned by labels.
+type Histogram struct{ m *metric }
+
+// NewCounter returns a Counter registered in the Default registry.
+func NewCounter(name, help string, labels ...string) *Counter {
+	return Default.NewCounter(name, help, labels...)
+}
+
+// NewGauge returns a Gauge registered in the Default registry.
+func NewGauge(name, help string, labels ...string) *Gauge {
+	return Default.NewGauge(name, help, labels...)
+}
+
+// NewHistogram returns a Histogram registered in the Default registry.
+func NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	return Default.NewHistogram(name, help, buckets, labels...)
+}
+
+// NewCounter returns a Counter registered in r.
+func (r *Registry) NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{r.register(name, help, "counter", nil, labels)}
+}
+
+// NewGauge returns a Gauge registered in r.
+func (r *Registry) NewGauge(name, help string, labels ...string) *Gauge {
+	return &Gauge{r.register(name, help, "gauge", nil, labels)}
+}
+
+// NewHistogram returns a Histogram registered in r.
+// The buckets are the sorted upper bounds of the buckets.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	return &Histogram{r.register(name, help, "histogram", buckets, labels)}
+}
+
+// Inc adds 1 to the counter of the label values.
+func (c *Counter) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds v, which must not be negative,
+// to the counter of the label values.
+func (c *Counter) Add(v float64, labelValues ...string) {
+	c.m.update(labelValues, func(s *series) { s.value += v })
+}
+
+// Inc adds 1 to the gauge of the label values.
+func (g *Gauge) Inc(labelValues ...string) {
+	g.Add(1, labelValues...)
+}
+
+// Dec subtracts 1 from the gauge of the label values.
+func (g *Gauge) Dec(labelValues ...string) {
+	g.Add(-1, labelValues...)
+}
+
+// Add adds v to the gauge of the label values.
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	g.m.update(labelValues, func(s *series) { s.value += v })
+}
+
+// Set sets the gauge of the label values to v.
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	g.m.update(labelValues, func(s *series) { s.value = v })
+}
+
+// Observe adds v to the histogram of the label values.
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	h.m.update(labelValues, func(s *series) {
+		s.value += v
+		s.count++
+		for i, b := range h.m.buckets {
+			if v <= b {
+				s.buckets[i]++
+			}
+		}
+	})
+}
+
+// Handler serves the metrics of the Default registry.
+func Handler() http.Handler {
+	return Default
+}
+
+// ServeHTTP serves the metrics of r in the Prometheus text format.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	r.WriteTo(w)
+}
+
+// WriteTo writes the metrics of r in the Prometheus text format.
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	metrics := append([]*metric(nil), r.metrics...)
+	r.mu.Unlock()
+	bw := bufio.NewWriter(w)
+	cw := &countWriter{w: bw}
+	for _, m := range metrics {
+		m.write(cw)
+	}
+	if cw.err != nil {
+		return cw.n, cw.err
+	}
+	return cw.n, bw.Flush()
+}
+
+func (r *Registry) register(name, help, typ string, buckets []float64, labels []string) *metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, m := range r.metrics {
+		if m.name == name {
+			panic("metrics: " + name + " is already registered")
+		}
+	}
+	m := &metric{name: name, help: help, typ: typ, labels: labels, buckets: buckets, series: map[string]*series{}}
+	r.metrics = append(r.metrics, m)
+	return m
+}
+
+type metric struct {
+	name, help, typ string
+	labels          []string
+	buckets         []float64
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+	count       uint64
+	buckets     []uint64
+}
+
+func (m *metric) update(labelValues []string, f func(s *series)) {
+	if len(labelValues) != len(m.labels) {
+		panic(fmt.Sprintf("metrics: %v has labels %v, got values %v", m.name, m.labels, labelValues))
+	}
+	key := strings.Join(labelValues, "\xff")
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...), buckets: make([]uint64, len(m.buckets))}
+		m.series[key] = s
+	}
+	f(s)
+}
+
+func (m *metric) write(w *countWriter) {
+	fmt.Fprintf(w, "# HELP %v %v\n", m.name, strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(m.help))
+	fmt.Fprintf(w, "# TYPE %v %v\n", m.name, m.typ)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	keys := make([]string, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := m.series[k]
+		if m.typ != "histogram" {
+			fmt.Fprintf(w, "%v%v %v\n", m.name, m.labelPairs(s, "", 0), formatFloat(s.value))
+			continue
+		}
+		for i, b := range m.buckets {
+			fmt.Fprintf(w, "%v_bucket%v %v\n", m.name, m.labelPairs(s, "le", b), s.buckets[i])
+		}
+		fmt.Fprintf(w, "%v_bucket%v %v\n", m.name, m.labelPairs(s, "le", math.Inf(1)), s.count)
+		fmt.Fprintf(w, "%v_sum%v %v\n", m.name, m.labelPairs(s, "", 0), formatFloat(s.value))
+		fmt.Fprintf(w, "%v_count%v %v\n", m.name, m.labelPairs(s, "", 0), s.count)
+	}
+}
+
+// labelPairs formats the labels of s, adding
+// the extra label with the value v if it is set.
+func (m *metric) labelPairs(s *series, extra string, v float64) string {
+	var pairs []string
+	for i, l := range m.labels {
+		pairs = append(pairs, l+"="+quote(s.labelValues[i]))
+	}
+	if extra != "" {
+		pairs = append(pairs, extra+"="+quote(formatFloat(v)))
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func quote(v string) string {
+	return `"` + labelEscaper.Replace(v) + `"`
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	n, err := w.w.Write(p)
+	w.n += int64(n)
+	w.err = err
+	return n, err
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const expectedOutput = `# HELP test_requests_total Requests by route.
+# TYPE test_requests_total counter
+test_requests_total{route="doc",code="200"} 2
+test_requests_total{route="say \"hi\"\n",code="500"} 1
+# HELP test_in_flight Builds in flight.
+# TYPE test_in_flight gauge
+test_in_flight 1
+# HELP test_duration_seconds Durations.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{endpoint="zip",le="0.1"} 1
+test_duration_seconds_bucket{endpoint="zip",le="1"} 2
+test_duration_seconds_bucket{endpoint="zip",le="+Inf"} 3
+test_duration_seconds_sum{endpoint="zip"} 3.55
+test_duration_seconds_count{endpoint="zip"} 3
+`
+
+func TestWrite(t *testing.T) {
+	r := &Registry{}
+	requests := r.NewCounter("test_requests_total", "Requests by route.", "route", "code")
+	inFlight := r.NewGauge("test_in_flight", "Builds in flight.")
+	durations := r.NewHistogram("test_duration_seconds", "Durations.", []float64{0.1, 1}, "endpoint")
+
+	requests.Inc("doc", "200")
+	requests.Add(1, "doc", "200")
+	requests.Inc("say \"hi\"\n", "500")
+	inFlight.Inc()
+	inFlight.Inc()
+	inFlight.Dec()
+	durations.Observe(0.05, "zip")
+	durations.Observe(0.5, "zip")
+	durations.Observe(3, "zip")
+
+	var buf bytes.Buffer
+	if _, err := r.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != expectedOutput {
+		t.Fatalf("expected:\n%v\nbut got:\n%v", expectedOutput, got)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Fatalf("expected the Prometheus text format but got %v", ct)
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	r := &Registry{}
+	r.NewCounter("test_total", "")
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected registering a metric twice to panic")
+		}
+	}()
+	r.NewGauge("test_total", "")
+}
@@ -0,0 +1,48 @@
+package modgraph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+
+	"marwan.io/moddoc/gocopy/module"
+)
+
+// WriteText writes the graph in the format of go mod graph:
+// one requirement per line, with the main module unversioned.
+func (g *Graph) WriteText(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	for _, e := range g.Edges {
+		fmt.Fprintf(bw, "%s %s\n", g.name(e.From), g.name(e.To))
+	}
+	return bw.Flush()
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. Selected
+// module versions are drawn in bold and requirements that MVS
+// upgraded are drawn in red.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %s {\n", strconv.Quote(g.Main.Path))
+	fmt.Fprintf(bw, "\t%s [shape=box, style=bold];\n", strconv.Quote(g.name(g.Main)))
+	for _, m := range g.Modules {
+		fmt.Fprintf(bw, "\t%s [style=bold];\n", strconv.Quote(g.name(module.Version{Path: m.Path, Version: m.Version})))
+	}
+	for _, e := range g.Edges {
+		attrs := ""
+		if e.Upgraded {
+			attrs = " [color=red]"
+		}
+		fmt.Fprintf(bw, "\t%s -> %s%s;\n", strconv.Quote(g.name(e.From)), strconv.Quote(g.name(e.To)), attrs)
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func (g *Graph) name(m module.Version) string {
+	if m == g.Main {
+		return m.Path
+	}
+	return m.Path + "@" + m.Version
+}
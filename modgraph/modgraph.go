@@ -0,0 +1,248 @@
+// Package modgraph resolves the requirement graph of a module
+// version using minimal version selection over the go.mod files
+// served by a GOPROXY, the same way go mod graph and go list -m all
+// see it from inside that module.
+package modgraph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"marwan.io/moddoc/gocopy/modfile"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/gocopy/semver"
+)
+
+// parallelism is the number of go.mod files fetched at once.
+const parallelism = 8
+
+// ModSource returns the go.mod file of a module version, given
+// the encoded module path and version. It is satisfied by proxy.Service.
+type ModSource interface {
+	GetMod(ctx context.Context, mod, ver string) (*modfile.File, error)
+}
+
+// Graph is the resolved requirement graph of a main module.
+type Graph struct {
+	Main module.Version
+	// Modules are the dependencies of Main at their
+	// selected version, sorted by path.
+	Modules []*Module
+	// Edges are all the requirements between the
+	// module versions reachable from Main.
+	Edges []*Edge
+}
+
+// Module is a dependency of the main module.
+type Module struct {
+	Path string
+	// Version is the version selected by MVS.
+	Version string
+	// Direct is true if the main module's go.mod requires this
+	// module, in which case Required is the version it asks for.
+	Direct   bool
+	Required string
+	// Upgraded is true if MVS selected a higher version than the one
+	// required by the main module because another dependency needs it.
+	Upgraded bool
+	// Replace is what the main module replaces this module with, if any.
+	Replace *module.Version
+}
+
+// Edge is a requirement of one module version on another.
+type Edge struct {
+	From module.Version
+	To   module.Version
+	// Upgraded is true if To is not the selected version of To.Path.
+	Upgraded bool
+}
+
+// Resolve fetches the go.mod of main and of every module version
+// it transitively requires, and selects the maximum required version
+// of each module. Replacements and exclusions of the main module's
+// go.mod are honored, like the go command does.
+func Resolve(ctx context.Context, src ModSource, main module.Version) (*Graph, error) {
+	mainMod, err := getMod(ctx, src, main)
+	if err != nil {
+		return nil, err
+	}
+	r := &resolver{
+		src:      src,
+		main:     main,
+		replace:  map[module.Version]module.Version{},
+		exclude:  map[module.Version]bool{},
+		reqs:     map[module.Version][]module.Version{},
+		selected: map[string]string{},
+	}
+	for _, rep := range mainMod.Replace {
+		r.replace[rep.Old] = rep.New
+	}
+	for _, ex := range mainMod.Exclude {
+		r.exclude[ex.Mod] = true
+	}
+	r.reqs[main] = r.filter(mainMod)
+	err = r.walk(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.graph(mainMod), nil
+}
+
+type resolver struct {
+	src      ModSource
+	main     module.Version
+	replace  map[module.Version]module.Version
+	exclude  map[module.Version]bool
+	reqs     map[module.Version][]module.Version
+	selected map[string]string
+}
+
+// walk visits every module version reachable from the main module,
+// fetching their go.mod files concurrently.
+func (r *resolver) walk(ctx context.Context) error {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, parallelism)
+		seen     = map[module.Version]bool{r.main: true}
+	)
+	var enqueue func(reqs []module.Version)
+	visit := func(m module.Version) {
+		defer wg.Done()
+		sem <- struct{}{}
+		reqs, err := r.required(ctx, m)
+		<-sem
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		r.reqs[m] = reqs
+		enqueue(reqs)
+	}
+	enqueue = func(reqs []module.Version) {
+		for _, req := range reqs {
+			if seen[req] {
+				continue
+			}
+			seen[req] = true
+			if semver.Compare(req.Version, r.selected[req.Path]) > 0 {
+				r.selected[req.Path] = req.Version
+			}
+			wg.Add(1)
+			go visit(req)
+		}
+	}
+	mu.Lock()
+	enqueue(r.reqs[r.main])
+	mu.Unlock()
+	wg.Wait()
+	return firstErr
+}
+
+// required returns the requirements of m, reading the go.mod of its
+// replacement if the main module replaces it. Modules replaced by a
+// local directory cannot be fetched and are treated as having no
+// requirements.
+func (r *resolver) required(ctx context.Context, m module.Version) ([]module.Version, error) {
+	target := m
+	if rep, ok := r.replace[m]; ok {
+		target = rep
+	} else if rep, ok := r.replace[module.Version{Path: m.Path}]; ok {
+		target = rep
+	}
+	if target.Version == "" {
+		return nil, nil
+	}
+	f, err := getMod(ctx, r.src, target)
+	if err != nil {
+		return nil, err
+	}
+	return r.filter(f), nil
+}
+
+func (r *resolver) filter(f *modfile.File) []module.Version {
+	reqs := make([]module.Version, 0, len(f.Require))
+	for _, req := range f.Require {
+		if !r.exclude[req.Mod] {
+			reqs = append(reqs, req.Mod)
+		}
+	}
+	return reqs
+}
+
+func (r *resolver) graph(mainMod *modfile.File) *Graph {
+	g := &Graph{Main: r.main}
+	direct := map[string]string{}
+	for _, req := range r.reqs[r.main] {
+		direct[req.Path] = req.Version
+	}
+	for path, ver := range r.selected {
+		if path == r.main.Path {
+			continue
+		}
+		m := &Module{Path: path, Version: ver}
+		m.Required, m.Direct = direct[path]
+		m.Upgraded = m.Direct && m.Required != ver
+		if rep, ok := r.replace[module.Version{Path: path, Version: ver}]; ok {
+			m.Replace = &rep
+		} else if rep, ok := r.replace[module.Version{Path: path}]; ok {
+			m.Replace = &rep
+		}
+		g.Modules = append(g.Modules, m)
+	}
+	sort.Slice(g.Modules, func(i, j int) bool {
+		return g.Modules[i].Path < g.Modules[j].Path
+	})
+	for from, reqs := range r.reqs {
+		for _, to := range reqs {
+			g.Edges = append(g.Edges, &Edge{
+				From:     from,
+				To:       to,
+				Upgraded: r.selected[to.Path] != to.Version,
+			})
+		}
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		ei, ej := g.Edges[i], g.Edges[j]
+		if ei.From != ej.From {
+			return less(ei.From, ej.From, r.main)
+		}
+		return less(ei.To, ej.To, r.main)
+	})
+	return g
+}
+
+// less orders module versions by path then version,
+// with the main module first.
+func less(a, b, main module.Version) bool {
+	if a == main || b == main {
+		return a == main && b != main
+	}
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+	return semver.Compare(a.Version, b.Version) < 0
+}
+
+func getMod(ctx context.Context, src ModSource, m module.Version) (*modfile.File, error) {
+	encPath, err := module.EncodePath(m.Path)
+	if err != nil {
+		return nil, err
+	}
+	encVer, err := module.EncodeVersion(m.Version)
+	if err != nil {
+		return nil, err
+	}
+	f, err := src.GetMod(ctx, encPath, encVer)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve %v@%v: %v", m.Path, m.Version, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,85 @@
+package modgraph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"marwan.io/moddoc/gocopy/modfile"
+	"marwan.io/moddoc/gocopy/module"
+)
+
+type fakeSource map[string]string
+
+func (fs fakeSource) GetMod(ctx context.Context, mod, ver string) (*modfile.File, error) {
+	content, ok := fs[mod+"@"+ver]
+	if !ok {
+		return nil, fmt.Errorf("not found")
+	}
+	return modfile.Parse("go.mod", []byte(content), nil)
+}
+
+var diamond = fakeSource{
+	"example.com/main@v1.0.0": `module example.com/main
+require (
+	example.com/a v1.0.0
+	example.com/b v1.0.0
+	example.com/old v1.0.0
+)
+replace example.com/old => example.com/new v1.1.0
+`,
+	"example.com/a@v1.0.0":   "module example.com/a\nrequire example.com/c v1.1.0\n",
+	"example.com/b@v1.0.0":   "module example.com/b\nrequire (\n\texample.com/c v1.2.0\n\texample.com/a v1.1.0\n)\n",
+	"example.com/a@v1.1.0":   "module example.com/a\nrequire example.com/c v1.1.0\n",
+	"example.com/c@v1.1.0":   "module example.com/c\n",
+	"example.com/c@v1.2.0":   "module example.com/c\n",
+	"example.com/new@v1.1.0": "module example.com/new\nrequire example.com/c v1.0.0\n",
+	"example.com/c@v1.0.0":   "module example.com/c\n",
+}
+
+func TestResolve(t *testing.T) {
+	g, err := Resolve(context.Background(), diamond, module.Version{Path: "example.com/main", Version: "v1.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, m := range g.Modules {
+		got = append(got, fmt.Sprintf("%v@%v direct=%v upgraded=%v", m.Path, m.Version, m.Direct, m.Upgraded))
+	}
+	expected := []string{
+		"example.com/a@v1.1.0 direct=true upgraded=true",
+		"example.com/b@v1.0.0 direct=true upgraded=false",
+		"example.com/c@v1.2.0 direct=false upgraded=false",
+		"example.com/old@v1.0.0 direct=true upgraded=false",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Fatalf("expected modules\n%v\nbut got\n%v", strings.Join(expected, "\n"), strings.Join(got, "\n"))
+	}
+	if g.Modules[3].Replace == nil || g.Modules[3].Replace.Path != "example.com/new" {
+		t.Fatalf("expected example.com/old to be replaced but got %+v", g.Modules[3].Replace)
+	}
+
+	var sb strings.Builder
+	g.WriteText(&sb)
+	expectedText := `example.com/main example.com/a@v1.0.0
+example.com/main example.com/b@v1.0.0
+example.com/main example.com/old@v1.0.0
+example.com/a@v1.0.0 example.com/c@v1.1.0
+example.com/a@v1.1.0 example.com/c@v1.1.0
+example.com/b@v1.0.0 example.com/a@v1.1.0
+example.com/b@v1.0.0 example.com/c@v1.2.0
+example.com/old@v1.0.0 example.com/c@v1.0.0
+`
+	if sb.String() != expectedText {
+		t.Fatalf("expected graph\n%v\nbut got\n%v", expectedText, sb.String())
+	}
+}
+
+func TestResolveMissingMod(t *testing.T) {
+	src := fakeSource{"example.com/main@v1.0.0": "module example.com/main\nrequire example.com/gone v1.0.0\n"}
+	_, err := Resolve(context.Background(), src, module.Version{Path: "example.com/main", Version: "v1.0.0"})
+	if err == nil || !strings.Contains(err.Error(), "example.com/gone@v1.0.0") {
+		t.Fatalf("expected an error about example.com/gone but got %v", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"go/printer"
 	"go/token"
 	"html/template"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
@@ -21,65 +22,95 @@ import (
 	proxydoc "marwan.io/moddoc/doc"
 	"marwan.io/moddoc/gocopy/modfile"
 	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/logging"
 )
 
 type builder struct {
 	fset     *token.FileSet
 	examples []*doc.Example
 	mods     []*modFile
+	mode     doc.Mode
+	log      *logging.Logger
+	links    proxydoc.Links
 }
 
 func (b *builder) getGoDoc(ctx context.Context, mod, ver, subpkg string, files []*file) (*proxydoc.Documentation, error) {
 	b.fset = token.NewFileSet()
 	mp := map[string]*ast.File{}
-	dirMap := map[string]struct{}{}
+	pkgDirs := map[string]bool{}
+	modDirs := map[string]bool{}
 	pkgName := ""
-	// TODO: parse sub directories to get synopsis
+	modRoot, _ := module.DecodePath(mod)
+	if subpkg != "" {
+		modRoot = strings.TrimSuffix(modRoot, "/"+subpkg)
+	}
+	pkgImports := []*ast.ImportSpec{}
 	pkgFiles := []*proxydoc.File{}
 	testFiles := []*ast.File{}
+	nested := nestedModules(files)
 	for _, f := range files {
 		if filepath.Base(f.Name) == "go.mod" {
+			if modDir := filepath.ToSlash(getDir(f.Name)); nested[modDir] {
+				if dir, valid := getRelativeDir(f.Name, subpkg); valid && dir != "." && !ignoredDir(dir) {
+					modDirs[filepath.ToSlash(dir)] = true
+				}
+				continue
+			}
 			modf, err := modfile.Parse("go.mod", f.Content, nil)
 			if err != nil {
 				return nil, err
 			}
-			b.mods = append(b.mods, &modFile{path: filepath.Dir(f.Name), file: modf})
+			b.mods = append(b.mods, &modFile{path: path.Join(modRoot, filepath.ToSlash(getDir(f.Name))), file: modf})
 			continue
 		}
 		if filepath.Ext(f.Name) != ".go" {
 			continue
 		}
+		if inNestedModule(filepath.ToSlash(getDir(f.Name)), nested) {
+			continue
+		}
 		dir, valid := getRelativeDir(f.Name, subpkg)
 		if !valid {
 			continue
 		}
 		if dir != "." {
-			dirMap[dir] = struct{}{}
+			if !ignoredDir(dir) && !strings.HasSuffix(f.Name, "_test.go") {
+				pkgDirs[filepath.ToSlash(dir)] = true
+			}
 			continue
 		}
 		astFile, err := parser.ParseFile(b.fset, f.Name, f.Content, parser.ParseComments)
 		if err != nil {
 			return nil, err
 		}
+		if buildIgnored(astFile) {
+			continue
+		}
 		if strings.HasSuffix(f.Name, "_test.go") {
 			testFiles = append(testFiles, astFile)
 			continue
 		}
 		mp[f.Name] = astFile
-		if pkgName == "" {
-			pkgName = astFile.Name.String()
-		}
-		pkgFiles = append(pkgFiles, &proxydoc.File{Name: filepath.Base(f.Name)})
+		pkgImports = append(pkgImports, astFile.Imports...)
+		pkgName = preferredName(pkgName, astFile.Name.String())
+		pkgFiles = append(pkgFiles, &proxydoc.File{
+			Name: filepath.Base(f.Name),
+			Link: b.links.Raw(modRoot, ver, zipPath(f.Name)),
+		})
 	}
 	b.examples = doc.Examples(testFiles...)
 	astPkg := &ast.Package{Name: mod, Files: mp}
-	dpkg := doc.New(astPkg, mod, doc.Mode(0))
+	dpkg := doc.New(astPkg, mod, b.mode)
 	var d proxydoc.Documentation
 	d.PackageName = pkgName
 	var sb strings.Builder
 	doc.ToHTML(&sb, dpkg.Doc, nil)
 	d.PackageDoc = template.HTML(sb.String())
+	d.PackageDocText = dpkg.Doc
 	d.ImportPath, _ = module.DecodePath(mod)
+	if pkgName == "main" {
+		d.Command = commandName(d.ImportPath)
+	}
 	d.Constants = b.getConsts(dpkg.Consts)
 	d.Variables = b.getConsts(dpkg.Vars)
 	d.Funcs = b.getFuncs(dpkg.Funcs, "")
@@ -87,35 +118,51 @@ func (b *builder) getGoDoc(ctx context.Context, mod, ver, subpkg string, files [
 	d.Files = pkgFiles
 	d.Examples = b.getExamples("")
 	d.ModuleVersion = ver
-	for subDir := range dirMap {
-		d.Subdirs = append(d.Subdirs, &proxydoc.Subdir{
-			Name:     subDir,
-			Synopsis: getSynopsis(subDir, files),
-			Link:     filepath.Join("/", d.ImportPath, subDir, "@v", d.ModuleVersion),
-		})
-	}
-	sort.Slice(d.Subdirs, func(i, j int) bool {
-		return d.Subdirs[i].Name < d.Subdirs[j].Name
-	})
+	d.Subdirs = getSubdirs(d.ImportPath, ver, subpkg, pkgDirs, modDirs, files, b.links)
 
+	var modf *modFile
 	if len(b.mods) > 0 {
-		modf := b.getClosestModFile(mod)
+		modf = b.getClosestModFile(d.ImportPath)
+	}
+	if modf != nil {
 		d.GoMod = b.getMod(modf.file)
 	}
+	var nestedPaths []string
+	for dir := range nested {
+		nestedPaths = append(nestedPaths, path.Join(modRoot, dir))
+	}
+	d.Imports = getImports(pkgImports, modRoot, nestedPaths, ver, modf, b.links)
+	if subpkg == "" {
+		d.Readme = getReadme(files, modRoot, ver, b.links)
+	}
+	d.Licenses = getLicenses(files, subpkg)
 
-	d.NavLinks = []string{"Index"}
-	if len(d.Examples) > 0 {
-		d.NavLinks = append(d.NavLinks, "Examples")
+	// a command's exported identifiers are not an API,
+	// its package doc is all there is to read.
+	if d.Command == "" {
+		d.NavLinks = append(d.NavLinks, "Index")
+		if len(d.Examples) > 0 {
+			d.NavLinks = append(d.NavLinks, "Examples")
+		}
+	}
+	if d.Readme != "" {
+		d.NavLinks = append(d.NavLinks, "README")
 	}
 	if len(d.Files) > 0 {
 		d.NavLinks = append(d.NavLinks, "Files")
 	}
+	if len(d.Imports.Stdlib)+len(d.Imports.Module)+len(d.Imports.ThirdParty) > 0 {
+		d.NavLinks = append(d.NavLinks, "Imports")
+	}
 	if len(d.GoMod) > 0 {
 		d.NavLinks = append(d.NavLinks, "Go.mod")
 	}
 	if len(d.Subdirs) > 0 {
 		d.NavLinks = append(d.NavLinks, "Directories")
 	}
+	if len(d.Licenses) > 0 {
+		d.NavLinks = append(d.NavLinks, "Licenses")
+	}
 
 	return &d, nil
 }
@@ -123,32 +170,185 @@ func (b *builder) getGoDoc(ctx context.Context, mod, ver, subpkg string, files [
 func (b *builder) getMod(mod *modfile.File) template.HTML {
 	mp := map[string]string{}
 	for _, req := range mod.Require {
-		mp[req.Mod.Path] = fmt.Sprintf(`/%s/@v/%s`, req.Mod.Path, req.Mod.Version)
+		mp[req.Mod.Path] = b.links.Version(req.Mod.Path, req.Mod.Version)
 	}
 	for _, rep := range mod.Replace {
-		mp[rep.New.Path] = fmt.Sprintf(`/%s/@v/%s`, rep.New.Path, rep.New.Version)
+		mp[rep.New.Path] = b.links.Version(rep.New.Path, rep.New.Version)
 	}
 	return template.HTML(modfile.FormatHTML(mod.Syntax, mp))
 }
 
-func getSynopsis(subDir string, files []*file) string {
+// nestedModules returns the module relative directories, other than the
+// module root, that have a go.mod file. The go tool treats them and
+// everything below them as separate modules.
+func nestedModules(files []*file) map[string]bool {
+	nested := map[string]bool{}
 	for _, f := range files {
-		dir := filepath.Dir(f.Name)
-		fileInDir := strings.HasSuffix(dir, subDir)
-		ext := filepath.Ext(f.Name)
-		if fileInDir && ext == ".go" {
-			astFile, err := parser.ParseFile(token.NewFileSet(), f.Name, f.Content, parser.ParseComments)
+		if filepath.Base(f.Name) != "go.mod" {
+			continue
+		}
+		if dir := filepath.ToSlash(getDir(f.Name)); dir != "." {
+			nested[dir] = true
+		}
+	}
+	return nested
+}
+
+// inNestedModule reports whether the module relative
+// dir belongs to one of the nested modules.
+func inNestedModule(dir string, nested map[string]bool) bool {
+	for ; dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if nested[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// getSubdirs lays out the package and nested module directories below
+// importPath as a tree, adding the directories in between that hold
+// no package themselves. Nested modules link to their latest version.
+func getSubdirs(importPath, ver, subpkg string, pkgDirs, modDirs map[string]bool, files []*file, links proxydoc.Links) []*proxydoc.Subdir {
+	all := map[string]bool{}
+	for _, set := range []map[string]bool{pkgDirs, modDirs} {
+		for dir := range set {
+			for ; dir != "." && !all[dir]; dir = path.Dir(dir) {
+				all[dir] = true
+			}
+		}
+	}
+	subdirs := make([]*proxydoc.Subdir, 0, len(all))
+	for dir := range all {
+		sd := &proxydoc.Subdir{Name: dir}
+		switch {
+		case modDirs[dir]:
+			sd.Module = true
+			sd.Link = links.Latest(path.Join(importPath, dir))
+		case pkgDirs[dir]:
+			name, synopsis, err := getSubdirDoc(subpkg, dir, files)
+			sd.Synopsis = synopsis
+			sd.Command = name == "main"
 			if err != nil {
-				fmt.Println(err)
-				// TODO: handle
+				sd.Warning = err.Error()
+			}
+			sd.Link = links.Version(path.Join(importPath, dir), ver)
+		}
+		subdirs = append(subdirs, sd)
+	}
+	sort.Slice(subdirs, func(i, j int) bool {
+		return lessPath(subdirs[i].Name, subdirs[j].Name)
+	})
+	for _, sd := range subdirs {
+		sd.Depth = strings.Count(sd.Name, "/")
+	}
+	return subdirs
+}
+
+// lessPath orders slash separated paths element by
+// element, so that a directory comes right before
+// its sub directories.
+func lessPath(a, b string) bool {
+	ae, be := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := 0; i < len(ae) && i < len(be); i++ {
+		if ae[i] != be[i] {
+			return ae[i] < be[i]
+		}
+	}
+	return len(ae) < len(be)
+}
+
+// getSubdirDoc returns the package name and synopsis of the package in
+// subDir, relative to subpkg. Like go/doc, it merges the package comments
+// of all the files of the package, starting with doc.go which conventionally
+// holds it. Files that cannot be parsed are skipped and reported in the
+// returned error.
+func getSubdirDoc(subpkg, subDir string, files []*file) (name, syn string, err error) {
+	var dirFiles []*file
+	for _, f := range files {
+		dir, valid := getRelativeDir(f.Name, subpkg)
+		if !valid || filepath.ToSlash(dir) != subDir || f.Content == nil || !isGoFile(f.Name) || strings.HasSuffix(f.Name, "_test.go") {
+			continue
+		}
+		dirFiles = append(dirFiles, f)
+	}
+	sort.Slice(dirFiles, func(i, j int) bool {
+		iDoc, jDoc := path.Base(dirFiles[i].Name) == "doc.go", path.Base(dirFiles[j].Name) == "doc.go"
+		if iDoc != jDoc {
+			return iDoc
+		}
+		return dirFiles[i].Name < dirFiles[j].Name
+	})
+	fset := token.NewFileSet()
+	var docs, errs []string
+	for _, f := range dirFiles {
+		astFile, err := parser.ParseFile(fset, path.Base(f.Name), f.Content, parser.PackageClauseOnly|parser.ParseComments)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		if buildIgnored(astFile) {
+			continue
+		}
+		name = preferredName(name, astFile.Name.Name)
+		if astFile.Doc != nil {
+			docs = append(docs, astFile.Doc.Text())
+		}
+	}
+	if len(errs) > 0 {
+		err = fmt.Errorf("could not parse %v", strings.Join(errs, "; "))
+	}
+	return name, synopsis(strings.Join(docs, "\n")), err
+}
+
+// buildIgnored reports whether f has an ignore build constraint,
+// such as the generators that go run but that go build skips.
+func buildIgnored(f *ast.File) bool {
+	for _, cg := range f.Comments {
+		if cg.Pos() >= f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			var expr string
+			switch {
+			case strings.HasPrefix(c.Text, "//go:build "):
+				expr = c.Text[len("//go:build "):]
+			case strings.HasPrefix(c.Text, "// +build "):
+				expr = c.Text[len("// +build "):]
+			default:
+				continue
 			}
-			if astFile.Doc != nil {
-				return synopsis(astFile.Doc.Text())
+			terms := strings.FieldsFunc(expr, func(r rune) bool {
+				return r != '!' && r != '_' && r != '.' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			})
+			for _, term := range terms {
+				if term == "ignore" {
+					return true
+				}
 			}
 		}
 	}
+	return false
+}
 
-	return ""
+// preferredName returns the package name of a directory given the
+// name found so far and the one of another file. A main package
+// that is left in a library directory does not make it a command.
+func preferredName(name, other string) string {
+	if name == "" || name == "main" {
+		return other
+	}
+	return name
+}
+
+// commandName returns the name of the binary that go install
+// builds for the command at importPath, skipping a major
+// version suffix like the go command does.
+func commandName(importPath string) string {
+	name := path.Base(importPath)
+	if dir := path.Dir(importPath); dir != "." && len(name) > 1 && name[0] == 'v' && strings.Trim(name[1:], "0123456789") == "" {
+		return path.Base(dir)
+	}
+	return name
 }
 
 func (b *builder) getTypes(types []*doc.Type) []*proxydoc.Type {
@@ -180,6 +380,7 @@ func (b *builder) getType(typ *doc.Type) *proxydoc.Type {
 	var docStr strings.Builder
 	doc.ToHTML(&docStr, typ.Doc, nil)
 	t.Doc = template.HTML(docStr.String())
+	t.DocText = typ.Doc
 	t.Constants = b.getConsts(typ.Consts)
 	t.Variables = b.getConsts(typ.Vars)
 	t.Examples = b.getExamples(t.Name)
@@ -237,11 +438,12 @@ func (b *builder) getFunc(f *doc.Func, typeName string) *proxydoc.Func {
 	var docBuilder strings.Builder
 	doc.ToHTML(&docBuilder, f.Doc, nil)
 	df.Doc = template.HTML(docBuilder.String())
+	df.DocText = f.Doc
 	// df.Signature = &proxydoc.FunctionSignature{} //TODO: make receiver/args/returns clickable.
 	var sb strings.Builder
 	err := format.Node(&sb, b.fset, f.Decl)
 	if err != nil {
-		fmt.Println("could not format function signature", err)
+		b.log.Warn("could not format function signature", "func", df.ID, "error", err)
 	}
 	df.SignatureString = sb.String()
 	df.MethodReceiverString = f.Recv
@@ -262,6 +464,7 @@ func (b *builder) getConsts(cc []*doc.Value) []*proxydoc.Value {
 		val := &proxydoc.Value{
 			IsGroup: len(c.Names) > 1,
 			Doc:     template.HTML(docBuilder.String()),
+			DocText: c.Doc,
 		}
 		if val.IsGroup {
 			for idx, n := range c.Names {
@@ -271,10 +474,11 @@ func (b *builder) getConsts(cc []*doc.Value) []*proxydoc.Value {
 				}
 				spec, ok := c.Decl.Specs[idx].(*ast.ValueSpec)
 				if !ok {
-					fmt.Printf("unrecognized group spec type: %T\n", c.Decl.Specs[idx])
+					b.log.Warn("unrecognized group spec type", "type", fmt.Sprintf("%T", c.Decl.Specs[idx]))
 					return vals
 				}
 				newV.Doc = template.HTML(spec.Doc.Text())
+				newV.DocText = spec.Doc.Text()
 				b.populateConstantsValueAndType(newV, spec)
 				val.Values = append(val.Values, newV)
 			}
@@ -282,7 +486,7 @@ func (b *builder) getConsts(cc []*doc.Value) []*proxydoc.Value {
 			val.Name = c.Names[0]
 			spec, ok := c.Decl.Specs[0].(*ast.ValueSpec)
 			if !ok {
-				fmt.Printf("unrecognized spec type: %T\n", c.Decl.Specs[0])
+				b.log.Warn("unrecognized spec type", "type", fmt.Sprintf("%T", c.Decl.Specs[0]))
 				return vals
 			}
 			b.populateConstantsValueAndType(val, spec)
@@ -306,7 +510,7 @@ func (b *builder) getValueFromSpec(v *proxydoc.Value, spec *ast.ValueSpec) strin
 	var sb strings.Builder
 	err := format.Node(&sb, b.fset, spec.Values[0])
 	if err != nil {
-		fmt.Println("value formatting error", err)
+		b.log.Warn("could not format value", "value", v.Name, "error", err)
 	}
 	return sb.String()
 }
@@ -378,7 +582,7 @@ func (b *builder) getExamples(name string) []*proxydoc.Example {
 		}
 		err := (&printer.Config{Mode: printer.UseSpaces, Tabwidth: 4}).Fprint(&codeBuilder, b.fset, nn)
 		if err != nil {
-			fmt.Println(err)
+			b.log.Warn("could not format example", "example", e.Name, "error", err)
 			continue
 		}
 
@@ -1,8 +1,15 @@
 package proxy
 
 import (
+	"context"
 	"fmt"
+	"go/parser"
+	"go/token"
+	"path"
 	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/modfile"
 )
 
 var getRelativeDirTestCases = []struct {
@@ -137,3 +144,250 @@ func TestLongestPath(t *testing.T) {
 		})
 	}
 }
+
+func TestGetImports(t *testing.T) {
+	src := `package lib
+
+import (
+	"fmt"
+	"net/http"
+
+	"example.com/lib/internal"
+	"example.com/lib/plugins/x"
+	"example.com/lib/plugins/xyz"
+	"github.com/pkg/errors"
+	"golang.org/x/text/language"
+	"example.com/unknown"
+)
+`
+	f, err := parser.ParseFile(token.NewFileSet(), "lib.go", src, parser.ImportsOnly)
+	if err != nil {
+		t.Fatal(err)
+	}
+	modf, err := modfile.Parse("go.mod", []byte(`module example.com/lib
+
+require (
+	example.com/lib/plugins/x v0.2.0
+	github.com/pkg/errors v0.8.1
+	golang.org/x/text v0.3.0
+)
+
+replace golang.org/x/text => example.com/fork/text v0.3.1
+`), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nested := []string{"example.com/lib/plugins/x"}
+	imps := getImports(f.Imports, "example.com/lib", nested, "v1.0.0", &modFile{path: "example.com/lib", file: modf}, proxydoc.Links{Base: "/godoc"})
+	links := func(list []*proxydoc.Import) []string {
+		ss := []string{}
+		for _, i := range list {
+			ss = append(ss, i.Link)
+		}
+		return ss
+	}
+	for _, tc := range []struct {
+		name     string
+		got      []string
+		expected []string
+	}{
+		{"stdlib", links(imps.Stdlib), []string{"/godoc/fmt", "/godoc/net/http"}},
+		{"module", links(imps.Module), []string{
+			"/godoc/example.com/lib/internal/@v/v1.0.0",
+			"/godoc/example.com/lib/plugins/xyz/@v/v1.0.0",
+		}},
+		{"third party", links(imps.ThirdParty), []string{
+			"/godoc/example.com/lib/plugins/x/@v/v0.2.0",
+			"/godoc/example.com/unknown",
+			"/godoc/github.com/pkg/errors/@v/v0.8.1",
+			"/godoc/example.com/fork/text/language/@v/v0.3.1",
+		}},
+	} {
+		if fmt.Sprint(tc.got) != fmt.Sprint(tc.expected) {
+			t.Fatalf("expected %v imports to be %v but got %v", tc.name, tc.expected, tc.got)
+		}
+	}
+}
+
+func TestSubdirs(t *testing.T) {
+	names := []string{
+		"example.com/lib@v1.0.0/go.mod",
+		"example.com/lib@v1.0.0/lib.go",
+		"example.com/lib@v1.0.0/a/a.go",
+		"example.com/lib@v1.0.0/a-b/ab.go",
+		"example.com/lib@v1.0.0/a/b/b.go",
+		"example.com/lib@v1.0.0/cmd/tool/main.go",
+		"example.com/lib@v1.0.0/onlytests/x_test.go",
+		"example.com/lib@v1.0.0/testdata/t.go",
+		"example.com/lib@v1.0.0/a/testdata/t.go",
+		"example.com/lib@v1.0.0/_tools/t.go",
+		"example.com/lib@v1.0.0/.hidden/h.go",
+		"example.com/lib@v1.0.0/vendor/v.go",
+		"example.com/lib@v1.0.0/plugins/x/go.mod",
+		"example.com/lib@v1.0.0/plugins/x/x.go",
+		"example.com/lib@v1.0.0/plugins/x/y/y.go",
+	}
+	files := []*file{}
+	for _, name := range names {
+		content := []byte("package p\n")
+		switch path.Base(name) {
+		case "go.mod":
+			content = []byte("module m\n")
+		case "main.go":
+			content = []byte("package main\n")
+		}
+		files = append(files, &file{Name: name, Content: content})
+	}
+	var b builder
+	d, err := b.getGoDoc(context.Background(), "example.com/lib", "v1.0.0", "", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, sd := range d.Subdirs {
+		s := fmt.Sprintf("%v %v %v %v", sd.Name, sd.Depth, sd.Module, sd.Link)
+		if sd.Command {
+			s += " command"
+		}
+		got = append(got, s)
+	}
+	expected := []string{
+		"a 0 false /example.com/lib/a/@v/v1.0.0",
+		"a/b 1 false /example.com/lib/a/b/@v/v1.0.0",
+		"a-b 0 false /example.com/lib/a-b/@v/v1.0.0",
+		"cmd 0 false ",
+		"cmd/tool 1 false /example.com/lib/cmd/tool/@v/v1.0.0 command",
+		"plugins 0 false ",
+		"plugins/x 1 true /example.com/lib/plugins/x",
+	}
+	if fmt.Sprint(got) != fmt.Sprint(expected) {
+		t.Fatalf("expected subdirs %q but got %q", expected, got)
+	}
+}
+
+var synopsisTestCases = []struct {
+	name     string
+	files    map[string]string
+	subDir   string
+	expected string
+	warning  bool
+}{
+	{
+		name: "exact directory",
+		files: map[string]string{
+			"x/foo/bar/bar.go": "// Package bar is nested.\npackage bar\n",
+			"foo/bar/bar.go":   "// Package bar is the one.\npackage bar\n",
+			"bar/bar.go":       "// Package bar is at the top.\npackage bar\n",
+		},
+		subDir:   "foo/bar",
+		expected: "Package bar is the one.",
+	},
+	{
+		name: "doc.go first",
+		files: map[string]string{
+			"foo/a.go":   "// Package foo is from a.go.\npackage foo\n",
+			"foo/doc.go": "// Package foo is from doc.go.\npackage foo\n",
+		},
+		subDir:   "foo",
+		expected: "Package foo is from doc.go.",
+	},
+	{
+		name: "any file",
+		files: map[string]string{
+			"foo/a.go":      "package foo\n",
+			"foo/b.go":      "// Package foo is from b.go.\npackage foo\n",
+			"foo/a_test.go": "// Package foo is from a test.\npackage foo\n",
+		},
+		subDir:   "foo",
+		expected: "Package foo is from b.go.",
+	},
+	{
+		name: "parse error",
+		files: map[string]string{
+			"foo/a.go": "packag foo\n",
+			"foo/b.go": "// Package foo still has a synopsis.\npackage foo\n",
+		},
+		subDir:   "foo",
+		expected: "Package foo still has a synopsis.",
+		warning:  true,
+	},
+}
+
+func TestSynopsis(t *testing.T) {
+	for _, tc := range synopsisTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			files := []*file{}
+			for name, content := range tc.files {
+				files = append(files, &file{Name: "example.com/lib@v1.0.0/" + name, Content: []byte(content)})
+			}
+			_, got, err := getSubdirDoc("", tc.subDir, files)
+			if got != tc.expected {
+				t.Fatalf("expected %q but got %q", tc.expected, got)
+			}
+			if (err != nil) != tc.warning {
+				t.Fatalf("expected warning to be %v but got %v", tc.warning, err)
+			}
+		})
+	}
+}
+
+func TestCommandName(t *testing.T) {
+	for importPath, expected := range map[string]string{
+		"example.com/tool":        "tool",
+		"example.com/tool/v2":     "tool",
+		"example.com/cmd/vet":     "vet",
+		"example.com/cmd/v":       "v",
+		"example.com/cmd/version": "version",
+	} {
+		if got := commandName(importPath); got != expected {
+			t.Fatalf("expected %v to build %v but got %v", importPath, expected, got)
+		}
+	}
+}
+
+var buildIgnoredTestCases = []struct {
+	src     string
+	ignored bool
+}{
+	{"//go:build ignore\n\npackage main\n", true},
+	{"// +build ignore\n\npackage main\n", true},
+	{"// Copyright 2020 The Authors.\n\n//go:build linux && ignore\n\npackage main\n", true},
+	{"//go:build !ignore\n\npackage lib\n", false},
+	{"//go:build linux\n\npackage lib\n", false},
+	{"package lib\n\n//go:build ignore\n", false},
+}
+
+func TestBuildIgnored(t *testing.T) {
+	for _, tc := range buildIgnoredTestCases {
+		f, err := parser.ParseFile(token.NewFileSet(), "x.go", tc.src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := buildIgnored(f); got != tc.ignored {
+			t.Fatalf("expected %q to be ignored: %v", tc.src, tc.ignored)
+		}
+	}
+}
+
+func TestIgnoredMainPackage(t *testing.T) {
+	files := []*file{
+		{Name: "example.com/lib@v1.0.0/gen.go", Content: []byte("//go:build ignore\n\npackage main\n\nfunc main() {}\n")},
+		{Name: "example.com/lib@v1.0.0/lib.go", Content: []byte("// Package lib is a library.\npackage lib\n\nfunc F() {}\n")},
+		{Name: "example.com/lib@v1.0.0/sub/a_gen.go", Content: []byte("// +build ignore\n\npackage main\n")},
+		{Name: "example.com/lib@v1.0.0/sub/sub.go", Content: []byte("package sub\n")},
+	}
+	var b builder
+	d, err := b.getGoDoc(context.Background(), "example.com/lib", "v1.0.0", "", files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.PackageName != "lib" || d.Command != "" {
+		t.Fatalf("expected package lib but got %q, command %q", d.PackageName, d.Command)
+	}
+	if len(d.Funcs) != 1 || len(d.NavLinks) == 0 || d.NavLinks[0] != "Index" || len(d.Files) != 1 {
+		t.Fatalf("expected the API of lib.go only but got funcs %v, nav links %v and files %v", len(d.Funcs), d.NavLinks, len(d.Files))
+	}
+	if len(d.Subdirs) != 1 || d.Subdirs[0].Command {
+		t.Fatalf("expected sub to be a package but got %+v", d.Subdirs)
+	}
+}
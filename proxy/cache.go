@@ -0,0 +1,120 @@
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"sync"
+
+	"marwan.io/moddoc/metrics"
+)
+
+var docCacheRequests = metrics.NewCounter(
+	"moddoc_doc_cache_requests_total",
+	"Lookups of the documentation cache, by result (hit or miss).",
+	"result",
+)
+
+// WithCache keeps the documentation of the size most recently
+// requested package versions in memory. A module version never
+// changes, so its documentation is only built once. The list of
+// versions of its module is not cached, as new ones get published.
+func WithCache(size int) Option {
+	return func(s *service) {
+		if size > 0 {
+			s.cache = &docCache{size: size, ll: list.New(), items: map[string]*list.Element{}}
+		}
+	}
+}
+
+// docCache is a least recently used cache of pages.
+type docCache struct {
+	mu    sync.Mutex
+	size  int
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key string
+	p   *page
+}
+
+// get returns the cached page of key, which must not be modified.
+func (c *docCache) get(key string) (*page, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		docCacheRequests.Inc("miss")
+		return nil, false
+	}
+	docCacheRequests.Inc("hit")
+	c.ll.MoveToFront(el)
+	return el.Value.(*cacheEntry).p, true
+}
+
+func (c *docCache) add(key string, p *page) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry{key, p})
+	if c.ll.Len() > c.size {
+		last := c.ll.Back()
+		c.ll.Remove(last)
+		delete(c.items, last.Value.(*cacheEntry).key)
+	}
+}
+
+// buildGroup runs a single build of a package version
+// for all the requests that need it at the same time.
+type buildGroup struct {
+	mu    sync.Mutex
+	calls map[string]*buildCall
+}
+
+type buildCall struct {
+	done chan struct{}
+	p    *page
+	err  error
+	// canceled reports whether the request that ran
+	// the build was canceled before it finished.
+	canceled bool
+}
+
+// do calls build, unless a build of key is already running, in which
+// case it waits for its result. A build that stopped because its
+// request was canceled is run again for the requests still waiting.
+func (g *buildGroup) do(ctx context.Context, key string, build func() (*page, error)) (*page, error) {
+	for {
+		g.mu.Lock()
+		if g.calls == nil {
+			g.calls = map[string]*buildCall{}
+		}
+		c, ok := g.calls[key]
+		if !ok {
+			c = &buildCall{done: make(chan struct{})}
+			g.calls[key] = c
+			g.mu.Unlock()
+			c.p, c.err = build()
+			c.canceled = ctx.Err() != nil
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(c.done)
+			return c.p, c.err
+		}
+		g.mu.Unlock()
+		select {
+		case <-c.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if c.err != nil && c.canceled && ctx.Err() == nil {
+			continue
+		}
+		return c.p, c.err
+	}
+}
@@ -0,0 +1,110 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+func TestDocCache(t *testing.T) {
+	s := &service{}
+	WithCache(2)(s)
+	c := s.cache
+	c.add("a@v1.0.0", &page{doc: &proxydoc.Documentation{PackageName: "a"}})
+	c.add("b@v1.0.0", &page{doc: &proxydoc.Documentation{PackageName: "b"}})
+	if _, ok := c.get("a@v1.0.0"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+	c.add("c@v1.0.0", &page{doc: &proxydoc.Documentation{PackageName: "c"}})
+	if _, ok := c.get("b@v1.0.0"); ok {
+		t.Fatal("expected b, the least recently used, to be evicted")
+	}
+	p, ok := c.get("a@v1.0.0")
+	if !ok || p.doc.PackageName != "a" {
+		t.Fatalf("expected a to still be cached but got %v, %v", p, ok)
+	}
+
+	s = &service{}
+	WithCache(0)(s)
+	if s.cache != nil {
+		t.Fatal("expected a size of 0 to disable the cache")
+	}
+}
+
+func TestBuildGroup(t *testing.T) {
+	var g buildGroup
+	var builds int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p, err := g.do(context.Background(), "a@v1.0.0", func() (*page, error) {
+				atomic.AddInt32(&builds, 1)
+				<-release
+				return &page{modRoot: "a"}, nil
+			})
+			if err != nil || p.modRoot != "a" {
+				t.Errorf("expected the page of a but got %v, %v", p, err)
+			}
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if builds != 1 {
+		t.Fatalf("expected concurrent requests to share 1 build but got %v", builds)
+	}
+
+	// a build that failed because its request was canceled
+	// is run again for the request still waiting for it.
+	ctx, cancel := context.WithCancel(context.Background())
+	started := make(chan struct{})
+	go g.do(ctx, "b@v1.0.0", func() (*page, error) {
+		close(started)
+		<-ctx.Done()
+		return nil, errors.New("could not open zip: context canceled")
+	})
+	<-started
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		cancel()
+	}()
+	p, err := g.do(context.Background(), "b@v1.0.0", func() (*page, error) {
+		return &page{modRoot: "b"}, nil
+	})
+	if err != nil || p.modRoot != "b" {
+		t.Fatalf("expected the waiting request to build b but got %v, %v", p, err)
+	}
+}
+
+func TestVersionTimesCache(t *testing.T) {
+	var infos int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&infos, 1)
+		fmt.Fprintf(w, `{"Version":%q,"Time":"2019-04-10T00:00:00Z"}`, strings.TrimSuffix(path.Base(r.URL.Path), ".info"))
+	}))
+	defer srv.Close()
+	s := &service{url: srv.URL}
+	vers := []string{"v1.1.0", "v1.0.0", "v1.1.0"}
+	for i := 0; i < 2; i++ {
+		times := s.getVersionTimes(context.Background(), "example.com/mod", vers)
+		if len(times) != 2 || times["v1.0.0"].IsZero() {
+			t.Fatalf("expected the times of 2 versions but got %v", times)
+		}
+	}
+	if infos != 2 {
+		t.Fatalf("expected each .info to be fetched once but got %d requests", infos)
+	}
+}
@@ -0,0 +1,56 @@
+package proxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/sumdb"
+)
+
+// verify computes the h1: hashes of the downloaded module zip, given
+// the SHA-256 of each of its files, and of the go.mod served by the
+// GOPROXY and checks them against s.sumdb.
+func (s *service) verify(ctx context.Context, mod, ver string, sums map[string][sha256.Size]byte) *proxydoc.Checksum {
+	var c proxydoc.Checksum
+	var err error
+	c.Zip, err = sumdb.Hash1Sums(sums)
+	if err != nil {
+		c.Message = err.Error()
+		return &c
+	}
+	modBts, err := s.getModBytes(ctx, mod, ver)
+	if err != nil {
+		c.Message = fmt.Sprintf("could not verify checksums: could not download the go.mod: %v", err)
+		return &c
+	}
+	c.GoMod = sumdb.HashGoMod(modBts)
+	modPath, err := module.DecodePath(mod)
+	if err != nil {
+		c.Message = err.Error()
+		return &c
+	}
+	verPath, err := module.DecodeVersion(ver)
+	if err != nil {
+		c.Message = err.Error()
+		return &c
+	}
+	err = sumdb.Verify(ctx, s.sumdb, modPath, verPath, &sumdb.Sums{Zip: c.Zip, GoMod: c.GoMod})
+	switch err.(type) {
+	case nil:
+		c.Verified = true
+		c.Message = "checksums match the checksum database"
+	case *sumdb.MismatchError:
+		c.Mismatch = true
+		c.Message = err.Error()
+	default:
+		if err == sumdb.ErrNotFound {
+			c.Message = "the checksum database does not know this module version"
+		} else {
+			c.Message = fmt.Sprintf("could not verify checksums: %v", err)
+		}
+	}
+	return &c
+}
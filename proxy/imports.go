@@ -0,0 +1,228 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/modfile"
+	"marwan.io/moddoc/gocopy/module"
+)
+
+// ModuleImports lists what a module version depends on:
+// the modules its go.mod requires and the packages each
+// of its own packages imports, excluding test files.
+type ModuleImports struct {
+	Module   string
+	Version  string
+	Requires []string
+	Packages map[string][]string
+	// Nested are the paths of the modules inside of this one.
+	Nested []string
+}
+
+// Contains reports whether the package pkg is part of the module.
+func (mi *ModuleImports) Contains(pkg string) bool {
+	return InModule(pkg, mi.Module, mi.Nested)
+}
+
+// GetImports downloads the module zip and collects the import
+// statements of every package in it. mod must be the module root.
+func (s *service) GetImports(ctx context.Context, mod, ver string) (*ModuleImports, error) {
+	mz, subpkg, err := s.openZip(ctx, mod, ver, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not open zip: %v", err)
+	}
+	if subpkg != "" {
+		return nil, fmt.Errorf("%v is not a module root", mod)
+	}
+	files, err := s.readFiles(ctx, mz, func(name string) bool {
+		return filepath.Base(name) == "go.mod" || isGoFile(name) && !strings.HasSuffix(name, "_test.go")
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	modPath, err := module.DecodePath(mod)
+	if err != nil {
+		return nil, err
+	}
+	return getModuleImports(modPath, ver, files)
+}
+
+func getModuleImports(modPath, ver string, files []*file) (*ModuleImports, error) {
+	mi := &ModuleImports{
+		Module:   modPath,
+		Version:  ver,
+		Packages: map[string][]string{},
+	}
+	fset := token.NewFileSet()
+	imports := map[string]map[string]struct{}{}
+	nested := nestedModules(files)
+	for dir := range nested {
+		mi.Nested = append(mi.Nested, path.Join(modPath, dir))
+	}
+	sort.Strings(mi.Nested)
+	for _, f := range files {
+		dir := getDir(f.Name)
+		if filepath.Base(f.Name) == "go.mod" && dir == "." {
+			modf, err := modfile.Parse("go.mod", f.Content, nil)
+			if err != nil {
+				return nil, err
+			}
+			for _, req := range modf.Require {
+				mi.Requires = append(mi.Requires, req.Mod.Path)
+			}
+			continue
+		}
+		if f.Content == nil || !isGoFile(f.Name) || strings.HasSuffix(f.Name, "_test.go") || ignoredDir(dir) || inNestedModule(filepath.ToSlash(dir), nested) {
+			continue
+		}
+		astFile, err := parser.ParseFile(fset, f.Name, f.Content, parser.ImportsOnly)
+		if err != nil {
+			// a broken file should not hide the rest of the module
+			continue
+		}
+		pkgPath := modPath
+		if dir != "." {
+			pkgPath = path.Join(modPath, filepath.ToSlash(dir))
+		}
+		if imports[pkgPath] == nil {
+			imports[pkgPath] = map[string]struct{}{}
+		}
+		for _, spec := range astFile.Imports {
+			imp, err := strconv.Unquote(spec.Path.Value)
+			if err != nil || imp == "C" {
+				continue
+			}
+			imports[pkgPath][imp] = struct{}{}
+		}
+	}
+	for pkgPath, set := range imports {
+		list := make([]string, 0, len(set))
+		for imp := range set {
+			list = append(list, imp)
+		}
+		sort.Strings(list)
+		mi.Packages[pkgPath] = list
+	}
+	return mi, nil
+}
+
+// ignoredDir reports whether the go tool would skip
+// the given module relative directory when matching packages.
+func ignoredDir(dir string) bool {
+	if dir == "." {
+		return false
+	}
+	for _, elem := range strings.Split(filepath.ToSlash(dir), "/") {
+		if elem == "testdata" || elem == "vendor" || strings.HasPrefix(elem, "_") || strings.HasPrefix(elem, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// getImports groups the import specs of a package into standard library,
+// same module and third party imports. The packages of the modules nested
+// inside of modRoot, given by their paths, are third party ones. Third
+// party imports are linked at the version required by modf, or to their
+// latest version if modf does not require them.
+func getImports(specs []*ast.ImportSpec, modRoot string, nested []string, ver string, modf *modFile, links proxydoc.Links) proxydoc.Imports {
+	var imps proxydoc.Imports
+	seen := map[string]bool{}
+	for _, spec := range specs {
+		imp, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || imp == "C" || seen[imp] {
+			continue
+		}
+		seen[imp] = true
+		switch {
+		case IsStdlib(imp):
+			imps.Stdlib = append(imps.Stdlib, &proxydoc.Import{
+				Path: imp,
+				Link: links.Latest(imp),
+			})
+		case InModule(imp, modRoot, nested):
+			imps.Module = append(imps.Module, &proxydoc.Import{
+				Path:    imp,
+				Version: ver,
+				Link:    links.Version(imp, ver),
+			})
+		default:
+			i := &proxydoc.Import{Path: imp, Link: links.Latest(imp)}
+			if modf != nil {
+				if linkPath, modVer := requiredVersion(modf.file, imp); modVer != "" {
+					i.Version = modVer
+					i.Link = links.Version(linkPath, modVer)
+				}
+			}
+			imps.ThirdParty = append(imps.ThirdParty, i)
+		}
+	}
+	for _, list := range [][]*proxydoc.Import{imps.Stdlib, imps.Module, imps.ThirdParty} {
+		sort.Slice(list, func(i, j int) bool { return list[i].Path < list[j].Path })
+	}
+	return imps
+}
+
+// requiredVersion finds the requirement of modf that provides the imported
+// package and returns the package path and version to document, honoring
+// replacements by other module versions.
+func requiredVersion(modf *modfile.File, imp string) (string, string) {
+	var req *modfile.Require
+	for _, r := range modf.Require {
+		if InModule(imp, r.Mod.Path, nil) && (req == nil || len(r.Mod.Path) > len(req.Mod.Path)) {
+			req = r
+		}
+	}
+	if req == nil {
+		return "", ""
+	}
+	for _, rep := range modf.Replace {
+		if rep.Old.Path != req.Mod.Path || rep.New.Version == "" {
+			continue
+		}
+		if rep.Old.Version == "" || rep.Old.Version == req.Mod.Version {
+			return rep.New.Path + imp[len(req.Mod.Path):], rep.New.Version
+		}
+	}
+	return imp, req.Mod.Version
+}
+
+// IsStdlib reports whether imp looks like a standard library
+// package: its first path element has no dot in it.
+func IsStdlib(imp string) bool {
+	elem := imp
+	if i := strings.Index(imp, "/"); i >= 0 {
+		elem = imp[:i]
+	}
+	return !strings.Contains(elem, ".")
+}
+
+// InModule reports whether the package pkg is part of the module mod,
+// given the paths of the modules nested inside of mod, which have a
+// go.mod of their own and provide the packages below them instead.
+func InModule(pkg, mod string, nested []string) bool {
+	if !hasPathPrefix(pkg, mod) {
+		return false
+	}
+	for _, n := range nested {
+		if hasPathPrefix(pkg, n) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPathPrefix reports whether p is prefix or a path below it.
+func hasPathPrefix(p, prefix string) bool {
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
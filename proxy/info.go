@@ -0,0 +1,166 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"marwan.io/moddoc/gocopy/semver"
+	"marwan.io/moddoc/logging"
+)
+
+// maxVersionTimes caps how many versions get their .info
+// fetched for the version drop down, newest first.
+const maxVersionTimes = 25
+
+// maxCachedTimes caps how many release times are kept in memory.
+const maxCachedTimes = 10000
+
+// Info is the metadata served by the .info endpoint of a GOPROXY.
+type Info struct {
+	Version string
+	Time    time.Time
+}
+
+// GetInfo fetches the .info of a module version. If mod is a package
+// inside of a module, the module providing it is looked up. ver may be
+// any query the GOPROXY resolves, such as a branch name or a commit hash,
+// in which case Info.Version is its canonical version.
+func (s *service) GetInfo(ctx context.Context, mod, ver string) (*Info, error) {
+	resp, _, err := s.fetchModule(ctx, mod, ver, ".info", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var info Info
+	err = json.NewDecoder(resp.Body).Decode(&info)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode info of %v@%v: %v", mod, ver, err)
+	}
+	return &info, nil
+}
+
+type versionList struct {
+	versions []string
+	times    map[string]time.Time
+}
+
+// getVersions lists the versions of mod, newest first, along with the
+// release time of the most recent ones and of the current version.
+func (s *service) getVersions(ctx context.Context, mod, current string) chan *versionList {
+	ch := make(chan *versionList, 1)
+	go func() {
+		defer close(ch)
+		vl := &versionList{times: map[string]time.Time{}}
+		defer func() { ch <- vl }()
+		resp, err := s.fetch(ctx, mod, "list", "")
+		if err != nil {
+			logging.FromContext(ctx).Warn("could not list versions", "error", err)
+			return
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != 200 {
+			return
+		}
+		scnr := bufio.NewScanner(resp.Body)
+		for scnr.Scan() {
+			if v := strings.TrimSpace(scnr.Text()); v != "" {
+				vl.versions = append(vl.versions, v)
+			}
+		}
+		if err := scnr.Err(); err != nil {
+			logging.FromContext(ctx).Warn("could not read versions", "error", err)
+		}
+		sort.Slice(vl.versions, func(i, j int) bool {
+			return semver.Compare(vl.versions[i], vl.versions[j]) > 0
+		})
+		timed := vl.versions
+		if len(timed) > maxVersionTimes {
+			timed = timed[:maxVersionTimes]
+		}
+		vl.times = s.getVersionTimes(ctx, mod, append([]string{current}, timed...))
+	}()
+	return ch
+}
+
+// timeCache keeps the release times of module versions. The .info
+// of a version never changes, so it only needs to be fetched once.
+type timeCache struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+}
+
+func (c *timeCache) get(key string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t, ok := c.times[key]
+	return t, ok
+}
+
+func (c *timeCache) add(key string, t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.times == nil {
+		c.times = map[string]time.Time{}
+	}
+	if len(c.times) >= maxCachedTimes {
+		// evict an arbitrary entry, it is fetched again if needed.
+		for k := range c.times {
+			delete(c.times, k)
+			break
+		}
+	}
+	c.times[key] = t
+}
+
+// getVersionTimes returns the release time of the given versions,
+// fetching the ones that are not cached concurrently. Versions
+// whose .info cannot be fetched are left out.
+func (s *service) getVersionTimes(ctx context.Context, mod string, vers []string) map[string]time.Time {
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		sem   = make(chan struct{}, 8)
+		times = map[string]time.Time{}
+		seen  = map[string]bool{}
+	)
+	for _, ver := range vers {
+		if seen[ver] {
+			continue
+		}
+		seen[ver] = true
+		if t, ok := s.times.get(mod + "@" + ver); ok {
+			times[ver] = t
+			continue
+		}
+		wg.Add(1)
+		go func(ver string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			resp, err := s.fetch(ctx, mod, ver, ".info")
+			if err != nil {
+				return
+			}
+			defer resp.Body.Close()
+			if resp.StatusCode != 200 {
+				return
+			}
+			var info Info
+			if json.NewDecoder(resp.Body).Decode(&info) != nil {
+				return
+			}
+			s.times.add(mod+"@"+ver, info.Time)
+			mu.Lock()
+			times[ver] = info.Time
+			mu.Unlock()
+		}(ver)
+	}
+	wg.Wait()
+	return times
+}
@@ -1,150 +1,298 @@
 package proxy
 
 import (
-	"archive/zip"
 	"context"
+	"crypto/sha256"
 	"fmt"
-	"io"
+	"go/doc"
 	"io/ioutil"
 	"net/http"
-	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	proxydoc "marwan.io/moddoc/doc"
 	"marwan.io/moddoc/fetch"
+	"marwan.io/moddoc/gocopy/modfile"
 	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/metrics"
+	"marwan.io/moddoc/sumdb"
+	"marwan.io/moddoc/vuln"
+)
+
+var (
+	docBuildDuration = metrics.NewHistogram(
+		"moddoc_doc_build_duration_seconds",
+		"Time to build the documentation of a package, by result.",
+		metrics.DefBuckets, "result",
+	)
+	docBuildsInFlight = metrics.NewGauge(
+		"moddoc_doc_builds_in_flight",
+		"Documentation builds in progress.",
+	)
 )
 
 // Service can return a valid godoc
 type Service interface {
 	GetDoc(ctx context.Context, mod, ver string) (*proxydoc.Documentation, error)
+	GetImports(ctx context.Context, mod, ver string) (*ModuleImports, error)
+	GetMod(ctx context.Context, mod, ver string) (*modfile.File, error)
+	GetInfo(ctx context.Context, mod, ver string) (*Info, error)
+	GetFile(ctx context.Context, mod, ver, name string) ([]byte, error)
+	GetLicenses(ctx context.Context, mod, ver string) ([]*proxydoc.License, error)
 }
 
 // NewService returns a valid service based on a GOPROXY
-func NewService(url string) Service {
-	return &service{url: strings.TrimSuffix(url, "/")}
+func NewService(url string, opts ...Option) Service {
+	s := &service{url: strings.TrimSuffix(url, "/"), limits: DefaultLimits}
+	for _, o := range opts {
+		o(s)
+	}
+	return s
+}
+
+// Option configures a Service
+type Option func(*service)
+
+// WithChecksumDB verifies every downloaded module version against db.
+// If refuse is true, no documentation is returned for a module version
+// whose checksums do not match, otherwise the mismatch is only reported
+// in Documentation.Checksum.
+func WithChecksumDB(db sumdb.DB, refuse bool) Option {
+	return func(s *service) {
+		s.sumdb = db
+		s.refuseMismatch = refuse
+	}
+}
+
+// WithLimits replaces DefaultLimits for the module zips the Service accepts.
+func WithLimits(l Limits) Option {
+	return func(s *service) {
+		s.limits = l
+	}
+}
+
+// WithUnexported documents the unexported
+// declarations of packages as well.
+func WithUnexported() Option {
+	return func(s *service) {
+		s.docMode |= doc.AllDecls
+	}
+}
+
+// WithSource keeps the bodies of functions and methods
+// in their SignatureString, to show their source.
+func WithSource() Option {
+	return func(s *service) {
+		s.docMode |= doc.PreserveAST
+	}
 }
 
 type service struct {
-	url string
+	url            string
+	sumdb          sumdb.DB
+	refuseMismatch bool
+	limits         Limits
+	vulns          *vuln.DB
+	docMode        doc.Mode
+	cache          *docCache
+	builds         buildGroup
+	times          timeCache
 }
 
-// GetProxyDir from GOPROXY
+// page is the documentation of a package version, which never
+// changes, along with the module that provides the package.
+type page struct {
+	doc     *proxydoc.Documentation
+	modRoot string
+}
+
+// GetDoc returns the documentation of the package mod at version ver.
+// Only the versions of the module are fetched on every call, the
+// rest of the documentation is built once per package version.
 func (s *service) GetDoc(ctx context.Context, mod, ver string) (*proxydoc.Documentation, error) {
-	dir, fileName, subpkg, err := s.makeZip(ctx, mod, ver)
+	p, err := s.getPage(ctx, mod, ver)
 	if err != nil {
-		return nil, fmt.Errorf("could not make zip: %v", err)
+		return nil, err
+	}
+	// a copy, so that callers can set the fields
+	// of their page without a data race.
+	d := *p.doc
+	vl := <-s.getVersions(ctx, p.modRoot, ver)
+	d.Versions = vl.versions
+	d.VersionTimes = vl.times
+	d.Published = vl.times[ver]
+	if s.vulns != nil {
+		markVulnerableVersions(&d, s.vulns)
 	}
-	modRoot := mod
-	if subpkg != "" {
-		rootIdx := len(mod) - len(subpkg)
-		modRoot = strings.TrimSuffix(mod[0:rootIdx], "/")
+	return &d, nil
+}
+
+// getPage returns the cached page of mod at version ver,
+// or builds it once for all the callers that request it.
+// Pages are linked with the Links of the context.
+func (s *service) getPage(ctx context.Context, mod, ver string) (*page, error) {
+	key := proxydoc.LinksFromContext(ctx).Version(mod, ver)
+	if s.cache != nil {
+		if p, ok := s.cache.get(key); ok {
+			return p, nil
+		}
 	}
-	versCh := s.getVersions(ctx, modRoot)
-	defer os.RemoveAll(dir)
+	return s.builds.do(ctx, key, func() (*page, error) {
+		p, err := s.buildPage(ctx, mod, ver)
+		if err == nil && s.cache != nil {
+			s.cache.add(key, p)
+		}
+		return p, err
+	})
+}
+
+func (s *service) buildPage(ctx context.Context, mod, ver string) (*page, error) {
+	logger := logging.FromContext(ctx).With("module", mod, "version", ver)
+	ctx = logging.NewContext(ctx, logger)
+	docBuildsInFlight.Inc()
+	defer docBuildsInFlight.Dec()
+	start := time.Now()
+	p, err := s.build(ctx, mod, ver)
+	docBuildDuration.Observe(time.Since(start).Seconds(), result(err))
 	if err != nil {
-		return nil, err
+		logger.Debug("could not build documentation", "duration", time.Since(start), "error", err)
+	} else {
+		logger.Debug("built documentation", "duration", time.Since(start))
 	}
+	return p, err
+}
 
-	f, err := os.Open(fileName)
+// result labels the metrics of an operation that returned err.
+func result(err error) string {
 	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+func (s *service) build(ctx context.Context, mod, ver string) (*page, error) {
+	mz, subpkg, err := s.openZip(ctx, mod, ver, s.sumdb != nil)
+	if _, ok := err.(*ZipError); ok {
 		return nil, err
 	}
-	defer f.Close()
-	fi, err := os.Stat(fileName)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not open zip: %v", err)
+	}
+	modRoot := mz.mod
+
+	var sums map[string][sha256.Size]byte
+	if s.sumdb != nil {
+		sums = map[string][sha256.Size]byte{}
 	}
-	zipReader, err := zip.NewReader(f, fi.Size())
+	_, span := logging.StartSpan(ctx, "unzip")
+	files, err := s.readFiles(ctx, mz, docFiles(mz, subpkg), sums)
+	span.End(err)
 	if err != nil {
 		return nil, err
 	}
-	files := []*file{}
-	// TODO: parse sub directories to get synopsis
-	for _, f := range zipReader.File {
-		var fl file
-		fl.Name = f.Name
-		rdr, err := f.Open()
-		if err != nil {
-			return nil, err
-		}
-		bts, err := ioutil.ReadAll(rdr)
-		if err != nil {
-			return nil, err
+
+	decodedRoot, _ := module.DecodePath(modRoot)
+	var checksum *proxydoc.Checksum
+	if s.sumdb != nil {
+		checksum = s.verify(ctx, modRoot, ver, sums)
+		if checksum.Mismatch && s.refuseMismatch {
+			return nil, fmt.Errorf("refusing to document %v@%v: %v", decodedRoot, ver, checksum.Message)
 		}
-		fl.Content = bts
-		files = append(files, &fl)
 	}
 
-	bldr := &builder{}
-	proxyDoc, err := bldr.getGoDoc(ctx, mod, ver, subpkg, files)
+	bldr := &builder{mode: s.docMode, log: logging.FromContext(ctx), links: proxydoc.LinksFromContext(ctx)}
+	if !hasGoMod(files) {
+		// the zip of a module without a go.mod does not contain
+		// one, but the GOPROXY still serves a synthesized one.
+		modf, err := s.GetMod(ctx, modRoot, ver)
+		if err == nil {
+			bldr.mods = append(bldr.mods, &modFile{path: decodedRoot, file: modf})
+		}
+	}
+	parseCtx, span := logging.StartSpan(ctx, "parse")
+	proxyDoc, err := bldr.getGoDoc(parseCtx, mod, ver, subpkg, files)
+	span.End(err)
 	if err != nil {
 		return nil, err
 	}
-	proxyDoc.ModuleRoot, _ = module.DecodePath(modRoot)
-	proxyDoc.Versions = <-versCh
-	return proxyDoc, err
+	proxyDoc.ModuleRoot = decodedRoot
+	proxyDoc.Checksum = checksum
+	if s.vulns != nil {
+		var modf *modfile.File
+		if m := bldr.getClosestModFile(proxyDoc.ImportPath); m != nil {
+			modf = m.file
+		}
+		annotateVulns(proxyDoc, s.vulns, modf)
+	}
+	return &page{doc: proxyDoc, modRoot: modRoot}, nil
+}
+
+func hasGoMod(files []*file) bool {
+	for _, f := range files {
+		if filepath.Base(f.Name) == "go.mod" && getDir(f.Name) == "." {
+			return true
+		}
+	}
+	return false
 }
 
+// file is an entry of a module zip. Content is
+// nil for files that the builder does not need.
 type file struct {
 	Name    string
 	Content []byte
 }
 
-func (s *service) getVersions(ctx context.Context, mod string) chan []string {
-	ch := make(chan []string, 1)
-	go func() {
-		defer close(ch)
-		resp, err := s.fetch(ctx, mod, "list", "")
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		defer resp.Body.Close()
-		if resp.StatusCode != 200 {
-			return
-		}
-		bts, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			fmt.Println(err)
-			return
-		}
-		ch <- strings.Split(string(bts), "\n")
-	}()
-	return ch
+// GetMod fetches and parses the go.mod file of the given module version
+// from the GOPROXY without downloading the module zip.
+func (s *service) GetMod(ctx context.Context, mod, ver string) (*modfile.File, error) {
+	bts, err := s.getModBytes(ctx, mod, ver)
+	if err != nil {
+		return nil, err
+	}
+	return modfile.Parse(mod+"@"+ver+"/go.mod", bts, nil)
 }
 
-func (s *service) makeZip(ctx context.Context, mod, ver string) (string, string, string, error) {
-	dir, err := ioutil.TempDir("", strings.Replace(mod, "/", "_", -1)+ver)
+func (s *service) getModBytes(ctx context.Context, mod, ver string) ([]byte, error) {
+	resp, err := s.fetch(ctx, mod, ver, ".mod")
 	if err != nil {
-		return dir, "", "", err
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("could not fetch go.mod of %v@%v: %v", mod, ver, resp.Status)
 	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// fetchModule fetches the given endpoint for mod, walking up its path until
+// it finds the module that provides it. It returns the path of mod relative
+// to that module.
+func (s *service) fetchModule(ctx context.Context, mod, ver, ext string, header http.Header) (*http.Response, string, error) {
 	path := mod
-	var resp *http.Response
 	var subdir string
 	for {
 		if path == "." {
-			return "", "", "", fmt.Errorf("invalid path: %v", mod)
+			return nil, "", fmt.Errorf("invalid path: %v", mod)
 		}
-		resp, err = s.fetch(ctx, path, ver, ".zip")
+		url := s.url + "/" + path + "/@v/" + ver + ext
+		if ver == "latest" && ext == ".info" {
+			// the latest version query has an endpoint of its own.
+			url = s.url + "/" + path + "/@latest"
+		}
+		resp, err := fetch.FetchWithHeader(ctx, url, header)
 		if err != nil {
-			return "", "", "", err
+			return nil, "", err
 		}
-		if resp.StatusCode == 200 {
-			break
+		if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusPartialContent {
+			return resp, subdir, nil
 		}
+		resp.Body.Close()
 		path = filepath.Dir(path)
 		subdir = mod[len(path)+1:]
 	}
-	file := filepath.Join(dir, "source.zip")
-	f, err := os.Create(file)
-	if err != nil {
-		return dir, file, "", err
-	}
-	defer f.Close()
-	_, err = io.Copy(f, resp.Body)
-	return dir, file, subdir, err
 }
 
 func (s *service) fetch(ctx context.Context, mod, ver, ext string) (*http.Response, error) {
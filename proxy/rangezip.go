@@ -0,0 +1,279 @@
+package proxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"marwan.io/moddoc/fetch"
+)
+
+// blockSize is the granularity at which parts of a
+// remote zip are requested and kept in memory.
+const blockSize = 64 << 10
+
+// dataDescriptorLen is the largest size of the record
+// that may follow the data of a zip entry.
+const dataDescriptorLen = 24
+
+// openZip opens the zip of mod without downloading all of it when the
+// GOPROXY supports range requests: only the central directory and the
+// entries that are actually read get fetched. Otherwise, or if whole is
+// true because every entry will be read, the zip is downloaded into
+// memory in one request. It returns the path of mod relative to the
+// module that provides it.
+func (s *service) openZip(ctx context.Context, mod, ver string, whole bool) (*moduleZip, string, error) {
+	header := http.Header{}
+	if !whole {
+		header.Set("Range", fmt.Sprintf("bytes=-%d", blockSize))
+	}
+	resp, subdir, err := s.fetchModule(ctx, mod, ver, ".zip", header)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	root := mod
+	if subdir != "" {
+		root = mod[:len(mod)-len(subdir)-1]
+	}
+	maxSize := s.limits.MaxZipSize
+	start, size, ok := parseContentRange(resp)
+	if !ok {
+		// no range support, the body is the whole zip.
+		if resp.ContentLength > maxSize {
+			return nil, "", zipErrorf(root, ver, "the zip is %d bytes, more than the limit of %d bytes", resp.ContentLength, maxSize)
+		}
+		bts, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+		if err != nil {
+			return nil, "", err
+		}
+		if int64(len(bts)) > maxSize {
+			return nil, "", zipErrorf(root, ver, "the zip is larger than the limit of %d bytes", maxSize)
+		}
+		zr, err := zip.NewReader(bytes.NewReader(bts), int64(len(bts)))
+		if err != nil {
+			return nil, "", zipErrorf(root, ver, "not a valid zip file: %v", err)
+		}
+		return &moduleZip{Reader: zr, mod: root, ver: ver}, subdir, nil
+	}
+	if size > maxSize {
+		return nil, "", zipErrorf(root, ver, "the zip is %d bytes, more than the limit of %d bytes", size, maxSize)
+	}
+	tail, err := ioutil.ReadAll(io.LimitReader(resp.Body, blockSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	rr := &rangeReader{
+		url:    s.url + "/" + root + "/@v/" + ver + ".zip",
+		size:   size,
+		blocks: map[int64][]byte{},
+	}
+	rr.fetched = int64(len(tail))
+	rr.store(start, tail)
+	err = rr.prefetchDirectory(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	var zr *zip.Reader
+	err = rr.retry(ctx, func() error {
+		zr, err = zip.NewReader(rr, size)
+		return err
+	})
+	if err != nil {
+		return nil, "", zipErrorf(root, ver, "not a valid zip file: %v", err)
+	}
+	return &moduleZip{Reader: zr, remote: rr, mod: root, ver: ver}, subdir, nil
+}
+
+// parseContentRange returns the start of a partial
+// response and the total size of the resource.
+func parseContentRange(resp *http.Response) (start, size int64, ok bool) {
+	if resp.StatusCode != http.StatusPartialContent {
+		return 0, 0, false
+	}
+	cr := strings.TrimPrefix(resp.Header.Get("Content-Range"), "bytes ")
+	slash := strings.Index(cr, "/")
+	dash := strings.Index(cr, "-")
+	if slash < 0 || dash < 0 || dash > slash {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(cr[:dash], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	size, err = strconv.ParseInt(cr[slash+1:], 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	return start, size, true
+}
+
+// rangeReader is an io.ReaderAt over the parts of a remote file that
+// were fetched with range requests and kept in memory. Reading a part
+// that is not in memory fails with a *missingRangeError: the caller
+// fetches it with prefetch, under its own context, and tries again.
+type rangeReader struct {
+	url  string
+	size int64
+
+	mu      sync.Mutex
+	blocks  map[int64][]byte
+	fetched int64
+}
+
+// missingRangeError is returned when reading a part
+// of a rangeReader that was not fetched yet.
+type missingRangeError struct {
+	off, length int64
+}
+
+func (e *missingRangeError) Error() string {
+	return fmt.Sprintf("bytes %d to %d were not fetched", e.off, e.off+e.length-1)
+}
+
+func (r *rangeReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	end := off + int64(len(p))
+	if end > r.size {
+		end = r.size
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for b := off / blockSize; b <= (end-1)/blockSize; b++ {
+		if _, ok := r.blocks[b]; !ok {
+			return 0, &missingRangeError{off: off, length: end - off}
+		}
+	}
+	n := 0
+	for pos := off; pos < end; {
+		block := r.blocks[pos/blockSize]
+		c := copy(p[n:end-off], block[pos%blockSize:])
+		n += c
+		pos += int64(c)
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// retry calls read until it stops failing for lack of
+// fetched data, fetching what it was missing in between.
+func (r *rangeReader) retry(ctx context.Context, read func() error) error {
+	for {
+		err := read()
+		missing, ok := err.(*missingRangeError)
+		if !ok {
+			return err
+		}
+		err = r.prefetch(ctx, missing.off, missing.length)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// prefetch makes sure the given span is in memory, fetching
+// all of its missing blocks in at most one request.
+func (r *rangeReader) prefetch(ctx context.Context, off, length int64) error {
+	end := off + length
+	if end > r.size {
+		end = r.size
+	}
+	if off >= end {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.load(ctx, off, end)
+}
+
+// prefetchDirectory loads the central directory in one request, using
+// the end of central directory record found in the tail of the zip.
+// Zips without such a record in memory are left to archive/zip.
+func (r *rangeReader) prefetchDirectory(ctx context.Context) error {
+	r.mu.Lock()
+	last := (r.size - 1) / blockSize
+	tail := r.blocks[last]
+	if prev, ok := r.blocks[last-1]; ok {
+		tail = append(append([]byte{}, prev...), tail...)
+	}
+	r.mu.Unlock()
+	i := bytes.LastIndex(tail, []byte("PK\x05\x06"))
+	if i < 0 || len(tail)-i < 22 {
+		return nil
+	}
+	dirSize := int64(binary.LittleEndian.Uint32(tail[i+12:]))
+	dirOffset := int64(binary.LittleEndian.Uint32(tail[i+16:]))
+	return r.prefetch(ctx, dirOffset, dirSize)
+}
+
+// load must be called with r.mu held.
+func (r *rangeReader) load(ctx context.Context, start, end int64) error {
+	lo, hi := int64(-1), int64(-1)
+	for b := start / blockSize; b <= (end-1)/blockSize; b++ {
+		if _, ok := r.blocks[b]; ok {
+			continue
+		}
+		if lo < 0 {
+			lo = b
+		}
+		hi = b
+	}
+	if lo < 0 {
+		return nil
+	}
+	from := lo * blockSize
+	to := (hi + 1) * blockSize
+	if to > r.size {
+		to = r.size
+	}
+	header := http.Header{}
+	header.Set("Range", fmt.Sprintf("bytes=%d-%d", from, to-1))
+	resp, err := fetch.FetchWithHeader(ctx, r.url, header)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if got, _, ok := parseContentRange(resp); !ok || got != from {
+		return fmt.Errorf("unexpected response to range request for %v: %v", r.url, resp.Status)
+	}
+	bts, err := ioutil.ReadAll(io.LimitReader(resp.Body, to-from))
+	if err != nil {
+		return err
+	}
+	if int64(len(bts)) != to-from {
+		return fmt.Errorf("short response to range request for %v", r.url)
+	}
+	r.fetched += int64(len(bts))
+	r.store(from, bts)
+	return nil
+}
+
+// store keeps the complete blocks found in data, which starts at off.
+func (r *rangeReader) store(off int64, data []byte) {
+	b := (off + blockSize - 1) / blockSize
+	for {
+		start := b*blockSize - off
+		end := start + blockSize
+		if end > int64(len(data)) {
+			// only the last block of the file may be short
+			if off+int64(len(data)) != r.size || start >= int64(len(data)) {
+				return
+			}
+			end = int64(len(data))
+		}
+		r.blocks[b] = data[start:end]
+		b++
+	}
+}
@@ -0,0 +1,113 @@
+package proxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bigZip returns a module zip where the package
+// is small but the module has large other files.
+func bigZip(t *testing.T) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	add := func(name string, content []byte) {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(content)
+	}
+	noise := make([]byte, 1<<20)
+	rand.New(rand.NewSource(1)).Read(noise)
+	add("example.com/mod@v1.0.0/go.mod", []byte("module example.com/mod\n"))
+	add("example.com/mod@v1.0.0/assets/image.png", noise)
+	add("example.com/mod@v1.0.0/mod.go", []byte("// Package mod is small.\npackage mod\n"))
+	add("example.com/mod@v1.0.0/sub/testdata/big.json", noise)
+	add("example.com/mod@v1.0.0/sub/sub.go", []byte("// Package sub is smaller.\npackage sub\n"))
+	add("example.com/mod@v1.0.0/sub/other.go", []byte("package sub\n"))
+	zw.Close()
+	return buf.Bytes()
+}
+
+func zipServer(content []byte, ranges bool, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.URL.Path != "/example.com/mod/@v/v1.0.0.zip" {
+			http.NotFound(w, r)
+			return
+		}
+		if !ranges {
+			r.Header.Del("Range")
+		}
+		http.ServeContent(w, r, "mod.zip", time.Time{}, bytes.NewReader(content))
+	}))
+}
+
+func TestOpenZipWithRanges(t *testing.T) {
+	content := bigZip(t)
+	for _, ranges := range []bool{true, false} {
+		var requests int
+		srv := zipServer(content, ranges, &requests)
+		s := &service{url: srv.URL, limits: DefaultLimits}
+		mz, subpkg, err := s.openZip(context.Background(), "example.com/mod/sub", "v1.0.0", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if subpkg != "sub" || mz.mod != "example.com/mod" {
+			t.Fatalf("expected example.com/mod and sub but got %v and %v", mz.mod, subpkg)
+		}
+		if ranges != (mz.remote != nil) {
+			t.Fatalf("expected range requests to be used: %v", ranges)
+		}
+		files, err := s.readFiles(context.Background(), mz, docFiles(mz, ""), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		read := []string{}
+		for _, f := range files {
+			if f.Content != nil {
+				read = append(read, f.Name[len("example.com/mod@v1.0.0/"):])
+			}
+		}
+		expected := "go.mod mod.go sub/sub.go sub/other.go"
+		if strings.Join(read, " ") != expected {
+			t.Fatalf("expected to read %v but read %v", expected, read)
+		}
+		if ranges && mz.remote.fetched > int64(len(content))/4 {
+			t.Fatalf("expected to fetch a fraction of %d bytes but fetched %d", len(content), mz.remote.fetched)
+		}
+		srv.Close()
+	}
+}
+
+func TestOpenWholeZip(t *testing.T) {
+	content := bigZip(t)
+	var requests int
+	srv := zipServer(content, true, &requests)
+	defer srv.Close()
+	s := &service{url: srv.URL, limits: DefaultLimits}
+	ctx := context.Background()
+	mz, _, err := s.openZip(ctx, "example.com/mod", "v1.0.0", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mz.remote != nil {
+		t.Fatal("expected the whole zip to be downloaded")
+	}
+	sums := map[string][sha256.Size]byte{}
+	_, err = s.readFiles(ctx, mz, docFiles(mz, ""), sums)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sums) != 6 || requests != 1 {
+		t.Fatalf("expected 6 sums from 1 request but got %d from %d", len(sums), requests)
+	}
+}
@@ -0,0 +1,173 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"html/template"
+	"path"
+	"sort"
+	"strings"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/license"
+	"marwan.io/moddoc/markdown"
+)
+
+// ErrFileNotFound is returned by GetFile for
+// files that are not part of the module zip.
+var ErrFileNotFound = errors.New("file not found in module zip")
+
+// GetFile returns the content of a file of the module zip,
+// given its path relative to mod, so that documentation can
+// link to files such as the images of a README.
+func (s *service) GetFile(ctx context.Context, mod, ver, name string) ([]byte, error) {
+	mz, subpkg, err := s.openZip(ctx, mod, ver, false)
+	if err != nil {
+		return nil, err
+	}
+	name = path.Join(subpkg, name)
+	files, err := s.readFiles(ctx, mz, func(zipName string) bool {
+		return zipPath(zipName) == name
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.Content != nil {
+			return f.Content, nil
+		}
+	}
+	return nil, ErrFileNotFound
+}
+
+// GetLicenses returns the license files at the root of a module
+// version, reading nothing else of the module zip.
+func (s *service) GetLicenses(ctx context.Context, mod, ver string) ([]*proxydoc.License, error) {
+	mz, subpkg, err := s.openZip(ctx, mod, ver, false)
+	if err != nil {
+		return nil, err
+	}
+	if subpkg != "" {
+		return nil, fmt.Errorf("%v is not a module root", mod)
+	}
+	files, err := s.readFiles(ctx, mz, func(name string) bool {
+		name = zipPath(name)
+		return path.Dir(name) == "." && license.IsLicenseFile(name)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return getLicenses(files, ""), nil
+}
+
+// zipPath returns the path of a zip entry relative to the module root.
+func zipPath(name string) string {
+	i := strings.Index(name, "@")
+	if i < 0 {
+		return name
+	}
+	j := strings.Index(name[i:], "/")
+	if j < 0 {
+		return ""
+	}
+	return name[i+j+1:]
+}
+
+// readmeRank orders the README files of a directory by preference,
+// Markdown first. It returns -1 for other files.
+func readmeRank(base string) int {
+	ext := path.Ext(base)
+	if strings.ToUpper(strings.TrimSuffix(base, ext)) != "README" {
+		return -1
+	}
+	switch strings.ToLower(ext) {
+	case ".md", ".markdown":
+		return 0
+	case "":
+		return 1
+	case ".txt":
+		return 2
+	}
+	return 3
+}
+
+// getReadme renders the README at the root of the module modPath.
+// Relative links and images are resolved inside the module zip: links
+// to packages go to their documentation and other files are served raw.
+func getReadme(files []*file, modPath, ver string, links proxydoc.Links) template.HTML {
+	var readme *file
+	names := map[string]bool{}
+	pkgDirs := map[string]bool{}
+	nested := nestedModules(files)
+	for _, f := range files {
+		name := zipPath(f.Name)
+		names[name] = true
+		dir := path.Dir(name)
+		if isGoFile(name) && !strings.HasSuffix(name, "_test.go") && !ignoredDir(dir) && !inNestedModule(dir, nested) {
+			pkgDirs[dir] = true
+		}
+		rank := readmeRank(name)
+		if rank < 0 || dir != "." || f.Content == nil {
+			continue
+		}
+		if readme == nil || rank < readmeRank(zipPath(readme.Name)) {
+			readme = f
+		}
+	}
+	if readme == nil {
+		return ""
+	}
+	if readmeRank(zipPath(readme.Name)) > 0 {
+		return template.HTML("<pre>" + html.EscapeString(string(readme.Content)) + "</pre>")
+	}
+	return markdown.ToHTML(readme.Content, markdown.Options{
+		IDPrefix: "readme-",
+		ResolveURL: func(dest string, image bool) string {
+			fragment := ""
+			if i := strings.IndexAny(dest, "?#"); i >= 0 {
+				dest, fragment = dest[:i], dest[i:]
+			}
+			target := path.Clean("./" + strings.TrimPrefix(dest, "/"))
+			if target == ".." || strings.HasPrefix(target, "../") {
+				return ""
+			}
+			switch {
+			case !image && pkgDirs[target]:
+				return links.Version(path.Join(modPath, target), ver) + fragment
+			case names[target]:
+				return links.Raw(modPath, ver, target)
+			}
+			return ""
+		},
+	})
+}
+
+// getLicenses returns the license files that apply to the package at
+// subpkg: the ones in its directory and in every parent directory up
+// to the module root.
+func getLicenses(files []*file, subpkg string) []*proxydoc.License {
+	pkgDir := path.Clean("./" + subpkg)
+	var licenses []*proxydoc.License
+	for _, f := range files {
+		name := zipPath(f.Name)
+		if f.Content == nil || !license.IsLicenseFile(path.Base(name)) || !inDir(pkgDir, path.Dir(name)) {
+			continue
+		}
+		licenses = append(licenses, &proxydoc.License{
+			Path:  name,
+			Types: license.Detect(f.Content),
+			Text:  string(f.Content),
+		})
+	}
+	sort.Slice(licenses, func(i, j int) bool {
+		return lessPath(licenses[i].Path, licenses[j].Path)
+	})
+	return licenses
+}
+
+// inDir reports whether parent is dir or one of its parent directories.
+func inDir(dir, parent string) bool {
+	return parent == "." || dir == parent || strings.HasPrefix(dir, parent+"/")
+}
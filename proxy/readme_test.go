@@ -0,0 +1,50 @@
+package proxy
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+func TestReadme(t *testing.T) {
+	files := []*file{
+		{Name: "example.com/lib@v1.0.0/README.md", Content: []byte("[sub](./sub) [guide](docs/guide.md#intro) [up](../x) ![logo](/img/logo.png) [gone](missing.md)")},
+		{Name: "example.com/lib@v1.0.0/README.txt", Content: []byte("plain")},
+		{Name: "example.com/lib@v1.0.0/sub/README.md", Content: []byte("# Not the root")},
+		{Name: "example.com/lib@v1.0.0/sub/sub.go"},
+		{Name: "example.com/lib@v1.0.0/docs/guide.md"},
+		{Name: "example.com/lib@v1.0.0/img/logo.png"},
+	}
+	got := string(getReadme(files, "example.com/lib", "v1.0.0", proxydoc.Links{Base: "/godoc"}))
+	expected := `<p><a href="/godoc/example.com/lib/sub/@v/v1.0.0">sub</a> ` +
+		`<a href="/godoc/example.com/lib/@v/v1.0.0/raw/docs/guide.md">guide</a> up ` +
+		`<img src="/godoc/example.com/lib/@v/v1.0.0/raw/img/logo.png" alt="logo"> gone</p>`
+	if strings.TrimSpace(got) != expected {
+		t.Fatalf("expected %v but got %v", expected, got)
+	}
+}
+
+func TestLicenses(t *testing.T) {
+	files := []*file{
+		{Name: "example.com/lib@v1.0.0/LICENSE", Content: []byte("no idea")},
+		{Name: "example.com/lib@v1.0.0/a/LICENSE.md", Content: []byte("")},
+		{Name: "example.com/lib@v1.0.0/a/b/COPYING", Content: []byte("")},
+		{Name: "example.com/lib@v1.0.0/ab/LICENSE", Content: []byte("")},
+		{Name: "example.com/lib@v1.0.0/a/c/LICENSE", Content: []byte("")},
+	}
+	for subpkg, expected := range map[string][]string{
+		"":    {"LICENSE"},
+		"a/b": {"LICENSE", "a/LICENSE.md", "a/b/COPYING"},
+		"ab":  {"LICENSE", "ab/LICENSE"},
+	} {
+		got := []string{}
+		for _, l := range getLicenses(files, subpkg) {
+			got = append(got, l.Path)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(expected) {
+			t.Fatalf("expected licenses of %q to be %v but got %v", subpkg, expected, got)
+		}
+	}
+}
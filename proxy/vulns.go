@@ -0,0 +1,104 @@
+package proxy
+
+import (
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/modfile"
+	"marwan.io/moddoc/vuln"
+)
+
+// WithVulnDB annotates the documentation with the
+// known vulnerabilities found in db.
+func WithVulnDB(db *vuln.DB) Option {
+	return func(s *service) {
+		s.vulns = db
+	}
+}
+
+// annotateVulns marks the documented module version, the affected
+// symbols of the package and the vulnerable requirements of modf.
+func annotateVulns(d *proxydoc.Documentation, db *vuln.DB, modf *modfile.File) {
+	mod, ver := d.ModuleRoot, d.ModuleVersion
+	symbols := map[string][]string{}
+	for _, e := range db.Vulns(mod, ver) {
+		v := newVuln(e, mod, ver)
+		v.Symbols, v.Package = e.Symbols(mod, d.ImportPath)
+		for _, sym := range v.Symbols {
+			symbols[sym] = append(symbols[sym], e.ID)
+		}
+		d.Vulns = append(d.Vulns, v)
+	}
+	markSymbols(d, symbols)
+
+	if modf == nil {
+		return
+	}
+	replaced := map[string]modfile.Replace{}
+	for _, r := range modf.Replace {
+		replaced[r.Old.Path+"@"+r.Old.Version] = *r
+	}
+	for _, r := range modf.Require {
+		path, ver := r.Mod.Path, r.Mod.Version
+		rep, ok := replaced[path+"@"+ver]
+		if !ok {
+			rep, ok = replaced[path+"@"]
+		}
+		if ok {
+			if rep.New.Version == "" {
+				// replaced by a local directory
+				continue
+			}
+			path, ver = rep.New.Path, rep.New.Version
+		}
+		dv := &proxydoc.DependencyVulns{Path: path, Version: ver}
+		for _, e := range db.Vulns(path, ver) {
+			dv.Vulns = append(dv.Vulns, newVuln(e, path, ver))
+		}
+		if len(dv.Vulns) > 0 {
+			d.DependencyVulns = append(d.DependencyVulns, dv)
+		}
+	}
+}
+
+// markVulnerableVersions marks the versions of the module
+// that are affected by at least one known vulnerability.
+func markVulnerableVersions(d *proxydoc.Documentation, db *vuln.DB) {
+	d.VulnerableVersions = nil
+	for _, v := range d.Versions {
+		if len(db.Vulns(d.ModuleRoot, v)) > 0 {
+			if d.VulnerableVersions == nil {
+				d.VulnerableVersions = map[string]bool{}
+			}
+			d.VulnerableVersions[v] = true
+		}
+	}
+}
+
+func newVuln(e *vuln.Entry, mod, ver string) *proxydoc.Vuln {
+	return &proxydoc.Vuln{
+		ID:      e.ID,
+		Aliases: e.Aliases,
+		Summary: e.Summary,
+		URL:     e.URL(),
+		Fixed:   e.Fixed(mod, ver),
+	}
+}
+
+// markSymbols sets the Vulns of the functions, types and
+// methods named in symbols, where methods are Type.Method.
+func markSymbols(d *proxydoc.Documentation, symbols map[string][]string) {
+	if len(symbols) == 0 {
+		return
+	}
+	for _, f := range d.Funcs {
+		f.Vulns = symbols[f.ID]
+	}
+	for _, t := range d.Types {
+		t.Vulns = symbols[t.Name]
+		for _, f := range t.Funcs {
+			f.Vulns = symbols[f.ID]
+		}
+		for _, f := range t.Methods {
+			f.Vulns = symbols[f.ID]
+		}
+	}
+}
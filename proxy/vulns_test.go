@@ -0,0 +1,55 @@
+package proxy
+
+import (
+	"reflect"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/modfile"
+	"marwan.io/moddoc/vuln"
+)
+
+func TestAnnotateVulns(t *testing.T) {
+	e := &vuln.Entry{ID: "GO-2021-0001", Summary: "Panic on crafted input"}
+	a := &vuln.Affected{Ranges: []*vuln.Range{{
+		Type:   "SEMVER",
+		Events: []*vuln.Event{{Introduced: "0"}, {Fixed: "1.1.0"}},
+	}}}
+	a.Package.Name = "example.com/lib"
+	a.EcosystemSpecific.Imports = []*vuln.Import{{Path: "example.com/lib", Symbols: []string{"Parse", "T.M"}}}
+	e.Affected = []*vuln.Affected{a}
+	db := vuln.New(e)
+
+	d := &proxydoc.Documentation{
+		ModuleRoot:    "example.com/lib",
+		ModuleVersion: "v1.0.0",
+		ImportPath:    "example.com/lib",
+		Versions:      []string{"v1.1.0", "v1.0.0"},
+		Funcs:         []*proxydoc.Func{{ID: "Parse"}, {ID: "Format"}},
+		Types: []*proxydoc.Type{{
+			Name:    "T",
+			Methods: []*proxydoc.Func{{ID: "T.M"}},
+		}},
+	}
+	modf, err := modfile.Parse("go.mod", []byte("module example.com/app\n\nrequire example.com/lib v1.0.0\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	annotateVulns(d, db, modf)
+	markVulnerableVersions(d, db)
+
+	if !reflect.DeepEqual(d.VulnerableVersions, map[string]bool{"v1.0.0": true}) {
+		t.Fatalf("expected only v1.0.0 to be vulnerable but got %v", d.VulnerableVersions)
+	}
+	if len(d.Vulns) != 1 || d.Vulns[0].Fixed != "v1.1.0" || !d.Vulns[0].Package {
+		t.Fatalf("expected one vulnerability of the package fixed in v1.1.0 but got %+v", d.Vulns)
+	}
+	got := [][]string{d.Funcs[0].Vulns, d.Funcs[1].Vulns, d.Types[0].Vulns, d.Types[0].Methods[0].Vulns}
+	expected := [][]string{{"GO-2021-0001"}, nil, nil, {"GO-2021-0001"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected symbols %v but got %v", expected, got)
+	}
+	if len(d.DependencyVulns) != 1 || d.DependencyVulns[0].Path != "example.com/lib" {
+		t.Fatalf("expected example.com/lib to be a vulnerable dependency but got %+v", d.DependencyVulns)
+	}
+}
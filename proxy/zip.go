@@ -0,0 +1,223 @@
+package proxy
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/license"
+)
+
+// Limits bound the module zips a Service accepts, so that
+// a huge or malicious module cannot exhaust the server.
+type Limits struct {
+	// MaxZipSize is the size of the zip as downloaded.
+	MaxZipSize int64
+	// MaxTotalSize is the uncompressed size of all files.
+	MaxTotalSize int64
+	// MaxFileSize is the uncompressed size of any one file.
+	MaxFileSize int64
+	// MaxFiles is the number of entries in the zip.
+	MaxFiles int
+}
+
+// DefaultLimits follow the limits the go command enforces on module
+// zips: 500 MiB in total, with go.mod and LICENSE files of at most
+// 16 MiB. Other files share the go.mod limit here since every file
+// is read into memory.
+var DefaultLimits = Limits{
+	MaxZipSize:   500 << 20,
+	MaxTotalSize: 500 << 20,
+	MaxFileSize:  16 << 20,
+	MaxFiles:     50000,
+}
+
+// ZipError is returned when a module zip is rejected
+// for breaking the module zip rules or the Service's Limits.
+type ZipError struct {
+	Module  string
+	Version string
+	Reason  string
+}
+
+func (e *ZipError) Error() string {
+	return fmt.Sprintf("module %v@%v was rejected: %v", e.Module, e.Version, e.Reason)
+}
+
+func zipErrorf(mod, ver, format string, args ...interface{}) *ZipError {
+	mod, _ = module.DecodePath(mod)
+	ver, _ = module.DecodeVersion(ver)
+	return &ZipError{Module: mod, Version: ver, Reason: fmt.Sprintf(format, args...)}
+}
+
+// moduleZip is the zip of a module version, opened
+// by openZip without necessarily being downloaded.
+type moduleZip struct {
+	*zip.Reader
+	// remote is nil if the zip was downloaded into memory
+	remote *rangeReader
+	// mod and ver are the encoded module root and version
+	mod, ver string
+}
+
+// readFiles checks the zip entries against the module zip rules and
+// s.limits and returns them, with the content of only the ones keep
+// selects. Other files have a nil Content. If sums is not nil, every
+// entry is streamed through SHA-256 and its sum recorded, so the zip
+// should then be opened whole. ctx bounds the range requests made to
+// read the entries of a zip that was not downloaded.
+func (s *service) readFiles(ctx context.Context, mz *moduleZip, keep func(name string) bool, sums map[string][sha256.Size]byte) ([]*file, error) {
+	err := s.checkZip(mz.File, mz.mod, mz.ver)
+	if err != nil {
+		return nil, err
+	}
+	files := []*file{}
+	for _, zf := range mz.File {
+		if strings.HasSuffix(zf.Name, "/") {
+			continue
+		}
+		fl := &file{Name: zf.Name}
+		files = append(files, fl)
+		read := keep(zf.Name)
+		if !read && sums == nil {
+			continue
+		}
+		if mz.remote != nil {
+			err := mz.remote.retry(ctx, func() error {
+				off, err := zf.DataOffset()
+				if err != nil {
+					return err
+				}
+				return mz.remote.prefetch(ctx, off, int64(zf.CompressedSize64)+dataDescriptorLen)
+			})
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch %v: %v", zf.Name, err)
+			}
+		}
+		rdr, err := zf.Open()
+		if err != nil {
+			return nil, zipErrorf(mz.mod, mz.ver, "could not open %v: %v", zf.Name, err)
+		}
+		// the declared size was checked but the content may lie about it
+		lr := io.LimitReader(rdr, int64(zf.UncompressedSize64)+1)
+		h := sha256.New()
+		var n int64
+		if read {
+			fl.Content, err = ioutil.ReadAll(io.TeeReader(lr, h))
+			n = int64(len(fl.Content))
+			if fl.Content == nil {
+				fl.Content = []byte{}
+			}
+		} else {
+			n, err = io.Copy(h, lr)
+		}
+		rdr.Close()
+		if err != nil {
+			return nil, zipErrorf(mz.mod, mz.ver, "could not read %v: %v", zf.Name, err)
+		}
+		if uint64(n) > zf.UncompressedSize64 {
+			return nil, zipErrorf(mz.mod, mz.ver, "%v is larger than its declared size", zf.Name)
+		}
+		if sums != nil {
+			var sum [sha256.Size]byte
+			copy(sum[:], h.Sum(nil))
+			sums[zf.Name] = sum
+		}
+	}
+	return files, nil
+}
+
+// checkZip validates the zip entries without reading them: every path must
+// be a valid file path inside the mod@ver/ prefix, unique even when compared
+// case insensitively, and the declared sizes must be within s.limits.
+func (s *service) checkZip(zfs []*zip.File, mod, ver string) error {
+	modPath, err := module.DecodePath(mod)
+	if err != nil {
+		return err
+	}
+	modVer, err := module.DecodeVersion(ver)
+	if err != nil {
+		return err
+	}
+	prefix := modPath + "@" + modVer + "/"
+	if len(zfs) > s.limits.MaxFiles {
+		return zipErrorf(mod, ver, "the zip has %d files, more than the limit of %d", len(zfs), s.limits.MaxFiles)
+	}
+	var total uint64
+	seen := map[string]string{}
+	for _, zf := range zfs {
+		if strings.HasSuffix(zf.Name, "/") {
+			continue
+		}
+		if !strings.HasPrefix(zf.Name, prefix) {
+			return zipErrorf(mod, ver, "%q is outside of the %v directory", zf.Name, prefix)
+		}
+		name := zf.Name[len(prefix):]
+		if err := module.CheckFilePath(name); err != nil {
+			return zipErrorf(mod, ver, "%v", err)
+		}
+		folded := strings.ToLower(name)
+		if other, ok := seen[folded]; ok {
+			if other == name {
+				return zipErrorf(mod, ver, "%q appears more than once", name)
+			}
+			return zipErrorf(mod, ver, "%q and %q only differ by case", other, name)
+		}
+		seen[folded] = name
+		if zf.UncompressedSize64 > uint64(s.limits.MaxFileSize) {
+			return zipErrorf(mod, ver, "%q is %d bytes, more than the limit of %d bytes per file", name, zf.UncompressedSize64, s.limits.MaxFileSize)
+		}
+		total += zf.UncompressedSize64
+		if total > uint64(s.limits.MaxTotalSize) {
+			return zipErrorf(mod, ver, "the files add up to more than the limit of %d bytes", s.limits.MaxTotalSize)
+		}
+	}
+	return nil
+}
+
+// docFiles selects the files of the zip that are needed to document the
+// package at subpkg: every Go file of the package, the go.mod files, the
+// README of the module root when documenting it, the LICENSE files of
+// the package directory and its parents, and the non-test Go files of
+// every sub directory that the go tool would see as a package of this
+// module, whose package comments make up their synopses.
+func docFiles(mz *moduleZip, subpkg string) func(name string) bool {
+	nested := map[string]bool{}
+	for _, zf := range mz.File {
+		if dir := getDir(zf.Name); path.Base(zf.Name) == "go.mod" && dir != "." {
+			nested[filepath.ToSlash(dir)] = true
+		}
+	}
+	pkgDir := path.Clean("./" + subpkg)
+	return func(name string) bool {
+		base := path.Base(name)
+		modDir := path.Dir(zipPath(name))
+		switch {
+		case base == "go.mod":
+			return true
+		case readmeRank(base) >= 0:
+			return subpkg == "" && modDir == "."
+		case license.IsLicenseFile(base):
+			return inDir(pkgDir, modDir)
+		}
+		dir, valid := getRelativeDir(name, subpkg)
+		if !valid || !isGoFile(name) {
+			return false
+		}
+		if dir == "." {
+			return true
+		}
+		return !strings.HasSuffix(name, "_test.go") && !ignoredDir(dir) && !inNestedModule(filepath.ToSlash(getDir(name)), nested)
+	}
+}
+
+func isGoFile(name string) bool {
+	return path.Ext(name) == ".go"
+}
@@ -0,0 +1,96 @@
+package proxy
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var checkZipTestCases = []struct {
+	name   string
+	files  map[string]string
+	limits Limits
+	reason string // empty if the zip is valid
+}{
+	{
+		name:  "valid",
+		files: map[string]string{"example.com/mod@v1.0.0/go.mod": "module example.com/mod\n", "example.com/mod@v1.0.0/a/a.go": "package a\n"},
+	},
+	{
+		name:   "outside prefix",
+		files:  map[string]string{"example.com/other@v1.0.0/a.go": "package a\n"},
+		reason: "outside of the example.com/mod@v1.0.0/ directory",
+	},
+	{
+		name:   "invalid path",
+		files:  map[string]string{"example.com/mod@v1.0.0/../a.go": "package a\n"},
+		reason: "malformed file path",
+	},
+	{
+		name:   "case collision",
+		files:  map[string]string{"example.com/mod@v1.0.0/README": "", "example.com/mod@v1.0.0/readme": ""},
+		reason: "only differ by case",
+	},
+	{
+		name:   "too many files",
+		files:  map[string]string{"example.com/mod@v1.0.0/a.go": "", "example.com/mod@v1.0.0/b.go": ""},
+		limits: Limits{MaxFiles: 1},
+		reason: "more than the limit of 1",
+	},
+	{
+		name:   "file too large",
+		files:  map[string]string{"example.com/mod@v1.0.0/a.go": "package a\n"},
+		limits: Limits{MaxFileSize: 5},
+		reason: "more than the limit of 5 bytes per file",
+	},
+	{
+		name:   "total too large",
+		files:  map[string]string{"example.com/mod@v1.0.0/a.go": "package a\n", "example.com/mod@v1.0.0/b.go": "package b\n"},
+		limits: Limits{MaxTotalSize: 15},
+		reason: "add up to more than the limit of 15 bytes",
+	},
+}
+
+func TestCheckZip(t *testing.T) {
+	for _, tc := range checkZipTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			zw := zip.NewWriter(&buf)
+			for name, content := range tc.files {
+				w, err := zw.Create(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				w.Write([]byte(content))
+			}
+			zw.Close()
+			zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			limits := DefaultLimits
+			if tc.limits.MaxFiles > 0 {
+				limits.MaxFiles = tc.limits.MaxFiles
+			}
+			if tc.limits.MaxFileSize > 0 {
+				limits.MaxFileSize = tc.limits.MaxFileSize
+			}
+			if tc.limits.MaxTotalSize > 0 {
+				limits.MaxTotalSize = tc.limits.MaxTotalSize
+			}
+			s := &service{limits: limits}
+			err = s.checkZip(zr.File, "example.com/mod", "v1.0.0")
+			if tc.reason == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			ze, ok := err.(*ZipError)
+			if !ok || !strings.Contains(ze.Reason, tc.reason) {
+				t.Fatalf("expected a rejection because %q but got %v", tc.reason, err)
+			}
+		})
+	}
+}
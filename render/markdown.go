@@ -0,0 +1,264 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+// Markdown writes the documentation of a package as GitHub flavored
+// Markdown, to publish API references in wikis and READMEs. Every
+// function and type has a heading with an anchor named like the ids
+// of the HTML pages, which the index at the top links to.
+func Markdown(w io.Writer, d *proxydoc.Documentation) error {
+	mw := &mdWriter{}
+	mw.pkg(d)
+	_, err := w.Write(mw.buf.Bytes())
+	return err
+}
+
+type mdWriter struct {
+	buf bytes.Buffer
+}
+
+func (mw *mdWriter) pkg(d *proxydoc.Documentation) {
+	if d.Command != "" {
+		fmt.Fprintf(&mw.buf, "# command %s\n\n", d.Command)
+		fmt.Fprintf(&mw.buf, "```\ngo install %s@%s\n```\n\n", d.ImportPath, d.ModuleVersion)
+	} else {
+		fmt.Fprintf(&mw.buf, "# package %s\n\n", d.PackageName)
+		fmt.Fprintf(&mw.buf, "```go\nimport %q\n```\n\n", d.ImportPath)
+	}
+	mw.doc(d.PackageDocText)
+	mw.examples(d.Examples)
+	if d.Command != "" {
+		return
+	}
+	mw.index(d)
+
+	if len(d.Constants) > 0 {
+		mw.buf.WriteString("## <a name=\"pkg-constants\"></a>Constants\n\n")
+		mw.values(d.Constants)
+	}
+	if len(d.Variables) > 0 {
+		mw.buf.WriteString("## <a name=\"pkg-variables\"></a>Variables\n\n")
+		mw.values(d.Variables)
+	}
+	if len(d.Funcs) > 0 {
+		mw.buf.WriteString("## <a name=\"pkg-functions\"></a>Functions\n\n")
+		for _, f := range d.Funcs {
+			mw.fn(f, "###")
+		}
+	}
+	if len(d.Types) > 0 {
+		mw.buf.WriteString("## <a name=\"pkg-types\"></a>Types\n\n")
+		for _, t := range d.Types {
+			mw.typ(t)
+		}
+	}
+}
+
+func (mw *mdWriter) index(d *proxydoc.Documentation) {
+	mw.buf.WriteString("## <a name=\"pkg-index\"></a>Index\n\n")
+	if len(d.Constants) > 0 {
+		mw.buf.WriteString("- [Constants](#pkg-constants)\n")
+	}
+	if len(d.Variables) > 0 {
+		mw.buf.WriteString("- [Variables](#pkg-variables)\n")
+	}
+	for _, f := range d.Funcs {
+		fmt.Fprintf(&mw.buf, "- [%s](#%s)\n", escapeText(oneLineSig(f.SignatureString)), f.ID)
+	}
+	for _, t := range d.Types {
+		fmt.Fprintf(&mw.buf, "- [type %s](#%s)\n", t.Name, t.Name)
+		for _, list := range [][]*proxydoc.Func{t.Funcs, t.Methods} {
+			for _, f := range list {
+				fmt.Fprintf(&mw.buf, "  - [%s](#%s)\n", escapeText(oneLineSig(f.SignatureString)), f.ID)
+			}
+		}
+	}
+	mw.buf.WriteString("\n")
+}
+
+func (mw *mdWriter) values(list []*proxydoc.Value) {
+	for _, v := range list {
+		mw.code("go", v.SignatureString)
+		mw.doc(v.DocText)
+	}
+}
+
+func (mw *mdWriter) fn(f *proxydoc.Func, level string) {
+	name := f.Name
+	if f.MethodReceiverString != "" {
+		name = "(" + f.MethodReceiverString + ") " + name
+	}
+	fmt.Fprintf(&mw.buf, "%s <a name=%q></a>func %s\n\n", level, f.ID, escapeText(name))
+	mw.code("go", f.SignatureString)
+	mw.doc(f.DocText)
+	mw.examples(f.Examples)
+}
+
+func (mw *mdWriter) typ(t *proxydoc.Type) {
+	fmt.Fprintf(&mw.buf, "### <a name=%q></a>type %s\n\n", t.Name, t.Name)
+	mw.code("go", t.SignatureString)
+	mw.doc(t.DocText)
+	mw.examples(t.Examples)
+	mw.values(t.Constants)
+	mw.values(t.Variables)
+	for _, list := range [][]*proxydoc.Func{t.Funcs, t.Methods} {
+		for _, f := range list {
+			mw.fn(f, "####")
+		}
+	}
+}
+
+func (mw *mdWriter) examples(list []*proxydoc.Example) {
+	for _, ex := range list {
+		title := "Example"
+		if ex.Name != "" {
+			title += " (" + ex.Name + ")"
+		}
+		fmt.Fprintf(&mw.buf, "<details><summary>%s</summary>\n\n", title)
+		mw.doc(ex.Doc)
+		mw.code("go", ex.Code)
+		if ex.Output != "" {
+			mw.buf.WriteString("Output:\n\n")
+			mw.code("", ex.Output)
+		}
+		mw.buf.WriteString("</details>\n\n")
+	}
+}
+
+// code writes a fenced code block, with a fence longer
+// than any run of backticks in the code.
+func (mw *mdWriter) code(lang, code string) {
+	fence := "```"
+	for strings.Contains(code, fence) {
+		fence += "`"
+	}
+	fmt.Fprintf(&mw.buf, "%s%s\n%s\n%s\n\n", fence, lang, strings.TrimRight(code, "\n"), fence)
+}
+
+// doc converts a Go doc comment to Markdown, following the rules of
+// go/doc: indented lines are preformatted text, and a single line
+// paragraph that looks like a title, between two other paragraphs,
+// is a heading.
+func (mw *mdWriter) doc(text string) {
+	blocks := docBlocks(text)
+	for i, b := range blocks {
+		switch {
+		case b.pre:
+			mw.code("", unindent(b.lines))
+		case len(b.lines) == 1 && i > 0 && i < len(blocks)-1 && !blocks[i-1].pre && !blocks[i+1].pre && isHeading(b.lines[0]):
+			fmt.Fprintf(&mw.buf, "#### %s\n\n", escapeText(b.lines[0]))
+		default:
+			for i, l := range b.lines {
+				b.lines[i] = escapeText(strings.TrimSpace(l))
+			}
+			mw.buf.WriteString(strings.Join(b.lines, "\n") + "\n\n")
+		}
+	}
+}
+
+type docBlock struct {
+	lines []string
+	pre   bool
+}
+
+// docBlocks splits a doc comment into paragraphs
+// and blocks of indented, preformatted lines.
+func docBlocks(text string) []*docBlock {
+	var blocks []*docBlock
+	var cur *docBlock
+	blank := 0
+	for _, l := range strings.Split(text, "\n") {
+		if strings.TrimSpace(l) == "" {
+			blank++
+			if cur != nil && !cur.pre {
+				cur = nil
+			}
+			continue
+		}
+		pre := l[0] == ' ' || l[0] == '\t'
+		if cur == nil || cur.pre != pre {
+			cur = &docBlock{pre: pre}
+			blocks = append(blocks, cur)
+		} else if pre {
+			// blank lines inside preformatted text are kept.
+			for ; blank > 0; blank-- {
+				cur.lines = append(cur.lines, "")
+			}
+		}
+		blank = 0
+		cur.lines = append(cur.lines, l)
+	}
+	return blocks
+}
+
+// unindent removes the longest common indentation of lines.
+func unindent(lines []string) string {
+	prefix, first := "", true
+	for _, l := range lines {
+		if l == "" {
+			continue
+		}
+		ind := l[:len(l)-len(strings.TrimLeft(l, " \t"))]
+		if first {
+			prefix, first = ind, false
+			continue
+		}
+		for !strings.HasPrefix(ind, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	out := make([]string, len(lines))
+	for i, l := range lines {
+		out[i] = strings.TrimPrefix(l, prefix)
+	}
+	return strings.Join(out, "\n")
+}
+
+// isHeading reports whether line looks like a title to go/doc:
+// it starts with an upper case letter, ends with a letter or a
+// digit and has no punctuation.
+func isHeading(line string) bool {
+	line = strings.TrimSpace(line)
+	r, _ := utf8.DecodeRuneInString(line)
+	if !unicode.IsLetter(r) || !unicode.IsUpper(r) {
+		return false
+	}
+	r, _ = utf8.DecodeLastRuneInString(line)
+	if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+		return false
+	}
+	return !strings.ContainsAny(line, ",.;:!?+*/=()[]{}_^°&§~%#@<\">\\")
+}
+
+var mdEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", "&lt;", ">", "&gt;", "|", `\|`,
+)
+
+// escapeText escapes the characters that Markdown would
+// interpret, as well as a # or a list marker at the start.
+func escapeText(s string) string {
+	s = mdEscaper.Replace(s)
+	switch {
+	case strings.HasPrefix(s, "#"), strings.HasPrefix(s, "+ "), strings.HasPrefix(s, "- "):
+		s = `\` + s
+	}
+	if i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsDigit(r) }); i > 0 && strings.HasPrefix(s[i:], ". ") {
+		s = s[:i] + `\` + s[i:]
+	}
+	return s
+}
+
+// oneLineSig returns the first line of a signature.
+func oneLineSig(sig string) string {
+	return strings.SplitN(sig, "\n", 2)[0]
+}
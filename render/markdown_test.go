@@ -0,0 +1,75 @@
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var mdDocTestCases = []struct {
+	name     string
+	text     string
+	expected string
+}{
+	{"paragraph", "Hello *world* [x]\nsecond line\n", "Hello \\*world\\* \\[x\\]\nsecond line\n\n"},
+	{"list marker", "- not a list\n", "\\- not a list\n\n"},
+	{"numbered", "1. not a list\n", "1\\. not a list\n\n"},
+	{"html", "a <b> c\n", "a &lt;b&gt; c\n\n"},
+	{
+		"preformatted",
+		"Example:\n\n\tfoo()\n\n\tbar()\n\t\tbaz()\nDone.\n",
+		"Example:\n\n```\nfoo()\n\nbar()\n\tbaz()\n```\n\nDone.\n\n",
+	},
+	{
+		"heading",
+		"Intro.\n\nUsage Notes\n\nBody.\n",
+		"Intro.\n\n#### Usage Notes\n\nBody.\n\n",
+	},
+	{
+		"not a heading",
+		"Intro.\n\nNot a heading.\n\nBody.\n",
+		"Intro.\n\nNot a heading.\n\nBody.\n\n",
+	},
+	{"empty", "", ""},
+}
+
+func TestMarkdownDoc(t *testing.T) {
+	for _, tc := range mdDocTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mw := &mdWriter{}
+			mw.doc(tc.text)
+			if mw.buf.String() != tc.expected {
+				t.Fatalf("expected:\n%q\nbut got:\n%q", tc.expected, mw.buf.String())
+			}
+		})
+	}
+}
+
+func TestMarkdownCode(t *testing.T) {
+	mw := &mdWriter{}
+	mw.code("", "a ``` b")
+	expected := "````\na ``` b\n````\n\n"
+	if mw.buf.String() != expected {
+		t.Fatalf("expected %q but got %q", expected, mw.buf.String())
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Markdown(&buf, testDoc); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# package lib\n",
+		"- [Constants](#pkg-constants)\n",
+		"- [func Hello() string](#Hello)\n",
+		"- [type Client](#Client)\n",
+		"  - [func (c \\*Client) Do() error](#Client.Do)\n",
+		"### <a name=\"Hello\"></a>func Hello\n\n```go\nfunc Hello() string\n```\n\nHello says hi.\n",
+		"#### <a name=\"Client.Do\"></a>func (\\*Client) Do\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("expected the output to contain %q but got:\n%s", want, buf.String())
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/logging"
+)
+
+// The kinds of pages that moddoc renders.
+const (
+	KindHome      = "home"
+	KindPackage   = "package"
+	KindImporters = "importers"
+	KindGraph     = "graph"
+	KindLicenses  = "licenses"
+	KindError     = "error"
+)
+
+// Page is a page to render.
+type Page struct {
+	// Kind is one of the Kind constants, or
+	// a kind of page that a custom renderer knows.
+	Kind string
+	// Data is what the HTML templates of the page get.
+	Data interface{}
+	// Value is what the other formats render, if it differs
+	// from Data, such as a graph without its HTML helpers.
+	Value interface{}
+	// BasePath is the URL path prefix that moddoc is served
+	// under, which the links of HTML pages start with.
+	BasePath string
+}
+
+func (p *Page) value() interface{} {
+	if p.Value != nil {
+		return p.Value
+	}
+	return p.Data
+}
+
+// ErrUnsupported is returned by a Renderer
+// that cannot render a kind of page.
+var ErrUnsupported = errors.New("render: page not supported in this format")
+
+// Renderer writes pages in a format.
+type Renderer interface {
+	// ContentType is the media type of the output.
+	ContentType() string
+	// Render writes the page, or returns ErrUnsupported.
+	Render(w io.Writer, p *Page) error
+}
+
+// RendererFunc adapts a function to a Renderer of the given content type.
+func RendererFunc(contentType string, f func(w io.Writer, p *Page) error) Renderer {
+	return &funcRenderer{contentType, f}
+}
+
+type funcRenderer struct {
+	contentType string
+	f           func(io.Writer, *Page) error
+}
+
+func (r *funcRenderer) ContentType() string               { return r.contentType }
+func (r *funcRenderer) Render(w io.Writer, p *Page) error { return r.f(w, p) }
+
+// LinkFuncs returns the template functions that link
+// to the pages of moddoc served under the URL path prefix base:
+//
+//	link "/public/main.css"
+//	getVerLink importPath version
+//	importersLink importPath
+//
+// Templates are parsed with the ones of LinkFuncs(""), which HTML
+// replaces with the ones of the BasePath of each page.
+func LinkFuncs(base string) template.FuncMap {
+	l := proxydoc.Links{Base: base}
+	return template.FuncMap{
+		"link":          l.Path,
+		"getVerLink":    l.Version,
+		"importersLink": l.Importers,
+	}
+}
+
+// maxBasePaths bounds the template sets that HTML keeps,
+// one for each BasePath that pages are rendered under.
+const maxBasePaths = 16
+
+// HTML renders pages with the index.html template of the set that
+// templates returns, which picks the page from a boolean named after
+// the kind of page, index for the home page and none for packages.
+// The template functions of LinkFuncs link under the BasePath of the
+// page, which the template also gets as basePath for its scripts.
+// The set that templates returns is never executed, only its clones.
+func HTML(templates func() *template.Template) Renderer {
+	var (
+		mu   sync.Mutex
+		from *template.Template
+		sets = map[string]*template.Template{}
+	)
+	// forBase returns the clone of the current templates
+	// whose links are under base.
+	forBase := func(base string) (*template.Template, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if tmpl := templates(); tmpl != from || len(sets) >= maxBasePaths {
+			from = tmpl
+			sets = map[string]*template.Template{}
+		}
+		if set, ok := sets[base]; ok {
+			return set, nil
+		}
+		set, err := from.Clone()
+		if err != nil {
+			return nil, err
+		}
+		set.Funcs(LinkFuncs(base))
+		sets[base] = set
+		return set, nil
+	}
+	return RendererFunc("text/html; charset=utf-8", func(w io.Writer, p *Page) error {
+		data := map[string]interface{}{"data": p.Data, "basePath": p.BasePath}
+		switch p.Kind {
+		case KindHome:
+			data["index"] = true
+		case KindPackage:
+		default:
+			data[p.Kind] = true
+		}
+		set, err := forBase(p.BasePath)
+		if err != nil {
+			return err
+		}
+		return set.Lookup("index.html").Execute(w, data)
+	})
+}
+
+// JSON renders any page as JSON.
+func JSON() Renderer {
+	return RendererFunc("application/json", func(w io.Writer, p *Page) error {
+		return json.NewEncoder(w).Encode(p.value())
+	})
+}
+
+// MarkdownRenderer renders package pages with Markdown.
+func MarkdownRenderer() Renderer {
+	return RendererFunc("text/markdown; charset=utf-8", func(w io.Writer, p *Page) error {
+		d, ok := p.value().(*proxydoc.Documentation)
+		if !ok {
+			return ErrUnsupported
+		}
+		return Markdown(w, d)
+	})
+}
+
+// TextRenderer renders package pages with Text, and the
+// values of other pages that can write themselves as text.
+func TextRenderer() Renderer {
+	return RendererFunc("text/plain; charset=utf-8", func(w io.Writer, p *Page) error {
+		switch v := p.value().(type) {
+		case *proxydoc.Documentation:
+			return Text(w, v, TextOptions{})
+		case interface{ WriteText(io.Writer) error }:
+			return v.WriteText(w)
+		}
+		return ErrUnsupported
+	})
+}
+
+// DOT renders the values of pages that can write
+// themselves as Graphviz graphs, such as module graphs.
+func DOT() Renderer {
+	return RendererFunc("text/vnd.graphviz; charset=utf-8", func(w io.Writer, p *Page) error {
+		v, ok := p.value().(interface{ WriteDOT(io.Writer) error })
+		if !ok {
+			return ErrUnsupported
+		}
+		return v.WriteDOT(w)
+	})
+}
+
+// Registry holds the renderers of a server by format name.
+// The first registered renderer is the default one.
+type Registry struct {
+	formats   []string
+	renderers map[string]Renderer
+}
+
+// NewRegistry returns a Registry of the built-in formats: html,
+// the default, json, md, text and dot.
+func NewRegistry(templates func() *template.Template) *Registry {
+	rg := &Registry{}
+	rg.Register("html", HTML(templates))
+	rg.Register("json", JSON())
+	rg.Register("md", MarkdownRenderer())
+	rg.Register("text", TextRenderer())
+	rg.Register("dot", DOT())
+	return rg
+}
+
+// Register adds the renderer of a format, or replaces it.
+func (rg *Registry) Register(format string, r Renderer) {
+	if rg.renderers == nil {
+		rg.renderers = map[string]Renderer{}
+	}
+	if _, ok := rg.renderers[format]; !ok {
+		rg.formats = append(rg.formats, format)
+	}
+	rg.renderers[format] = r
+}
+
+// Lookup returns the renderer of a format.
+func (rg *Registry) Lookup(format string) (Renderer, bool) {
+	r, ok := rg.renderers[format]
+	return r, ok
+}
+
+// Negotiate returns the renderers that may answer a request, by order
+// of preference: the one of the ?format= query if any, otherwise the
+// ones that the Accept header prefers, with the default renderer last
+// if the header does not accept it.
+func (rg *Registry) Negotiate(r *http.Request) []Renderer {
+	if format := r.URL.Query().Get("format"); format != "" {
+		if rd, ok := rg.Lookup(format); ok {
+			return []Renderer{rd}
+		}
+		return nil
+	}
+	accepted := parseAccept(r.Header.Get("Accept"))
+	type candidate struct {
+		rd Renderer
+		q  float64
+	}
+	var candidates []candidate
+	for i, format := range rg.formats {
+		rd := rg.renderers[format]
+		q, ok := accepted.quality(rd.ContentType())
+		if i == 0 && (!ok || q == 0) {
+			// the default renderer comes last rather than never.
+			ok, q = true, -1
+		}
+		if ok && q != 0 {
+			candidates = append(candidates, candidate{rd, q})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	list := make([]Renderer, len(candidates))
+	for i, c := range candidates {
+		list[i] = c.rd
+	}
+	return list
+}
+
+// Render writes the page with the first negotiated renderer that
+// supports it, or fails with 406 Not Acceptable.
+func (rg *Registry) Render(w http.ResponseWriter, r *http.Request, status int, p *Page) {
+	w.Header().Add("Vary", "Accept")
+	for _, rd := range rg.Negotiate(r) {
+		var buf bytes.Buffer
+		err := rd.Render(&buf, p)
+		if err == ErrUnsupported {
+			continue
+		}
+		if err != nil {
+			logging.FromContext(r.Context()).Error("could not render page", "format", rd.ContentType(), "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", rd.ContentType())
+		w.WriteHeader(status)
+		w.Write(buf.Bytes())
+		return
+	}
+	http.Error(w, fmt.Sprintf("the %v page is not available in the requested format, try one of %v",
+		p.Kind, strings.Join(rg.formats, ", ")), http.StatusNotAcceptable)
+}
+
+type acceptRange struct {
+	mediaType string
+	q         float64
+}
+
+type acceptHeader []acceptRange
+
+// parseAccept parses the media ranges of an Accept header.
+func parseAccept(accept string) acceptHeader {
+	var ah acceptHeader
+	for _, part := range strings.Split(accept, ",") {
+		mt, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		q := 1.0
+		if v, ok := params["q"]; ok {
+			if q, err = strconv.ParseFloat(v, 64); err != nil {
+				continue
+			}
+		}
+		ah = append(ah, acceptRange{mt, q})
+	}
+	return ah
+}
+
+// quality returns the quality of a content type in the Accept header,
+// from its most specific media range: a type, type/* or */*.
+func (ah acceptHeader) quality(contentType string) (float64, bool) {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return 0, false
+	}
+	q, specificity := 0.0, -1
+	for _, ar := range ah {
+		s := -1
+		switch {
+		case ar.mediaType == mt:
+			s = 2
+		case ar.mediaType == "*/*":
+			s = 0
+		case strings.HasSuffix(ar.mediaType, "/*") && strings.HasPrefix(mt, strings.TrimSuffix(ar.mediaType, "*")):
+			s = 1
+		}
+		if s > specificity {
+			q, specificity = ar.q, s
+		}
+	}
+	return q, specificity >= 0
+}
@@ -0,0 +1,98 @@
+package render
+
+import (
+	"html/template"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+var negotiateTestCases = []struct {
+	name     string
+	url      string
+	accept   string
+	kind     string
+	status   int
+	expected string
+}{
+	{"default", "/", "", KindPackage, 200, "text/html; charset=utf-8"},
+	{"browser", "/", "text/html,application/xhtml+xml,*/*;q=0.8", KindPackage, 200, "text/html; charset=utf-8"},
+	{"any", "/", "*/*", KindPackage, 200, "text/html; charset=utf-8"},
+	{"json", "/", "application/json", KindPackage, 200, "application/json"},
+	{"quality", "/", "text/html;q=0.5, text/markdown", KindPackage, 200, "text/markdown; charset=utf-8"},
+	{"wildcard", "/", "text/*;q=0.9, text/html;q=0.1", KindPackage, 200, "text/markdown; charset=utf-8"},
+	{"query", "/?format=text", "application/json", KindPackage, 200, "text/plain; charset=utf-8"},
+	{"unknown query", "/?format=pdf", "", KindPackage, 406, ""},
+	{"unsupported query", "/?format=md", "", KindHome, 406, ""},
+	{"unsupported falls back", "/", "text/markdown", KindHome, 200, "text/html; charset=utf-8"},
+	{"custom", "/", "application/x-custom", KindPackage, 200, "application/x-custom"},
+}
+
+func TestNegotiate(t *testing.T) {
+	tmpl := template.Must(template.New("index.html").Parse(`{{ if .index }}home{{ else }}package{{ end }}`))
+	rg := NewRegistry(func() *template.Template { return tmpl })
+	rg.Register("custom", RendererFunc("application/x-custom", func(w io.Writer, p *Page) error {
+		_, err := io.WriteString(w, "custom")
+		return err
+	}))
+	for _, tc := range negotiateTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", tc.url, nil)
+			if tc.accept != "" {
+				req.Header.Set("Accept", tc.accept)
+			}
+			rec := httptest.NewRecorder()
+			var data interface{} = map[string]interface{}{}
+			if tc.kind == KindPackage {
+				data = &proxydoc.Documentation{PackageName: "lib", ImportPath: "example.com/lib"}
+			}
+			rg.Render(rec, req, 200, &Page{Kind: tc.kind, Data: data})
+			if rec.Code != tc.status {
+				t.Fatalf("expected status %v but got %v: %v", tc.status, rec.Code, rec.Body)
+			}
+			if ct := rec.Header().Get("Content-Type"); tc.status == 200 && ct != tc.expected {
+				t.Fatalf("expected content type %q but got %q", tc.expected, ct)
+			}
+		})
+	}
+}
+
+func TestHTMLKind(t *testing.T) {
+	tmpl := template.Must(template.New("index.html").Parse(`{{ if .index }}home{{ else if .graph }}graph{{ else }}package{{ end }}`))
+	html := HTML(func() *template.Template { return tmpl })
+	for kind, expected := range map[string]string{KindHome: "home", KindGraph: "graph", KindPackage: "package"} {
+		var sb strings.Builder
+		if err := html.Render(&sb, &Page{Kind: kind}); err != nil {
+			t.Fatal(err)
+		}
+		if sb.String() != expected {
+			t.Fatalf("expected %v to render %q but got %q", kind, expected, sb.String())
+		}
+	}
+}
+
+func TestHTMLBasePath(t *testing.T) {
+	tmpl := template.Must(template.New("index.html").Funcs(LinkFuncs("")).Parse(
+		`<link href="{{ link "/public/main.css" }}"><a href="{{ getVerLink "example.com/lib" "v1.0.0" }}">lib</a>` +
+			`<a href="{{ importersLink "example.com/lib" }}">importers</a>{{ .data }}` +
+			`<script>const basePath = {{ .basePath }};</script>`,
+	))
+	html := HTML(func() *template.Template { return tmpl })
+	// the content of pages, such as a README, is left as is.
+	readme := template.HTML(`<pre><code>&lt;a href="/x"&gt;</code></pre><a href="/example.com/lib/@v/v1.0.0">lib</a>`)
+	for _, base := range []string{"/godoc", "", "/godoc"} {
+		var sb strings.Builder
+		if err := html.Render(&sb, &Page{Kind: KindPackage, Data: readme, BasePath: base}); err != nil {
+			t.Fatal(err)
+		}
+		expected := `<link href="` + base + `/public/main.css"><a href="` + base + `/example.com/lib/@v/v1.0.0">lib</a>` +
+			`<a href="` + base + `/importers/example.com/lib">importers</a>` + string(readme) +
+			`<script>const basePath = "` + base + `";</script>`
+		if sb.String() != expected {
+			t.Fatalf("expected %q but got %q", expected, sb.String())
+		}
+	}
+}
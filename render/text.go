@@ -0,0 +1,323 @@
+// Package render writes the documentation of a package
+// in formats other than the HTML pages of moddoc.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/doc"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"strings"
+	"unicode"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+// TextOptions configures the output of Text.
+type TextOptions struct {
+	// Symbol is a constant, variable, function or type,
+	// or a Type.Method, to show instead of the package.
+	Symbol string
+	// All shows the documentation of every declaration.
+	All bool
+	// Source shows the full source of the declarations, which
+	// needs a Documentation built with the bodies of functions.
+	Source bool
+}
+
+const (
+	indent     = "    "
+	lineWidth  = 80
+	textIndent = lineWidth - len(indent)
+)
+
+// Text writes the documentation of a package as plain text, the
+// way go doc does: the package comment and a one line summary of
+// its declarations, every declaration with opts.All, or a single
+// symbol with opts.Symbol.
+func Text(w io.Writer, d *proxydoc.Documentation, opts TextOptions) error {
+	tw := &textWriter{opts: opts}
+	if opts.Symbol != "" {
+		if err := tw.symbol(d, opts.Symbol); err != nil {
+			return err
+		}
+	} else {
+		tw.pkg(d)
+	}
+	_, err := w.Write(tw.buf.Bytes())
+	return err
+}
+
+type textWriter struct {
+	buf  bytes.Buffer
+	opts TextOptions
+}
+
+func (tw *textWriter) pkg(d *proxydoc.Documentation) {
+	fmt.Fprintf(&tw.buf, "package %s // import %q\n\n", d.PackageName, d.ImportPath)
+	if d.PackageDocText != "" {
+		doc.ToText(&tw.buf, d.PackageDocText, "", indent, lineWidth)
+		tw.buf.WriteString("\n")
+	}
+	if tw.opts.All {
+		tw.all(d)
+		return
+	}
+	tw.summary(d)
+}
+
+// summary lists the declarations of the package, one per line,
+// with the declarations associated with a type indented below it.
+func (tw *textWriter) summary(d *proxydoc.Documentation) {
+	var lines []string
+	for _, v := range d.Constants {
+		lines = append(lines, tw.oneLine(v.SignatureString))
+	}
+	for _, v := range d.Variables {
+		lines = append(lines, tw.oneLine(v.SignatureString))
+	}
+	for _, f := range d.Funcs {
+		lines = append(lines, tw.oneLine(f.SignatureString))
+	}
+	for _, t := range d.Types {
+		lines = append(lines, tw.oneLine(t.SignatureString))
+		for _, v := range t.Constants {
+			lines = append(lines, indent+tw.oneLine(v.SignatureString))
+		}
+		for _, v := range t.Variables {
+			lines = append(lines, indent+tw.oneLine(v.SignatureString))
+		}
+		for _, f := range t.Funcs {
+			lines = append(lines, indent+tw.oneLine(f.SignatureString))
+		}
+	}
+	for _, l := range lines {
+		tw.buf.WriteString(l + "\n")
+	}
+}
+
+func (tw *textWriter) all(d *proxydoc.Documentation) {
+	tw.section("CONSTANTS", len(d.Constants) > 0)
+	for _, v := range d.Constants {
+		tw.decl(v.SignatureString, v.DocText)
+	}
+	tw.section("VARIABLES", len(d.Variables) > 0)
+	for _, v := range d.Variables {
+		tw.decl(v.SignatureString, v.DocText)
+	}
+	tw.section("FUNCTIONS", len(d.Funcs) > 0)
+	for _, f := range d.Funcs {
+		tw.decl(f.SignatureString, f.DocText)
+	}
+	tw.section("TYPES", len(d.Types) > 0)
+	for _, t := range d.Types {
+		tw.typ(t, true)
+	}
+}
+
+func (tw *textWriter) section(title string, ok bool) {
+	if ok {
+		tw.buf.WriteString(title + "\n\n")
+	}
+}
+
+// typ writes a type with the declarations associated with it, which
+// are documented if full is true and summarized otherwise.
+func (tw *textWriter) typ(t *proxydoc.Type, full bool) {
+	tw.decl(t.SignatureString, t.DocText)
+	var lines []string
+	for _, v := range t.Constants {
+		if full {
+			tw.decl(v.SignatureString, v.DocText)
+			continue
+		}
+		lines = append(lines, tw.oneLine(v.SignatureString))
+	}
+	for _, v := range t.Variables {
+		if full {
+			tw.decl(v.SignatureString, v.DocText)
+			continue
+		}
+		lines = append(lines, tw.oneLine(v.SignatureString))
+	}
+	for _, list := range [][]*proxydoc.Func{t.Funcs, t.Methods} {
+		for _, f := range list {
+			if full {
+				tw.decl(f.SignatureString, f.DocText)
+				continue
+			}
+			lines = append(lines, tw.oneLine(f.SignatureString))
+		}
+	}
+	for _, l := range lines {
+		tw.buf.WriteString(l + "\n")
+	}
+}
+
+// decl writes a declaration followed by its documentation. The
+// source of a declaration already has its documentation comment.
+func (tw *textWriter) decl(sig, text string) {
+	if tw.opts.Source {
+		tw.buf.WriteString(sig + "\n\n")
+		return
+	}
+	tw.buf.WriteString(sig + "\n")
+	if text != "" {
+		doc.ToText(&tw.buf, text, indent, indent+"\t", textIndent)
+	}
+	tw.buf.WriteString("\n")
+}
+
+// comment writes text as a // comment.
+func (tw *textWriter) comment(text, prefix string) {
+	if text == "" {
+		return
+	}
+	for _, l := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+		tw.buf.WriteString(strings.TrimRight(prefix+"// "+l, " ") + "\n")
+	}
+}
+
+// symbol writes the documentation of a single symbol.
+func (tw *textWriter) symbol(d *proxydoc.Documentation, sym string) error {
+	if i := strings.Index(sym, "."); i >= 0 {
+		typeName, method := sym[:i], sym[i+1:]
+		for _, t := range d.Types {
+			if !matchName(typeName, t.Name) {
+				continue
+			}
+			for _, f := range t.Methods {
+				if matchName(method, f.Name) {
+					tw.decl(f.SignatureString, f.DocText)
+					return nil
+				}
+			}
+			for _, f := range t.Fields {
+				if matchName(method, f.Name) {
+					tw.field(t, f)
+					return nil
+				}
+			}
+			return fmt.Errorf("no method or field %s.%s in package %s", t.Name, method, d.ImportPath)
+		}
+		return fmt.Errorf("no type %s in package %s", typeName, d.ImportPath)
+	}
+	found := false
+	values := func(list []*proxydoc.Value) {
+		for _, v := range list {
+			if matchValue(sym, v) {
+				tw.decl(v.SignatureString, v.DocText)
+				found = true
+			}
+		}
+	}
+	funcs := func(list []*proxydoc.Func) {
+		for _, f := range list {
+			if matchName(sym, f.Name) {
+				tw.decl(f.SignatureString, f.DocText)
+				found = true
+			}
+		}
+	}
+	values(d.Constants)
+	values(d.Variables)
+	funcs(d.Funcs)
+	for _, t := range d.Types {
+		if matchName(sym, t.Name) {
+			tw.typ(t, tw.opts.All)
+			found = true
+			continue
+		}
+		values(t.Constants)
+		values(t.Variables)
+		funcs(t.Funcs)
+	}
+	if !found {
+		return fmt.Errorf("no symbol %s in package %s", sym, d.ImportPath)
+	}
+	return nil
+}
+
+// field writes a struct field the way go doc does, as
+// its struct declaration without the other fields.
+func (tw *textWriter) field(t *proxydoc.Type, f *proxydoc.Field) {
+	fmt.Fprintf(&tw.buf, "type %s struct {\n", t.Name)
+	tw.comment(f.Doc, "\t")
+	fmt.Fprintf(&tw.buf, "\t%s %s", f.Name, f.Type)
+	if f.StructTag != "" {
+		tw.buf.WriteString(" " + f.StructTag)
+	}
+	tw.buf.WriteString("\n\n\t// ... other fields elided ...\n}\n\n")
+}
+
+// oneLine summarizes a declaration on a single line.
+func (tw *textWriter) oneLine(sig string) string {
+	if tw.opts.Source {
+		sig = stripSource(sig)
+	}
+	lines := strings.Split(sig, "\n")
+	first := lines[0]
+	switch {
+	case len(lines) == 1:
+		return first
+	case strings.HasSuffix(first, "("):
+		// a group of constants or variables, shown by its first spec.
+		for _, l := range lines[1:] {
+			l = strings.TrimSpace(l)
+			if l != "" && !strings.HasPrefix(l, "//") {
+				return strings.TrimSuffix(first, "(") + l + " ..."
+			}
+		}
+	case strings.HasSuffix(first, "{"):
+		return first + " ... }"
+	}
+	return first + " ..."
+}
+
+// stripSource reduces the source of a declaration
+// to its signature, without comments or body.
+func stripSource(sig string) string {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", "package p\n"+sig, 0)
+	if err != nil || len(f.Decls) != 1 {
+		return sig
+	}
+	if fd, ok := f.Decls[0].(*ast.FuncDecl); ok {
+		fd.Body = nil
+	}
+	var b strings.Builder
+	if err := format.Node(&b, fset, f.Decls[0]); err != nil {
+		return sig
+	}
+	return b.String()
+}
+
+// matchName matches a symbol like go doc does: a
+// lower case symbol matches names of any case.
+func matchName(sym, name string) bool {
+	if sym == name {
+		return true
+	}
+	for _, r := range sym {
+		if unicode.IsUpper(r) {
+			return false
+		}
+	}
+	return strings.EqualFold(sym, name)
+}
+
+func matchValue(sym string, v *proxydoc.Value) bool {
+	if !v.IsGroup {
+		return matchName(sym, v.Name)
+	}
+	for _, c := range v.Values {
+		if matchName(sym, c.Name) {
+			return true
+		}
+	}
+	return false
+}
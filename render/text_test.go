@@ -0,0 +1,149 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+)
+
+var testDoc = &proxydoc.Documentation{
+	PackageName:    "lib",
+	ImportPath:     "example.com/lib",
+	PackageDocText: "Package lib is a library.\n",
+	Constants: []*proxydoc.Value{{
+		SignatureString: "const (\n\tA = 1\n\tB = 2\n)",
+		DocText:         "A and B are letters.\n",
+		IsGroup:         true,
+		Values:          []*proxydoc.Value{{Name: "A"}, {Name: "B"}},
+	}},
+	Funcs: []*proxydoc.Func{{
+		ID:              "Hello",
+		Name:            "Hello",
+		SignatureString: "func Hello() string",
+		DocText:         "Hello says hi.\n",
+	}},
+	Types: []*proxydoc.Type{{
+		Name:            "Client",
+		SignatureString: "type Client struct {\n\tName string\n}",
+		DocText:         "Client talks.\n",
+		Fields:          []*proxydoc.Field{{Name: "Name", Type: "string", Doc: "Name is the name.\n"}},
+		Funcs: []*proxydoc.Func{{
+			ID:              "NewClient",
+			Name:            "NewClient",
+			SignatureString: "func NewClient() *Client",
+			DocText:         "NewClient returns a Client.\n",
+		}},
+		Methods: []*proxydoc.Func{{
+			ID:                   "Client.Do",
+			Name:                 "Do",
+			MethodReceiverString: "*Client",
+			SignatureString:      "func (c *Client) Do() error",
+			DocText:              "Do does.\n",
+		}},
+	}},
+}
+
+var textTestCases = []struct {
+	name     string
+	opts     TextOptions
+	expected string
+}{
+	{
+		name: "summary",
+		expected: `package lib // import "example.com/lib"
+
+Package lib is a library.
+
+const A = 1 ...
+func Hello() string
+type Client struct { ... }
+    func NewClient() *Client
+`,
+	},
+	{
+		name: "all",
+		opts: TextOptions{All: true},
+		expected: `package lib // import "example.com/lib"
+
+Package lib is a library.
+
+CONSTANTS
+
+const (
+	A = 1
+	B = 2
+)
+    A and B are letters.
+
+FUNCTIONS
+
+func Hello() string
+    Hello says hi.
+
+TYPES
+
+type Client struct {
+	Name string
+}
+    Client talks.
+
+func NewClient() *Client
+    NewClient returns a Client.
+
+func (c *Client) Do() error
+    Do does.
+
+`,
+	},
+	{
+		name: "type",
+		opts: TextOptions{Symbol: "client"},
+		expected: `type Client struct {
+	Name string
+}
+    Client talks.
+
+func NewClient() *Client
+func (c *Client) Do() error
+`,
+	},
+	{
+		name:     "group member",
+		opts:     TextOptions{Symbol: "B"},
+		expected: "const (\n\tA = 1\n\tB = 2\n)\n    A and B are letters.\n\n",
+	},
+	{
+		name:     "method",
+		opts:     TextOptions{Symbol: "Client.Do"},
+		expected: "func (c *Client) Do() error\n    Do does.\n\n",
+	},
+	{
+		name:     "field",
+		opts:     TextOptions{Symbol: "Client.Name"},
+		expected: "type Client struct {\n\t// Name is the name.\n\tName string\n\n\t// ... other fields elided ...\n}\n\n",
+	},
+}
+
+func TestText(t *testing.T) {
+	for _, tc := range textTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := Text(&buf, testDoc, tc.opts); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != tc.expected {
+				t.Fatalf("expected:\n%s\nbut got:\n%s", tc.expected, buf.String())
+			}
+		})
+	}
+}
+
+func TestTextNotFound(t *testing.T) {
+	for _, sym := range []string{"Nope", "Client.Nope", "Nope.Do", "hello2", "HELLO"} {
+		err := Text(&bytes.Buffer{}, testDoc, TextOptions{Symbol: sym})
+		if err == nil {
+			t.Fatalf("expected %v not to be found", sym)
+		}
+	}
+}
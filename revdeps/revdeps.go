@@ -0,0 +1,184 @@
+// Package revdeps builds a reverse dependency index: which
+// packages import a given package and which modules require
+// a given module, considering the latest version of every
+// known module.
+package revdeps
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/gocopy/semver"
+	"marwan.io/moddoc/proxy"
+)
+
+// maxFetches caps how many module versions are fetched at once.
+const maxFetches = 8
+
+// Source returns the imports of a module version. It
+// is satisfied by proxy.Service.
+type Source interface {
+	GetImports(ctx context.Context, mod, ver string) (*proxy.ModuleImports, error)
+}
+
+// Index is a reverse dependency index. It is safe for
+// concurrent use and can be rebuilt while being read.
+type Index struct {
+	src Source
+
+	mu    sync.RWMutex
+	mods  map[string]*proxy.ModuleImports
+	pkgs  map[string][]*proxydoc.Importer
+	reqBy map[string][]*proxydoc.Importer
+}
+
+// New returns an empty Index that fetches module imports from src.
+func New(src Source) *Index {
+	return &Index{
+		src:   src,
+		mods:  map[string]*proxy.ModuleImports{},
+		pkgs:  map[string][]*proxydoc.Importer{},
+		reqBy: map[string][]*proxydoc.Importer{},
+	}
+}
+
+// Build updates the index from the given modules, mapped to their
+// known versions. Only the latest version of each module is
+// considered and versions already indexed are not fetched again.
+// Up to maxFetches module versions are fetched concurrently. A module
+// that cannot be fetched is reported to onErr and keeps its previously
+// indexed version, if any.
+func (idx *Index) Build(ctx context.Context, mods map[string][]string, onErr func(error)) error {
+	idx.mu.RLock()
+	next := make(map[string]*proxy.ModuleImports, len(mods))
+	for mod := range mods {
+		if mi, ok := idx.mods[mod]; ok {
+			next[mod] = mi
+		}
+	}
+	idx.mu.RUnlock()
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, maxFetches)
+	)
+	for mod, vers := range mods {
+		ver := latest(vers)
+		if ver == "" {
+			continue
+		}
+		if mi, ok := next[mod]; ok && mi.Version == ver {
+			continue
+		}
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(mod, ver string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			mi, err := idx.fetch(ctx, mod, ver)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if onErr != nil {
+					onErr(fmt.Errorf("could not index %v@%v: %v", mod, ver, err))
+				}
+				return
+			}
+			next[mod] = mi
+		}(mod, ver)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	pkgs, reqBy := invert(next)
+	idx.mu.Lock()
+	idx.mods, idx.pkgs, idx.reqBy = next, pkgs, reqBy
+	idx.mu.Unlock()
+	return nil
+}
+
+func (idx *Index) fetch(ctx context.Context, mod, ver string) (*proxy.ModuleImports, error) {
+	encMod, err := module.EncodePath(mod)
+	if err != nil {
+		return nil, err
+	}
+	encVer, err := module.EncodeVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	return idx.src.GetImports(ctx, encMod, encVer)
+}
+
+// ImportedBy returns the packages of other modules that import pkg.
+func (idx *Index) ImportedBy(pkg string) []*proxydoc.Importer {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.pkgs[pkg]
+}
+
+// RequiredBy returns the modules whose go.mod requires mod.
+func (idx *Index) RequiredBy(mod string) []*proxydoc.Importer {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return idx.reqBy[mod]
+}
+
+func invert(mods map[string]*proxy.ModuleImports) (pkgs, reqBy map[string][]*proxydoc.Importer) {
+	pkgs = map[string][]*proxydoc.Importer{}
+	reqBy = map[string][]*proxydoc.Importer{}
+	for _, mi := range mods {
+		for _, req := range mi.Requires {
+			reqBy[req] = append(reqBy[req], &proxydoc.Importer{
+				Path:    mi.Module,
+				Module:  mi.Module,
+				Version: mi.Version,
+			})
+		}
+		for pkg, imports := range mi.Packages {
+			for _, imp := range imports {
+				if mi.Contains(imp) {
+					continue
+				}
+				pkgs[imp] = append(pkgs[imp], &proxydoc.Importer{
+					Path:    pkg,
+					Module:  mi.Module,
+					Version: mi.Version,
+				})
+			}
+		}
+	}
+	for _, list := range pkgs {
+		sortImporters(list)
+	}
+	for _, list := range reqBy {
+		sortImporters(list)
+	}
+	return pkgs, reqBy
+}
+
+func sortImporters(list []*proxydoc.Importer) {
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Path < list[j].Path
+	})
+}
+
+func latest(vers []string) string {
+	ver := ""
+	for _, v := range vers {
+		if semver.IsValid(v) && (ver == "" || semver.Compare(v, ver) > 0) {
+			ver = v
+		}
+	}
+	return ver
+}
@@ -0,0 +1,78 @@
+package revdeps
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"marwan.io/moddoc/proxy"
+)
+
+type fakeSource map[string]*proxy.ModuleImports
+
+func (fs fakeSource) GetImports(ctx context.Context, mod, ver string) (*proxy.ModuleImports, error) {
+	mi, ok := fs[mod+"@"+ver]
+	if !ok {
+		return nil, fmt.Errorf("%v@%v not found", mod, ver)
+	}
+	return mi, nil
+}
+
+func TestBuild(t *testing.T) {
+	src := fakeSource{
+		"example.com/lib@v1.1.0": {
+			Module:   "example.com/lib",
+			Version:  "v1.1.0",
+			Packages: map[string][]string{"example.com/lib": {"fmt", "example.com/lib/plugins/x"}, "example.com/lib/sub": {"example.com/lib"}},
+			Nested:   []string{"example.com/lib/plugins"},
+		},
+		"example.com/app@v0.2.0": {
+			Module:   "example.com/app",
+			Version:  "v0.2.0",
+			Requires: []string{"example.com/lib"},
+			Packages: map[string][]string{"example.com/app/cmd": {"example.com/lib", "example.com/lib/sub"}},
+		},
+		"example.com/old@v0.2.0": {
+			Module:   "example.com/old",
+			Version:  "v0.2.0",
+			Packages: map[string][]string{"example.com/old": {"fmt"}},
+		},
+	}
+	idx := New(src)
+	err := idx.Build(context.Background(), map[string][]string{
+		"example.com/lib": {"v1.0.0", "v1.1.0"},
+		"example.com/app": {"v0.1.0", "v0.2.0"},
+		"example.com/old": {"v0.1.0", "v0.2.0"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	imps := idx.ImportedBy("example.com/lib")
+	if len(imps) != 1 || imps[0].Path != "example.com/app/cmd" || imps[0].Version != "v0.2.0" {
+		t.Fatalf("expected example.com/lib to be imported by example.com/app/cmd@v0.2.0 only but got %+v", imps)
+	}
+	if got := len(idx.ImportedBy("fmt")); got != 2 {
+		t.Fatalf("expected fmt to have 2 importers but got %d", got)
+	}
+	imps = idx.ImportedBy("example.com/lib/plugins/x")
+	if len(imps) != 1 || imps[0].Path != "example.com/lib" {
+		t.Fatalf("expected the nested module to be imported by example.com/lib but got %+v", imps)
+	}
+	reqs := idx.RequiredBy("example.com/lib")
+	if len(reqs) != 1 || reqs[0].Module != "example.com/app" {
+		t.Fatalf("expected example.com/lib to be required by example.com/app but got %+v", reqs)
+	}
+
+	// a module that disappears from the list is dropped from the index
+	err = idx.Build(context.Background(), map[string][]string{
+		"example.com/lib": {"v1.1.0"},
+		"example.com/old": {"v0.2.0"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(idx.ImportedBy("example.com/lib")); got != 0 {
+		t.Fatalf("expected no importers but got %d", got)
+	}
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"marwan.io/moddoc/server"
+)
+
+// serve serves s on the configured listener until SIGTERM or SIGINT,
+// then stops accepting connections and waits for the pages being built.
+func serve(s *server.Server) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	hs := &http.Server{
+		Handler:      s,
+		ReadTimeout:  cfg.Server.ReadTimeout,
+		WriteTimeout: cfg.Server.WriteTimeout,
+		IdleTimeout:  cfg.Server.IdleTimeout,
+	}
+	if cfg.TLS.Cert != "" {
+		cert, err := server.LoadCertificate(cfg.TLS.Cert, cfg.TLS.Key)
+		if err != nil {
+			return fmt.Errorf("could not load the TLS certificate: %v", err)
+		}
+		if cfg.TLS.Reload > 0 {
+			go cert.Watch(ctx, cfg.TLS.Reload, func(err error) {
+				logger.Error("could not reload the TLS certificate, keeping the previous one", "error", err)
+			})
+		}
+		// http.Server enables HTTP/2 on the TLS config.
+		hs.TLSConfig = &tls.Config{GetCertificate: cert.GetCertificate}
+	}
+	ln, addr, err := listen()
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGTERM, os.Interrupt)
+		logger.Info("shutting down, waiting for the pages being built", "signal", <-sig, "timeout", cfg.Server.ShutdownTimeout)
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
+		defer cancel()
+		done <- hs.Shutdown(ctx)
+	}()
+
+	logger.Info("listening", "address", addr, "base_path", cfg.Server.BasePath)
+	if hs.TLSConfig != nil {
+		err = hs.ServeTLS(ln, "", "")
+	} else {
+		err = hs.Serve(ln)
+	}
+	if err != http.ErrServerClosed {
+		return err
+	}
+	err = <-done
+	s.Close()
+	return err
+}
+
+// listen listens on the unix socket or the address of the configuration.
+func listen() (net.Listener, string, error) {
+	if cfg.Server.Socket == "" {
+		ln, err := net.Listen("tcp", cfg.Server.Listen)
+		return ln, cfg.Server.Listen, err
+	}
+	// remove the socket of a previous run that did not stop cleanly.
+	if fi, err := os.Stat(cfg.Server.Socket); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(cfg.Server.Socket)
+	}
+	ln, err := net.Listen("unix", cfg.Server.Socket)
+	return ln, "unix:" + cfg.Server.Socket, err
+}
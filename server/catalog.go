@@ -1,9 +1,11 @@
-package main
+package server
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
-	"strings"
+	"sort"
 
 	"marwan.io/moddoc/fetch"
 )
@@ -24,8 +26,8 @@ type moduleIndex struct {
 	Latest   string   `json:"latest"`
 }
 
-func catalog(w http.ResponseWriter, r *http.Request) {
-	url := strings.TrimSuffix(config.GoProxyURL, "/") + "/catalog"
+func (s *Server) catalog(w http.ResponseWriter, r *http.Request) {
+	url := s.goproxy + "/catalog"
 	resp, err := fetch.Fetch(r.Context(), url)
 	if err != nil {
 		http.Error(w, err.Error(), 500)
@@ -46,6 +48,32 @@ func catalog(w http.ResponseWriter, r *http.Request) {
 	for _, m := range lr.Modules {
 		mp[m.Module] = append(mp[m.Module], m.Version)
 	}
+	json.NewEncoder(w).Encode(newModuleIndexes(mp))
+}
+
+func (s *Server) getCatalogModules(ctx context.Context) ([]*moduleIndex, error) {
+	url := s.goproxy + "/catalog"
+	resp, err := fetch.Fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("unexpected status: %v", resp.StatusCode)
+	}
+	var lr listResp
+	err = json.NewDecoder(resp.Body).Decode(&lr)
+	if err != nil {
+		return nil, err
+	}
+	mp := map[string][]string{}
+	for _, m := range lr.Modules {
+		mp[m.Module] = append(mp[m.Module], m.Version)
+	}
+	return newModuleIndexes(mp), nil
+}
+
+func newModuleIndexes(mp map[string][]string) []*moduleIndex {
 	mods := []*moduleIndex{}
 	for mod, vers := range mp {
 		mods = append(mods, &moduleIndex{
@@ -54,5 +82,8 @@ func catalog(w http.ResponseWriter, r *http.Request) {
 			latestVer(vers),
 		})
 	}
-	json.NewEncoder(w).Encode(mods)
+	sort.Slice(mods, func(i, j int) bool {
+		return mods[i].Module < mods[j].Module
+	})
+	return mods
 }
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Certificate is a TLS certificate loaded from a certificate and
+// a key file, which can be reloaded when the files change, such as
+// when they are renewed, without restarting the server.
+type Certificate struct {
+	certFile, keyFile string
+
+	mu    sync.RWMutex
+	cert  *tls.Certificate
+	stamp string
+}
+
+// LoadCertificate loads the PEM encoded certificate and key files.
+func LoadCertificate(certFile, keyFile string) (*Certificate, error) {
+	c := &Certificate{certFile: certFile, keyFile: keyFile}
+	if _, err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetCertificate returns the current certificate,
+// to be used as the GetCertificate of a tls.Config.
+func (c *Certificate) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cert, nil
+}
+
+// Reload loads the certificate again if its files changed since
+// it was last loaded, and reports whether it did. The current
+// certificate is kept if the new files are not valid, and the
+// error is only returned once for a given state of the files.
+func (c *Certificate) Reload() (bool, error) {
+	stamp, err := c.filesStamp()
+	if err != nil {
+		return false, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cert != nil && stamp == c.stamp {
+		return false, nil
+	}
+	c.stamp = stamp
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return false, err
+	}
+	c.cert = &cert
+	return true, nil
+}
+
+// Watch reloads the certificate every interval until the context
+// is canceled. Reload errors are reported to onErr, if given,
+// and do not stop the watch.
+func (c *Certificate) Watch(ctx context.Context, interval time.Duration, onErr func(error)) error {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if _, err := c.Reload(); err != nil && onErr != nil {
+			onErr(err)
+		}
+	}
+}
+
+// filesStamp describes the certificate and key files
+// by their sizes and modification times, to notice changes.
+func (c *Certificate) filesStamp() (string, error) {
+	var stamp string
+	for _, name := range []string{c.certFile, c.keyFile} {
+		fi, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		stamp += fmt.Sprintf("%d %d\n", fi.Size(), fi.ModTime().UnixNano())
+	}
+	return stamp, nil
+}
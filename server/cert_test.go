@@ -0,0 +1,109 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCertificateReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "moddoc-cert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	writeCert(t, certFile, keyFile, "first", time.Now().Add(-time.Hour))
+
+	c, err := LoadCertificate(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := commonName(t, c); name != "first" {
+		t.Fatalf("expected the first certificate but got %v", name)
+	}
+	if reloaded, err := c.Reload(); reloaded || err != nil {
+		t.Fatalf("expected no reload of unchanged files but got %v, %v", reloaded, err)
+	}
+
+	writeCert(t, certFile, keyFile, "second", time.Now())
+	if reloaded, err := c.Reload(); !reloaded || err != nil {
+		t.Fatalf("expected a reload of the changed files but got %v, %v", reloaded, err)
+	}
+	if name := commonName(t, c); name != "second" {
+		t.Fatalf("expected the second certificate but got %v", name)
+	}
+
+	if err := ioutil.WriteFile(keyFile, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Reload(); err == nil {
+		t.Fatal("expected an error for an invalid key")
+	}
+	if _, err := c.Reload(); err != nil {
+		t.Fatalf("expected the error to be reported once but got %v", err)
+	}
+	if name := commonName(t, c); name != "second" {
+		t.Fatalf("expected the second certificate to be kept but got %v", name)
+	}
+
+	if _, err := LoadCertificate(filepath.Join(dir, "missing.pem"), keyFile); err == nil {
+		t.Fatal("expected an error for a missing certificate")
+	}
+}
+
+func commonName(t *testing.T, c *Certificate) string {
+	cert, err := c.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+// writeCert writes a self-signed certificate and its key,
+// setting their modification time to mtime.
+func writeCert(t *testing.T, certFile, keyFile, name string, mtime time.Time) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]*pem.Block{
+		certFile: {Type: "CERTIFICATE", Bytes: der},
+		keyFile:  {Type: "EC PRIVATE KEY", Bytes: keyDER},
+	}
+	for name, block := range files {
+		if err := ioutil.WriteFile(name, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(name, mtime, mtime); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
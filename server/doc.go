@@ -0,0 +1,67 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/proxy"
+	"marwan.io/moddoc/render"
+)
+
+const docPath = "/{module:.+}/@v/{version}"
+
+func (s *Server) getDoc(w http.ResponseWriter, r *http.Request) {
+	mod := mux.Vars(r)["module"]
+	ver := mux.Vars(r)["version"]
+	importPath := mod
+	mod, err := gomodule.EncodePath(mod)
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	if gomodule.CanonicalVersion(ver) != ver {
+		// branches, commit hashes and other queries are
+		// resolved to their canonical (pseudo-)version.
+		info, err := s.srv.GetInfo(r.Context(), mod, ver)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("could not resolve version %q: %v", ver, err), 404)
+			return
+		}
+		redirect(w, r, links(r).Version(importPath, info.Version), http.StatusFound)
+		return
+	}
+	doc, err := s.srv.GetDoc(r.Context(), mod, ver)
+	if ze, ok := err.(*proxy.ZipError); ok {
+		s.renderError(w, r, http.StatusUnprocessableEntity, "Module rejected", fmt.Sprintf(
+			"The zip of %v@%v breaks the module zip rules or the size limits of this server: %v.",
+			ze.Module, ze.Version, ze.Reason,
+		), "See https://golang.org/ref/mod#zip-files for the rules module zips must follow.")
+		return
+	}
+	if err != nil {
+		logging.FromContext(r.Context()).Error("could not build documentation", "module", mod, "version", ver, "error", err)
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	if s.revIndex != nil {
+		doc.ImportedBy = s.revIndex.ImportedBy(doc.ImportPath)
+		if doc.ImportPath == doc.ModuleRoot {
+			doc.RequiredBy = s.revIndex.RequiredBy(doc.ModuleRoot)
+		}
+	}
+	s.render(w, r, http.StatusOK, &render.Page{Kind: render.KindPackage, Data: doc})
+}
+
+func (s *Server) renderError(w http.ResponseWriter, r *http.Request, status int, title, message, details string) {
+	s.render(w, r, status, &render.Page{
+		Kind: render.KindError,
+		Data: map[string]interface{}{
+			"Title":   title,
+			"Message": message,
+			"Details": details,
+		},
+	})
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"marwan.io/moddoc/gocopy/semver"
+	"marwan.io/moddoc/render"
+)
+
+func (s *Server) templateFuncs() template.FuncMap {
+	funcs := template.FuncMap{
+		"toLower":        strings.ToLower,
+		"subOne":         subOne,
+		"json":           getJSON,
+		"latestVer":      latestVer,
+		"methodReceiver": methodReceiver,
+		"timeAgo":        timeAgo,
+	}
+	for name, f := range render.LinkFuncs("") {
+		funcs[name] = f
+	}
+	return funcs
+}
+
+func subOne(i int) int {
+	return i - 1
+}
+
+func getJSON(i interface{}) string {
+	bts, _ := json.Marshal(i)
+	return string(bts)
+}
+
+func latestVer(vers []string) string {
+	sortVersions(vers)
+	if len(vers) == 0 {
+		return "latest"
+	}
+	return vers[0]
+}
+
+func sortVersions(list []string) {
+	sort.Slice(list, func(i, j int) bool { return semver.Compare(list[i], list[j]) > 0 })
+}
+
+// timeAgo describes how long ago t was in
+// the largest unit that fits, such as "3 days ago".
+func timeAgo(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	d := time.Since(t)
+	unit := func(n int, name string) string {
+		if n == 1 {
+			return "1 " + name + " ago"
+		}
+		return fmt.Sprintf("%d %ss ago", n, name)
+	}
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return unit(int(d/time.Minute), "minute")
+	case d < 24*time.Hour:
+		return unit(int(d/time.Hour), "hour")
+	case d < 30*24*time.Hour:
+		return unit(int(d/(24*time.Hour)), "day")
+	case d < 365*24*time.Hour:
+		return unit(int(d/(30*24*time.Hour)), "month")
+	}
+	return unit(int(d/(365*24*time.Hour)), "year")
+}
+
+func methodReceiver(receiver string) string {
+	if receiver == "" {
+		return ""
+	}
+
+	return "(" + receiver + ")"
+}
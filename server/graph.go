@@ -0,0 +1,42 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/modgraph"
+	"marwan.io/moddoc/render"
+)
+
+const graphPath = "/{module:.+}/@v/{version}/graph"
+
+// getGraph renders the MVS requirement graph of a module version as
+// HTML, or exports it with ?format=dot, ?format=json or ?format=text,
+// the latter being the output of go mod graph. The formats can also
+// be negotiated with the Accept header.
+func (s *Server) getGraph(w http.ResponseWriter, r *http.Request) {
+	mod := mux.Vars(r)["module"]
+	ver := mux.Vars(r)["version"]
+	main := gomodule.Version{Path: mod, Version: ver}
+	if err := gomodule.Check(mod, ver); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	g, err := modgraph.Resolve(r.Context(), s.srv, main)
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	var sb strings.Builder
+	g.WriteText(&sb)
+	s.render(w, r, http.StatusOK, &render.Page{
+		Kind: render.KindGraph,
+		Data: map[string]interface{}{
+			"Graph": g,
+			"Text":  sb.String(),
+		},
+		Value: g,
+	})
+}
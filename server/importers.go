@@ -0,0 +1,136 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"marwan.io/moddoc/fetch"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/render"
+)
+
+const importersPath = "/importers/{path:.+}"
+
+// maxImportedByModules caps how many modules the reverse dependency
+// index considers, since the latest version of each is downloaded.
+const maxImportedByModules = 1000
+
+// buildRevIndex rebuilds the reverse dependency index from
+// all known modules every interval of WithImportedBy.
+func (s *Server) buildRevIndex(ctx context.Context) {
+	for {
+		mods, err := s.knownModules(ctx)
+		if err != nil {
+			s.logger.Error("could not list modules for the imported by index", "error", err)
+		} else {
+			s.revIndex.Build(ctx, mods, func(err error) {
+				s.logger.Warn("could not index a module version", "error", err)
+			})
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.importedByInterval):
+		}
+	}
+}
+
+// knownModules returns the modules given to WithImportedBy, or else
+// the catalogued ones, mapped to their versions. The module index is
+// never used as it would mean downloading every module there is, and
+// only the first maxImportedByModules modules by path are kept.
+func (s *Server) knownModules(ctx context.Context) (map[string][]string, error) {
+	var mods []*moduleIndex
+	if len(s.importedByModules) > 0 {
+		mods = s.listModules(ctx, s.importedByModules)
+	} else {
+		var err error
+		mods, err = s.getCatalogModules(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("the GOPROXY has no catalog and no modules were listed: %v", err)
+		}
+	}
+	if len(mods) > maxImportedByModules {
+		s.logger.Warn("too many modules for the imported by index, ignoring the rest", "modules", len(mods), "max", maxImportedByModules)
+		mods = mods[:maxImportedByModules]
+	}
+	mp := make(map[string][]string, len(mods))
+	for _, m := range mods {
+		mp[m.Module] = m.Versions
+	}
+	return mp, nil
+}
+
+// listModules fetches the versions of the given modules from the
+// GOPROXY concurrently. Modules that cannot be listed are left out.
+func (s *Server) listModules(ctx context.Context, paths []string) []*moduleIndex {
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, 8)
+		mp  = map[string][]string{}
+	)
+	for _, mod := range paths {
+		wg.Add(1)
+		go func(mod string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			vers, err := s.listVersions(ctx, mod)
+			if err != nil {
+				s.logger.Warn("could not list the versions of a module", "module", mod, "error", err)
+				return
+			}
+			mu.Lock()
+			mp[mod] = vers
+			mu.Unlock()
+		}(mod)
+	}
+	wg.Wait()
+	return newModuleIndexes(mp)
+}
+
+func (s *Server) listVersions(ctx context.Context, mod string) ([]string, error) {
+	encMod, err := gomodule.EncodePath(mod)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetch.Fetch(ctx, s.goproxy+"/"+encMod+"/@v/list")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("unexpected status: %v", resp.StatusCode)
+	}
+	vers := []string{}
+	scnr := bufio.NewScanner(resp.Body)
+	for scnr.Scan() {
+		if v := strings.TrimSpace(scnr.Text()); v != "" {
+			vers = append(vers, v)
+		}
+	}
+	return vers, scnr.Err()
+}
+
+func (s *Server) importers(w http.ResponseWriter, r *http.Request) {
+	if s.revIndex == nil {
+		http.Error(w, "the imported by index is disabled", http.StatusNotFound)
+		return
+	}
+	path := mux.Vars(r)["path"]
+	s.render(w, r, http.StatusOK, &render.Page{
+		Kind: render.KindImporters,
+		Data: map[string]interface{}{
+			"ImportPath": path,
+			"ImportedBy": s.revIndex.ImportedBy(path),
+			"RequiredBy": s.revIndex.RequiredBy(path),
+		},
+	})
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"marwan.io/moddoc/index"
+)
+
+func TestKnownModules(t *testing.T) {
+	s, cleanup := newTestServer(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	mods, err := s.knownModules(ctx)
+	if err != nil || fmt.Sprint(mods) != "map[example.com/lib:[v1.0.0]]" {
+		t.Fatalf("expected the catalogued module but got %v, %v", mods, err)
+	}
+
+	s.importedByModules = []string{"example.com/lib", "example.com/missing"}
+	mods, err = s.knownModules(ctx)
+	if err != nil || fmt.Sprint(mods) != "map[example.com/lib:[v1.0.0]]" {
+		t.Fatalf("expected the listed module that exists but got %v, %v", mods, err)
+	}
+
+	// the module index is not a substitute for a catalog
+	noCatalog := httptest.NewServer(http.NotFoundHandler())
+	defer noCatalog.Close()
+	store, _ := index.Open("")
+	store.Add([]index.Entry{{Path: "example.com/other", Version: "v1.0.0"}})
+	s.goproxy, s.index, s.importedByModules = noCatalog.URL, store, nil
+	mods, err = s.knownModules(ctx)
+	if err == nil {
+		t.Fatalf("expected an error without a catalog but got %v", mods)
+	}
+}
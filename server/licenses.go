@@ -0,0 +1,39 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/license"
+	"marwan.io/moddoc/modgraph"
+	"marwan.io/moddoc/render"
+)
+
+const licensesPath = "/{module:.+}/@v/{version}/licenses"
+
+// getLicenseReport lists the licenses of a module version and of all
+// its dependencies, checked against the license policy, as HTML or
+// as JSON with ?format=json or the Accept header.
+func (s *Server) getLicenseReport(w http.ResponseWriter, r *http.Request) {
+	mod := mux.Vars(r)["module"]
+	ver := mux.Vars(r)["version"]
+	if err := gomodule.Check(mod, ver); err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	g, err := modgraph.Resolve(r.Context(), s.srv, gomodule.Version{Path: mod, Version: ver})
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	report := license.NewReport(r.Context(), s.srv, g, s.policy)
+	s.render(w, r, http.StatusOK, &render.Page{
+		Kind: render.KindLicenses,
+		Data: map[string]interface{}{
+			"Report": report,
+			"Policy": s.policy,
+		},
+		Value: report,
+	})
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/metrics"
+)
+
+var (
+	httpRequests = metrics.NewCounter(
+		"moddoc_http_requests_total",
+		"Requests served, by route and status code.",
+		"route", "code",
+	)
+	httpDuration = metrics.NewHistogram(
+		"moddoc_http_request_duration_seconds",
+		"Time to serve requests, by route and status code.",
+		metrics.DefBuckets, "route", "code",
+	)
+)
+
+// instrument records, traces and logs the
+// requests served by h under the route name.
+func instrument(route string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ctx, span := logging.StartSpan(r.Context(), "request", "route", route)
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		h.ServeHTTP(sw, r.WithContext(ctx))
+		var err error
+		if sw.status >= 500 {
+			err = errors.New(http.StatusText(sw.status))
+		}
+		span.End(err)
+		code := strconv.Itoa(sw.status)
+		httpRequests.Inc(route, code)
+		httpDuration.Observe(time.Since(start).Seconds(), route, code)
+		fields := []interface{}{"method", r.Method, "path", r.URL.Path, "route", route, "status", sw.status, "duration", time.Since(start)}
+		if sc, ok := logging.SpanContextFromContext(ctx); ok {
+			fields = append(fields, "trace_id", hex.EncodeToString(sc.TraceID[:]))
+		}
+		logging.FromContext(ctx).Info("served request", fields...)
+	})
+}
+
+// statusWriter records the status code of a response.
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
@@ -1,4 +1,4 @@
-package main
+package server
 
 import (
 	"bufio"
@@ -10,16 +10,23 @@ import (
 
 	"marwan.io/moddoc/fetch"
 	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/proxy"
 )
 
-func getModule(w http.ResponseWriter, r *http.Request) {
-	mod := strings.TrimPrefix(r.URL.Path, "/")
-	mod, err := gomodule.EncodePath(strings.TrimSuffix(mod, "/"))
+func (s *Server) getModule(w http.ResponseWriter, r *http.Request) {
+	mod := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if proxy.IsStdlib(mod) {
+		// A GOPROXY does not serve the standard library, so send
+		// import links for it to the upstream documentation instead.
+		http.Redirect(w, r, "https://pkg.go.dev/"+mod, http.StatusFound)
+		return
+	}
+	mod, err := gomodule.EncodePath(mod)
 	if err != nil {
 		http.Error(w, err.Error(), 400)
 		return
 	}
-	url := config.GoProxyURL + "/" + mod + "/@v/list"
+	url := s.goproxy + "/" + mod + "/@v/list"
 	resp, err := fetch.Fetch(r.Context(), url)
 	if err != nil {
 		http.Error(w, fmt.Sprintf("error fetching list: %v", err), 500)
@@ -37,10 +44,10 @@ func getModule(w http.ResponseWriter, r *http.Request) {
 	}
 	ver := latestVer(vers)
 	if ver == "latest" {
-		url := config.GoProxyURL + "/" + mod + "/@latest"
+		url := s.goproxy + "/" + mod + "/@latest"
 		ver = getLatest(url)
 	}
-	http.Redirect(w, r, "/"+mod+"/@v/"+ver, http.StatusMovedPermanently)
+	redirect(w, r, links(r).Version(mod, ver), http.StatusMovedPermanently)
 }
 
 func getLatest(url string) string {
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	gomodule "marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/proxy"
+)
+
+const rawPath = "/{module:.+}/@v/{version}/raw/{file:.+}"
+
+// getRaw serves a file of a module zip, such as the images and
+// documents that a README links to. Files are served with a
+// restrictive content security policy and never as HTML, since
+// their content comes from the module author.
+func (s *Server) getRaw(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	mod, err := gomodule.EncodePath(vars["module"])
+	if err != nil {
+		http.Error(w, err.Error(), 400)
+		return
+	}
+	ver := vars["version"]
+	if gomodule.CanonicalVersion(ver) != ver {
+		http.Error(w, "raw files are only served for canonical versions", 400)
+		return
+	}
+	content, err := s.srv.GetFile(r.Context(), mod, ver, vars["file"])
+	if err == proxy.ErrFileNotFound {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), 500)
+		return
+	}
+	ctype := mime.TypeByExtension(path.Ext(vars["file"]))
+	if ctype == "" {
+		ctype = http.DetectContentType(content)
+	}
+	if strings.HasPrefix(ctype, "text/") || strings.Contains(ctype, "html") || strings.Contains(ctype, "javascript") {
+		ctype = "text/plain; charset=utf-8"
+	}
+	w.Header().Set("Content-Type", ctype)
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; img-src 'self'; style-src 'unsafe-inline'; sandbox")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	// the content of a module version never changes
+	w.Header().Set("Cache-Control", "public, max-age=86400")
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+}
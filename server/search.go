@@ -0,0 +1,26 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// homeLimit caps how many modules from the local index
+// are embedded in the home page. The rest are reachable
+// through the search endpoint.
+const homeLimit = 500
+
+func (s *Server) search(w http.ResponseWriter, r *http.Request) {
+	if s.index == nil {
+		http.Error(w, "the module index is disabled", http.StatusNotFound)
+		return
+	}
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	if limit <= 0 || limit > homeLimit {
+		limit = homeLimit
+	}
+	mods := newModuleIndexes(s.index.Search(r.URL.Query().Get("q"), limit))
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mods)
+}
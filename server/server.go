@@ -0,0 +1,336 @@
+// Package server serves the documentation of the modules of a GOPROXY.
+// A Server is an http.Handler, so that it can be mounted inside another
+// site as well as run on its own by the moddoc command.
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	// embedded files
+	_ "marwan.io/moddoc/statik"
+
+	"github.com/gorilla/mux"
+	"github.com/rakyll/statik/fs"
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/index"
+	"marwan.io/moddoc/license"
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/metrics"
+	"marwan.io/moddoc/proxy"
+	"marwan.io/moddoc/render"
+	"marwan.io/moddoc/revdeps"
+	"marwan.io/moddoc/theme"
+)
+
+// Server serves moddoc.
+type Server struct {
+	goproxy   string
+	srv       proxy.Service
+	router    *mux.Router
+	renderers *render.Registry
+	theme     *theme.Theme
+	logger    *logging.Logger
+	tracer    logging.Tracer
+
+	basePath        string
+	forwardedPrefix bool
+
+	themeFS     http.FileSystem
+	themeReload time.Duration
+
+	policy             *license.Policy
+	index              *index.Store
+	revIndex           *revdeps.Index
+	importedByInterval time.Duration
+	importedByModules  []string
+	serveMetrics       bool
+
+	cancel context.CancelFunc
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithService replaces the proxy.Service that builds the documentation,
+// which defaults to proxy.NewService of the GOPROXY without options.
+func WithService(srv proxy.Service) Option {
+	return func(s *Server) {
+		s.srv = srv
+	}
+}
+
+// WithTheme overrides the embedded templates and public assets with
+// the ones of dir, see the theme package. If reload is positive, the
+// templates are reloaded every reload when they change.
+func WithTheme(dir string, reload time.Duration) Option {
+	if dir == "" {
+		return WithThemeFS(nil, reload)
+	}
+	return WithThemeFS(http.Dir(dir), reload)
+}
+
+// WithThemeFS is like WithTheme but takes the overrides from files, such
+// as the statik file system of a program that embeds its own theme.
+func WithThemeFS(files http.FileSystem, reload time.Duration) Option {
+	return func(s *Server) {
+		s.themeFS = files
+		s.themeReload = reload
+	}
+}
+
+// WithBasePath serves moddoc under the URL path prefix p, such as
+// /godoc, instead of the root. The links, redirects and assets of
+// the pages are prefixed with it.
+func WithBasePath(p string) Option {
+	return func(s *Server) {
+		s.basePath = cleanPrefix(p)
+	}
+}
+
+// WithForwardedPrefix trusts the X-Forwarded-Prefix header of the
+// requests, with which a reverse proxy that strips a URL path prefix
+// before forwarding the requests tells the prefix to add to the links.
+// It must only be enabled behind such a proxy, which sets or removes
+// the header of every request.
+func WithForwardedPrefix() Option {
+	return func(s *Server) {
+		s.forwardedPrefix = true
+	}
+}
+
+// WithLogger replaces the logger of the requests and errors,
+// which writes the info level to the standard output by default.
+func WithLogger(l *logging.Logger) Option {
+	return func(s *Server) {
+		s.logger = l
+	}
+}
+
+// WithTracer traces the stages of the requests with t.
+// A request with a traceparent header continues its trace.
+func WithTracer(t logging.Tracer) Option {
+	return func(s *Server) {
+		s.tracer = t
+	}
+}
+
+// WithRenderer adds the renderer of a format
+// to the built-in ones, or replaces one of them.
+func WithRenderer(format string, r render.Renderer) Option {
+	return func(s *Server) {
+		s.renderers.Register(format, r)
+	}
+}
+
+// WithLicensePolicy checks the license reports against p.
+func WithLicensePolicy(p *license.Policy) Option {
+	return func(s *Server) {
+		s.policy = p
+	}
+}
+
+// WithIndex lists the modules of a module index store on the home
+// page and in search results when the GOPROXY has no catalog.
+// The caller is responsible for syncing the store.
+func WithIndex(store *index.Store) Option {
+	return func(s *Server) {
+		s.index = store
+	}
+}
+
+// WithImportedBy builds a reverse dependency index of the latest
+// version of the given modules, or of the modules in the catalog of
+// the GOPROXY if none are given, rebuilt every interval, to show the
+// packages that import a package.
+func WithImportedBy(interval time.Duration, modules ...string) Option {
+	return func(s *Server) {
+		s.importedByInterval = interval
+		s.importedByModules = modules
+	}
+}
+
+// WithMetrics serves the metrics of moddoc at /metrics,
+// in the Prometheus text format.
+func WithMetrics() Option {
+	return func(s *Server) {
+		s.serveMetrics = true
+	}
+}
+
+// New returns a Server of the documentation of the modules of the
+// goproxy URL. It fails if the templates of the theme are not valid.
+func New(goproxy string, opts ...Option) (*Server, error) {
+	s := &Server{
+		goproxy: strings.TrimSuffix(goproxy, "/"),
+		logger:  logging.New(os.Stdout, logging.Info, false),
+	}
+	s.renderers = render.NewRegistry(func() *template.Template { return s.theme.Templates() })
+	for _, o := range opts {
+		o(s)
+	}
+	if s.srv == nil {
+		s.srv = proxy.NewService(s.goproxy)
+	}
+	dist, err := fs.New()
+	if err != nil {
+		return nil, err
+	}
+	s.theme, err = theme.NewFS(dist, s.themeFS, s.templateFuncs())
+	if err != nil {
+		return nil, fmt.Errorf("invalid theme: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	if s.themeFS != nil && s.themeReload > 0 {
+		go s.theme.Watch(ctx, s.themeReload, func(err error) {
+			s.logger.Error("could not reload the theme, keeping the previous templates", "error", err)
+		})
+	}
+	if s.importedByInterval > 0 {
+		s.revIndex = revdeps.New(s.srv)
+		go s.buildRevIndex(ctx)
+	}
+	s.router = s.routes(s.theme.FileSystem())
+	return s, nil
+}
+
+// Renderers returns the renderers of the pages, by format.
+func (s *Server) Renderers() *render.Registry {
+	return s.renderers
+}
+
+// Close stops the background work of the Server,
+// such as the reverse dependency index.
+func (s *Server) Close() error {
+	s.cancel()
+	return nil
+}
+
+// ServeHTTP serves the pages of moddoc.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	base := s.basePath
+	if s.forwardedPrefix {
+		fp := strings.TrimSpace(strings.Split(r.Header.Get("X-Forwarded-Prefix"), ",")[0])
+		base = cleanPrefix(fp) + base
+	}
+	id := requestID(r.Header.Get("X-Request-ID"))
+	w.Header().Set("X-Request-ID", id)
+	ctx := proxydoc.WithLinks(r.Context(), proxydoc.Links{Base: base})
+	ctx = logging.NewContext(ctx, s.logger.With("request_id", id))
+	if s.tracer != nil {
+		ctx = logging.WithTracer(ctx, s.tracer)
+		if sc, ok := logging.ParseTraceParent(r.Header.Get("traceparent")); ok {
+			ctx = logging.ContextWithSpanContext(ctx, sc)
+		}
+	}
+	r = r.WithContext(ctx)
+	if s.basePath != "" {
+		p := strings.TrimPrefix(r.URL.Path, s.basePath)
+		if len(p) == len(r.URL.Path) || (p != "" && p[0] != '/') {
+			http.NotFound(w, r)
+			return
+		}
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		r2.URL.Path = "/" + strings.TrimPrefix(p, "/")
+		r2.URL.RawPath = ""
+		r = r2
+	}
+	s.router.ServeHTTP(w, r)
+}
+
+// requestID returns the ID of a request, which is the one of its
+// X-Request-ID header if it is valid or a new random one otherwise.
+func requestID(h string) string {
+	if h != "" && len(h) <= 64 && strings.Trim(h, "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.") == "" {
+		return h
+	}
+	var id [8]byte
+	rand.Read(id[:])
+	return hex.EncodeToString(id[:])
+}
+
+// links returns the Links of the pages served to a request.
+func links(r *http.Request) proxydoc.Links {
+	return proxydoc.LinksFromContext(r.Context())
+}
+
+// redirect sends r to target, keeping its query
+// string so that options such as ?format=json survive.
+func redirect(w http.ResponseWriter, r *http.Request, target string, code int) {
+	if r.URL.RawQuery != "" {
+		target += "?" + r.URL.RawQuery
+	}
+	http.Redirect(w, r, target, code)
+}
+
+// cleanPrefix returns the URL path prefix p, such as /godoc, without
+// a trailing slash, or "" if p is not a valid path from the root.
+func cleanPrefix(p string) string {
+	if p == "" || strings.ContainsAny(p, "\"'<>?#\\ \t") {
+		return ""
+	}
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return ""
+	}
+	return p
+}
+
+// render writes the page in the format that the request asks for,
+// with the links prefixed with the base path of the request.
+func (s *Server) render(w http.ResponseWriter, r *http.Request, status int, p *render.Page) {
+	p.BasePath = links(r).Base
+	_, span := logging.StartSpan(r.Context(), "render", "kind", p.Kind)
+	s.renderers.Render(w, r, status, p)
+	span.End(nil)
+}
+
+// routes returns the routes of moddoc, serving
+// the public assets from dist.
+func (s *Server) routes(dist http.FileSystem) *mux.Router {
+	r := mux.NewRouter()
+	r.Handle("/", instrument("home", http.HandlerFunc(s.home)))
+	r.Handle(docPath, instrument("doc", http.HandlerFunc(s.getDoc)))
+	r.Handle(graphPath, instrument("graph", http.HandlerFunc(s.getGraph)))
+	r.Handle(rawPath, instrument("raw", http.HandlerFunc(s.getRaw)))
+	r.Handle(licensesPath, instrument("licenses", http.HandlerFunc(s.getLicenseReport)))
+	r.Handle("/catalog", instrument("catalog", http.HandlerFunc(s.catalog)))
+	r.Handle("/search", instrument("search", http.HandlerFunc(s.search)))
+	r.Handle(importersPath, instrument("importers", http.HandlerFunc(s.importers)))
+	if s.serveMetrics {
+		r.Handle("/metrics", instrument("metrics", metrics.Handler()))
+	}
+	r.PathPrefix("/public/").Handler(instrument("public", http.FileServer(dist)))
+	r.NotFoundHandler = instrument("module", http.HandlerFunc(s.getModule))
+	return r
+}
+
+func (s *Server) home(w http.ResponseWriter, r *http.Request) {
+	mods, err := s.getCatalogModules(r.Context())
+	remoteSearch := false
+	if err != nil && s.index != nil {
+		logging.FromContext(r.Context()).Warn("could not retrieve the catalog, falling back to the module index", "error", err)
+		mods = newModuleIndexes(s.index.Search("", homeLimit))
+		remoteSearch = s.index.Len() > len(mods)
+	}
+	s.render(w, r, http.StatusOK, &render.Page{
+		Kind: render.KindHome,
+		Data: map[string]interface{}{
+			"Modules":      mods,
+			"RemoteSearch": remoteSearch,
+		},
+	})
+}
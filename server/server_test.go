@@ -0,0 +1,239 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	proxydoc "marwan.io/moddoc/doc"
+	"marwan.io/moddoc/logging"
+	"marwan.io/moddoc/proxy"
+	"marwan.io/moddoc/render"
+)
+
+// fakeService documents every canonical module version
+// with an empty package named after its last element.
+type fakeService struct {
+	proxy.Service
+}
+
+func (fakeService) GetDoc(ctx context.Context, mod, ver string) (*proxydoc.Documentation, error) {
+	return &proxydoc.Documentation{
+		ImportPath:    mod,
+		ModuleRoot:    mod,
+		ModuleVersion: ver,
+		PackageName:   mod[strings.LastIndex(mod, "/")+1:],
+		Versions:      []string{ver},
+	}, nil
+}
+
+// newTestServer returns a Server of a fake GOPROXY
+// and a function that closes both.
+func newTestServer(t *testing.T, opts ...Option) (*Server, func()) {
+	t.Helper()
+	goproxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/catalog":
+			fmt.Fprint(w, `{"modules": [{"module": "example.com/lib", "version": "v1.0.0"}]}`)
+		case "/example.com/lib/@v/list":
+			fmt.Fprint(w, "v1.0.0\n")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	s, err := New(goproxy.URL, append([]Option{WithService(fakeService{}), WithLogger(nil)}, opts...)...)
+	if err != nil {
+		goproxy.Close()
+		t.Fatal(err)
+	}
+	return s, func() {
+		s.Close()
+		goproxy.Close()
+	}
+}
+
+func get(s *Server, target string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("GET", target, nil))
+	return rec
+}
+
+var serveTestCases = []struct {
+	name     string
+	target   string
+	code     int
+	contains string
+}{
+	{"home", "/", 200, `example.com\/lib`},
+	{"package", "/example.com/lib/@v/v1.0.0", 200, "package lib"},
+	{"latest", "/example.com/lib", 301, "/example.com/lib/@v/v1.0.0"},
+	{"latest with query", "/example.com/lib?format=json", 301, "/example.com/lib/@v/v1.0.0?format=json"},
+	{"standard library", "/net/http", 302, "https://pkg.go.dev/net/http"},
+	{"catalog", "/catalog", 200, `"latest":"v1.0.0"`},
+	{"asset", "/public/main.css", 200, ".Header"},
+	{"search disabled", "/search?q=lib", 404, "disabled"},
+}
+
+func TestServe(t *testing.T) {
+	s, done := newTestServer(t)
+	defer done()
+	for _, tc := range serveTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := get(s, tc.target)
+			if rec.Code != tc.code {
+				t.Fatalf("expected status %v but got %v: %v", tc.code, rec.Code, rec.Body)
+			}
+			got := rec.Body.String() + rec.Header().Get("Location")
+			if !strings.Contains(got, tc.contains) {
+				t.Fatalf("expected the response to contain %q but got %q", tc.contains, got)
+			}
+		})
+	}
+}
+
+var basePathTestCases = []struct {
+	name     string
+	opts     []Option
+	target   string
+	prefix   string
+	code     int
+	contains string
+}{
+	{"page", []Option{WithBasePath("/godoc/")}, "/godoc/example.com/lib/@v/v1.0.0", "", 200, `href="/godoc/public/main.css"`},
+	{"redirect", []Option{WithBasePath("/godoc")}, "/godoc/example.com/lib", "", 301, "/godoc/example.com/lib/@v/v1.0.0"},
+	{"script", []Option{WithBasePath("godoc")}, "/godoc/", "", 200, `const basePath = "/godoc"`},
+	{"outside", []Option{WithBasePath("/godoc")}, "/catalog", "", 404, ""},
+	{"prefix of a segment", []Option{WithBasePath("/godoc")}, "/godocs/catalog", "", 404, ""},
+	{"forwarded", []Option{WithForwardedPrefix()}, "/example.com/lib", "/godoc", 301, "/godoc/example.com/lib/@v/v1.0.0"},
+	{"forwarded and base path", []Option{WithForwardedPrefix(), WithBasePath("/docs")}, "/docs/example.com/lib", "/portal/", 301, "/portal/docs/example.com/lib/@v/v1.0.0"},
+	{"invalid forwarded", []Option{WithForwardedPrefix()}, "/example.com/lib", `/"><script>`, 301, "/example.com/lib/@v/v1.0.0"},
+	{"untrusted forwarded", nil, "/example.com/lib/@v/v1.0.0", "/godoc", 200, `href="/public/main.css"`},
+}
+
+func TestBasePath(t *testing.T) {
+	for _, tc := range basePathTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, done := newTestServer(t, tc.opts...)
+			defer done()
+			req := httptest.NewRequest("GET", tc.target, nil)
+			if tc.prefix != "" {
+				req.Header.Set("X-Forwarded-Prefix", tc.prefix)
+			}
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+			if rec.Code != tc.code {
+				t.Fatalf("expected status %v but got %v: %v", tc.code, rec.Code, rec.Body)
+			}
+			got := rec.Body.String()
+			if loc := rec.Header().Get("Location"); loc != "" {
+				got = loc
+			}
+			if !strings.Contains(got, tc.contains) {
+				t.Fatalf("expected the response to contain %q but got %q", tc.contains, got)
+			}
+		})
+	}
+}
+
+func TestWithThemeFS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "theme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	os.MkdirAll(filepath.Join(dir, "public"), 0755)
+	ioutil.WriteFile(filepath.Join(dir, "public", "main.css"), []byte("company css"), 0644)
+	s, done := newTestServer(t, WithThemeFS(http.Dir(dir), 0))
+	defer done()
+	if rec := get(s, "/public/main.css"); rec.Body.String() != "company css" {
+		t.Fatalf("expected the css of the theme but got %q", rec.Body)
+	}
+	if rec := get(s, "/public/normalize.css"); rec.Code != 200 {
+		t.Fatalf("expected the embedded normalize.css but got %v", rec.Code)
+	}
+}
+
+func TestWithRenderer(t *testing.T) {
+	s, done := newTestServer(t, WithRenderer("name", render.RendererFunc("text/plain", func(w io.Writer, p *render.Page) error {
+		d, ok := p.Data.(*proxydoc.Documentation)
+		if !ok {
+			return render.ErrUnsupported
+		}
+		_, err := io.WriteString(w, d.PackageName)
+		return err
+	})))
+	defer done()
+	rec := get(s, "/example.com/lib/@v/v1.0.0?format=name")
+	if rec.Code != 200 || rec.Body.String() != "lib" {
+		t.Fatalf("expected the custom renderer to write lib but got %v %q", rec.Code, rec.Body)
+	}
+	if rec := get(s, "/?format=name"); rec.Code != http.StatusNotAcceptable {
+		t.Fatalf("expected status 406 but got %v", rec.Code)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	s, done := newTestServer(t)
+	defer done()
+	if rec := get(s, "/metrics"); rec.Code == http.StatusOK {
+		t.Fatal("expected no metrics without WithMetrics")
+	}
+
+	s, done = newTestServer(t, WithMetrics())
+	defer done()
+	get(s, "/example.com/lib/@v/v1.0.0")
+	rec := get(s, "/metrics")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200 but got %v", rec.Code)
+	}
+	expected := `moddoc_http_requests_total{route="doc",code="200"}`
+	if !strings.Contains(rec.Body.String(), expected) {
+		t.Fatalf("expected the metrics to contain %v but got:\n%v", expected, rec.Body)
+	}
+}
+
+var requestIDTestCases = []struct {
+	name   string
+	header string
+	reused bool
+}{
+	{"generated", "", false},
+	{"reused", "req-123_abc.1", true},
+	{"invalid", "bad id\n", false},
+}
+
+func TestRequestLog(t *testing.T) {
+	for _, tc := range requestIDTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			s, done := newTestServer(t, WithLogger(logging.New(&buf, logging.Info, false)), WithTracer(logging.LogTracer()))
+			defer done()
+			req := httptest.NewRequest("GET", "/example.com/lib/@v/v1.0.0", nil)
+			req.Header.Set("X-Request-ID", tc.header)
+			req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+			rec := httptest.NewRecorder()
+			s.ServeHTTP(rec, req)
+			id := rec.Header().Get("X-Request-ID")
+			if (id == tc.header) != tc.reused || id == "" {
+				t.Fatalf("expected the request ID of %q to be reused: %v, but got %q", tc.header, tc.reused, id)
+			}
+			for _, expect := range []string{
+				"request_id=" + id + " span=render",
+				"request_id=" + id + " span=request trace_id=4bf92f3577b34da6a3ce929d0e0e4736",
+				`msg="served request" request_id=` + id + " method=GET",
+			} {
+				if !strings.Contains(buf.String(), expect) {
+					t.Fatalf("expected the logs to contain %q but got:\n%v", expect, buf.String())
+				}
+			}
+		})
+	}
+}
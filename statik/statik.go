@@ -8,6 +8,6 @@ import (
 )
 
 func init() {
-	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00public/atom-one-light.cssUT\x05\x00\x01\xef\xdd\x02]|\x94\xcd\x8a\xdb0\x10\xc7\xefz\nAn\xcbz\x13'\xce\xc7zO\x85B/\x85=\xf4\x01\xca\xc8\x9e8j\xf4\xe1J\xe3\xdd\x98\xd2w/\x92b#\x13(\xb9D?\xcf\xfc\xff\xe3\x99\x91\xd7O\x8c}!\xab\xf9\xbbA\xfe]v\x17\xe2b\xe4_\xc1HT\xfc\x1bh\xe8\x90\xbd;\xd9I\x03*\x8b\xf91\x1a\x82\x1b\xa7\x0bj\xe4gg5\xbf\x10\xf5\xbe^\xaf;I\x97A\xbc4V\xaf\x81\xac^[\x83\x85\n9\x85\x8f9\x8c	\xf0Xs\xce\xf9\xea\x0c\xe1\xc7\xb45\xb6(k\xceW\xbb\xd3\x0e\xaam\x02\xdb\x00\x0e\xa7\x838\x1e\x13\xd8\x05\x00\x1b(\xe1\xc8.\x03\xc6\x0c\xbe\xda\x94\xa7J\x88\x08\xb6\x11T\x9b\xe3\xe9\xbc\x8d`\x17\x01\x1c\xb6\x07\xa8\"\xa8\"\xd8o\xa0\xac\xce\x11\xec#\xc0j\x7f\xa8^\x13\x08*\xab\xe6\xb5\xdcV\xbb\x08\x0e1\xe2\xf5t8m\xca\x04RDy\xaa6%cOk\xc6^.\xea\x97\xe7\x7f\x18\xe7\xad\xf4\xbd\x82\xb1\xe6B\xd9\xe6\xfa\xc68\xb7\x1f\xe8\xce\xca~\x16\xb7\x9a\xc3@6\xb0\x1e\xdaV\x9a\xae\xe6\x9b\x97=\xea@\x1a\xab\xac\xab\xa7\xf7\x0fD@s\xed\x9c\x1dL[O}zc\x7f\xef^Ec\xb5FC\xcf\xf7\xe3\xef\xc1\x12\xc6\x02&\xa1\xd4\xa6 t\xb6\x86\nO\xa3\xc2\x9aK\x02%\x9bL\xa7\xb5\x0dA7\xc9\\q\xfc\xb4\xae\x9d\x8eg\xeb\xf4\xa0`\xa9\x1b{\x99)xlHZ3\xe5\x18\xd0\xf8<?R\xd8\x90uEf\xd1\xa2\xc2<\xde\x0f\xc2\xd3\xc2!\xcd\"sP\x92\xd0\x81Z\x04\xa5\xa1\xe7e\x90\x93fvq\xd8\xe1\xad\x9fN\xa1\xdb\xb9'\x109)\x06\x9a\x0b\xd5HpWX\xb8\xa4=\xc9\\\xc4 \x15\xfd\x94\xb3R\xa3\xc0{\x9e\xfe\x93$\xb5\x9cAZ\x91,=\x18O\xa9\x1f\xe0$\x085\xd7@\xa8{\x05\x84\x8f\x0f\xc6\xfe\xb1\xa3\xd1\xf8\x81\xe6\xfa3\xec=\x0e\xad\x9d\xe73h\x81nQfZ\xed\xbc\x97\xa3\x16V=\xcf\xef\xac\x14\xce\x9b\xa6\xa4\xb9\xe6m{\xb0\x93\xf3\xfa<6$\xdd\xcc\xcc	u\x7f\x01/\xd3\xdd\xf9\xef\xa2zr\xf6>\x9d\x18\xf7\x89\xe1\x93RsaU\xbb\xd8\x15s\x8dA\x847*Zl\xac\x830\xfa\x9a\x0f\xa6E\xa7\xa4\xc1\x10\xfd/\x00\x00\xff\xffPK\x07\x08\x01\xc9\x04\xe87\x02\x00\x00\xf5\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00public/favicon.icoUT\x05\x00\x01\xef\xdd\x02]\xec\x98\x0dLUe\x18\xc7\x1f\xe0\x1a\x04\x85\x18\x9a]K\xefi\xa62\xe7\xdc,\x95sg&\xae\x9a\xb9\xe4\\\xf5\x9e\x13s\xe8n\xe9\x0c\xd4\xcc\x12\xb8\x8a\x9abi\xcd\xcdO0D\xca\x89\xb94]M\x14\x9ckj\xdd\x0b\x9b)a\x1a\xf3\x13\xf9\xf4\x8a\".\x94\xe0\xf2\xe1\x85\xf7\xdf\xdes?\xa6\xdc\xeb\xae3Xk\xbb\xff\xed\x19\xe7y\xdf\xe7}~\xef\xd7\xf32 \n\xa2`\x12\x04\xfeS\xa0\x1f\xa2\x88\x86\x13QT\x14\xf7\xc3(\xb9\x0f\xd1\x1fQD1D$\x10Q\x1c9\xe3\xfcI\x96Dpc\xb5\xe6^57'\xc0\x0f\xf0\x03\xfc\x00?\xc0\xff\x7f\xf2\xcb\xad\xf3q*\x7f\xb6j\xd5\xa7\x92\xfd\xc6\xf74\xfff\xedY8:\xa1\xda\xf5\xf2\x13~\xe3{\x8a\xdf^\x91\x82\x9b\xa5\x8b\xd0t\xaf\xc1\xc3\xbf]w\x197J\x16\xaa}\xfe\xc6\xff\x1b\xbe\xa3*\x0d\x07r\xdf\xc7\xf6\xacU\xd8\xbe\xfb\x18r\xf7Y\xf1\xcd\xfe\"d}\x9b\x8f\xec\xac\x15\xc8\xdfm\xeaU~{E:\xcc\x9b,H\xddX\xec\xd3Vm9\xd6\xb3\xfc\xeb+\xc1\x1a\x0f\x03mW\x81\x0e\x1b`?\x87\xbc\x03GU\x96q\xeez\xbc\xa6\x9f\x8aq\xafO\xc3\xac\x85[\xd5\xb6\xfc\xc2#\xc0\xdf\xc5@\xf3\x19\xb0\xc6C`\xb65O\xce\xbf\xb1\x0e\xb8_\x8f\xeeb\x00JKNB'\x0c\xf5\xd8\x88\x98\x91\xa8\xac\xba\xec\x15\x8b\xae\x16\xb0\xfa\x9c'\xe2\xa3\xbd\xca;\xdf\x03\xca\xdba\xc6\xb4)c1\xe3\xddq(\xf8)\xf7\xd1\x81]m`7\xd6?\x0e\xbf\x99\xb7\xb7W\xa4\x82\xd5\x7f\xed\x95\xa6\xb5\xb5\x15\xa6\x0f\xe6!f\xe4h\xac\xfcl\x0d\x98\xe3.X\xed2\xb0[\xdb\xbcb/\\\xb8\x88\xb8\xc9oa\xcc\xab\xe3QPx\x14h\xb2\xba\xeeO\xaa\x9b\xdf\xec\x83_\xce\xfb\xee\x9c_\x02\xd6X\xe0\x95sGN.\xfaE\x0f\xf4\x98\xb5\xa8\x18\xac\xeeK\xe7\xfd\xe8\xa6\xe93dO\x9c\xee\xe5a\xe8\xb4W\xaa\xfc\x86\xf3KT\xbeQ\x12\xafv\xe7+\x86\xd8C\xbc\xaf\xe4\xe8<\xb0\xbb\x85^9\xb3\xb3s\x1e\xe2[,V\xb0\xba\xaf|\xf2\x0d\xd3\x8d\x9e\xb8!\xc2+p\xb4T\xa8\xfc3\x05s\xdd\xeb?\xfc\xf0\xda\xf5J\xc2L}\xcb\xd6\xb5\x12\xae\x9f\xf9\x08\xac~\x87WN\xbb\xdd\x8e\xc4\xd9&\x0c\x1b>\x12\xe6e\xe9`\x8e&\xd7\xfegy\xc5\xfeYV\x86	\x13\xe30j\xf4\x18\xe4\xe7\xf3\x9a(R\xf9W,\xc9X\xbe\xf8m\xceo\x97\x0d\xfa$\x95=M\xfff\xc2t=+;\xfe!\xfe*\xfb\x04\x9d\xd5ft\xd5\xf0\xfbW\xe3\x95\xf7A\xf13\xf2\xdcU^\x9f\x8fRW\xbb\xf3\x9c\\\xb1m\xd7R\xf0\xdd6Y\xdd\x07\xc5 &(\x92x\xfcs\xf3T4_I\xc1\xaeMF\xec\xda<\x13\xad\xe5)\xe8\xb2\xf1\xfak\xf0\x9d\xb3\xe5w\xe7\xda\xddue[\xeb{\xbe]\xad`\xb7s\xc1j\xcc\xb8\xf4K\x12r6\xcc\xc0\xe1\xdd\xb3`\xbf\x9a\xe2\xe4K\xe2y\xd9 \xd6,\x9a\x1b\x87\xfbU\xa9\xe8\xa8L\xf3\x9cS\xe6\x17\x12\xec\xd7\xd2\x9dw\xa1\xad\x12\xe8\xa8\x03\xece`\x0dy>\xdf5u>w\xf6\x02\xcd\xbf\x01-\xa5\xce\xfd\xb1e\xa8\xac=\xdbd\xdc>\xf7\xb1\xbav\xbe\xc7\xd7\x8a\x17\xb8\xef\x81]\x91b\xad\xfc{\xf5\xd2)8\x92\x97\x88\x8d\xab\xe3=5\x9al\x9a\x04\xcb\x8f&t\xf0\x9a\xf4\xf3\xaev7^k|l\x92i\x92\x9ak\x8e2\x01{3\x15\x1c\xdc\x99\x80\xf9s\xdep\xd6A\xbcx\x89\x9f\xbf,\x89\x9dn\xa6\xc7\x0c\xa2\xcd\xfdmJ\x98\x80\xccu\x06\x14\xeeI\xc4\x85\x93I\xb8uv1\x9a.}\xaa\xee\x197\xfe\xcd\xdbx\x1f\x8f\xe1\xb1|\x8c{\xbc\"\xe9+\xbc\xf2K\"\xe3\xe7\xaf\xdeA\xc3\xf8\x89\xb2A\xff\xb3,\x89U\x8a$\x96(\x92\xb8\x80\x88\x82\x8d\xf1\xfaYFI\xac\xf61\xf6\xb1\xcc(\x89\x15\xc6x\xf1=\xfe'\xb0,\x89\x89\xb2$\x16\xbb\xf2Y\xe4xqj\xf77\xc0\x97\x14E	\x99)\xc5N\x96\x0db\xa6l\x10O\xf09\xca\x92\xd8\xe827\xab\xc9\xe5\xdb\x9c1\xb1[\xf8\x9a22(\xd8_\xfe\xffZ\x16\xa2\x90\xde\xb2\x0c\xa2 _L\xde\x0e\"\xb5Dc\x88(\xca\xf5\xbf\x8a \n\xf3\x15\xae\xaa_\xf4@\xd2	Ci\xf4\xa8\x11\x94 \x8d\xe1\xbf/(m~,-O\x1eK+S$:\x98\xf9\x0e}\x9f)S\xea\xc6b:\xbd_\xa2\xcd;\xf7\xd1\xbe\xbd\x1b\xe8t\xc1R*:\xb2\x82\xce\x1dO\xa7\x92_\xb7P\xa95\x9b\x9a/\xa6\x12*\x97\x11\xaa\xb9-'\xd4\xa6\x11j\xd2\x89\xd5\x9a\xe9Vm\x19\xd9[\x1d\xd4v\x1f\xe4\xe8\x049\x18\xf8D\x1f9\xaf\x80\x02\n\xa8\xf7%h\xb4\x0fH#\xf4\x92\x1f\xd5W;\xa0\x7f\x7f\xb7\xffB\xf8\xe0\xc1\xe1Z\xb7?@\xab}6<\xfc\x19\xed\xc0\xe7\x9d~\xb4N\x17\x19\x14\x14\xa9\xd3E;\xfdAC(X\xab\x1d4\x98\x82\xb5\x1aA\xf3T\xc4 \x9d\xea\xeb(8\xa2\x8fF\x13\x11\x16\xf9\xe2K\x91Zm\xa4N\x17\x19\x16\xa1\x114\xa1a\x11\x11\x1c\xf9\\\xbf\xa7C5\x02Whh\x88F\x13\x12\x1a*\xf8PF\x10\xd1\xe3\x9a[\xfc\xfb^_\xfe\xd0\x12\xfd\x13\x00\x00\xff\xffPK\x07\x08\xf7\x871\xc7\x19\x05\x00\x006\x16\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00public/fuzz.jsUT\x05\x00\x01\xef\xdd\x02]\xd4WM\x8f\xdb\xba\x15\xfd+\xc7\\\x0c(X\x8f\xb5\x83.\n9\xaaQ\xbc\xb6\xab\xf7\x90\x02\xf3v\x82\xf1 \xd3\x94\xad\xb1,\x1a$\x15G\x99\xf1\x7f/.)\xc9rFN6A\x81nfH\x8a\xbc\xbc\x1f\xe7\x9cK\xcf\x8a\xa6\x96\xae\xd45\xb8\x8eQDx\x05\xd3\xdb\x17%\x1dC\x9a\xc2\xb5g\xa5\x0b\xa8/gm\x9c\xc5\xd3\xd3\xd4\xd7\x93\xde5\x95\xc2\xba\x1b\x88~w\x8a\x82GH\xc0\xfa;\xc6\x87v\xaa(kE\x16\xc3H\xe4\xa7\x1d\xd6\xdd\x84g\x1b\xefL\xf2=g\xd6\xfdH\xfc\xbb\xf9\xfa\xb5}V\xb9\x91\x87\xe1R=\xb1z\xe5\xac\xa9\xc3\x0d;\x86\xd9`\xf1R\xd6;}\xc1\xba\x1f$p\x87\xd2\xc6\xb8\xe5\x86\xf2b\x94kL=Z\xd4\xb4\xfa97(\x90\xe2\xf5\xba\xba}\x92\xdc\xd1\xb7\xb2\x00/2\xb7\x89\x86\xb3\x99\xdb\xf4\xe9Y\xf9\xa3\x86\\\xcb\xdc\x86,\xa0L\xe0bT	f\xcb\xb8\x0f.\xc1\xeb\x15\xd7UoA\x93\x05\x99W\x157\xbd\xa1\x18&\xc6h&#\x9aVH1[\x8c>\xe0\xda\xdb\x90\xe2\x84\x14:\x86\x14\x92\xae\xa7\xc1\x8e\x06wP\x88\xe1c\x90Bw\xc8x{\xc3'_\x0c\x11R\xf8\x1f\xa3\xcf\xca\xb8\xb6\xdb\xfd\nU7'e\xf2m\xa5\x12\x7f\xf5^\xb9\x04\x0e\xd7\x08W\xba\xc2\xdc_\xe1\xa16Y\x8e\xe7\xf6\xb4\xd5\x15A#\x8c\x84\xd3\xcf\xce\x94\xf5\xfe\x8f|O\xab\x0f\xbdx\xbf=\xf6\x15\xaa\x1a\x95\x80\xfd\xee\xd1\xc9p\x8d\xe2\xc7&\xd8\x9f\x7f*\xdb\xed\x1c\x1d\x9e-\x860\xdc\xbbL\xf5\xa5^\xe2	\x059\xc85RH\xae\xa3(\xc6\xdfhq\x00\x80^\xf9\x9d\x7f\xedwN\xc0[\xd3z\xf8#n\xce\x8c-\x10n\xc8\x8b.\x08iT\xee\x14\xaf\x9b\xaa\x8a\x82y)\x0cw\x0f\xa3t1\xd8N\x15yS96U\xb6.d\xed\x13\xf5a\xf0\xd4\xfa\x9c\x8e\xeb\xa4#\x14\xda\x80\x07\x1c\x975-H\xb1\xa3\x0b\xcc\x98<\xc5\x88=:+6\xb8\x8amY\xef\xbc\xc71L\x14\x0d\xe8v!\xc5\xf5{\xa4\xf44{\x97\x18\xac\xa7i\xaaE\x17#\xaeH\x1el\x19\xf1\x8a\xfc.b\xb0\x9cQAc\x14\xc1\x13=Y\xec\xeeL\x97\xde\xb3\xd1NSJ\xc4!\xb7\x9f.u\x9f\xe8@\xd3p\xc6\x1b;#\x05\xfb\x0b\x8b!\xb9\x14$\x8f\x0b\x92\xa4\xec[\xdaI\xbaB\x8fTT\xf2\xa5\xb70\xb9\x915V\x81j#\x1d\x1b)\x90\x1b\x9c\x1dJD\\_\xac \xf1\x11\x85\xa8T\xbdw\x87\x15\xe4|\xde\xa7\xd7\x03;\x93\x9b\x15\x9c\xb8a\x02\xe9\xfd\xf4\xed\xcd\xab\x93\x13R\xd7E\xb9o\xfaM\x84\x1c\xe6\xa1\xc3\x08\n\xce\xf3\xc0\x89\x8b)\xdd\xb0\xe3{\xc4s\xe2\xa8Z\xaf9W\\I-x\x11\x8d$\xf2\xae~\xc3L\xfb\xe9D\x0f#\x16\xcc\xb8FY[\x97\xd7\x92\xd0ZD\x11\xdc\xc1\xe8\x0bju\xc1\x1f\xedY\xfd\xcb\x18m8\xfb5\xafk\xed@\xd5B\x0eY\xe5\xd6\"\xb7\xc8\x87k\x18\x95)t\x04M\xeey,\xc6\x901\xcc\x80\x9f\"(*\xf9\x9a\xbd\xe2\xa8\xda\x04l\xaf\xdc?\x95\x95\xaa\xde\xe5\xb5\xebce\x03\xc1F\x04\xe9j\xe9\xab\xe0\xc9\xd3\xc6\xb01\xb61\x8e1\x1a\xa4\xc8\xcd\xbe9\xa9\xda\xd9\xaep\xf8;1\xf3	\x9fu\xb9\xc3\x02\xb3t\xb4%\xfb\xb0\xc1\xfa~\x9a \xdbt\xd2\xd0g\xe7\x97%\xd24\x05o	`\xa2\xacw\xea\xcb\xa7\x823\xc1\xa2\x08k\x8f\x05\x89\x04\xdc\x0f\x84\xadJ\xa9\xf8\"F\x1b\x85\x18\xfb\xa5\x16s,I\xe6\x88\xcb$\x0d\xdcv\x0d-\x8a\xfc5\x86\x003\xa1\x1d\xe1-Q7\xa7\xad2w\xeb\xe1\x18\xcb\x820\xe2\x1f\xc6\xe4\xed\x86y_\xdf\xf1\xad\x17\xfa\xc04\x1buz\xb4%\xa0\xc78\"\x85\x1d\x80\xbe\xc5G\x1cW\xd8\xce\xe7\x91\x16\x93\x95\xe16\xdbn|\xf6\x9bh\x05UY\x05\x13\xd4\xe6\xc1\xf6\xfb\xbd\x8d87\xf6\xc0-!d\xbcP\xdc\xe4\xad!lobp\xa2\xa2\xd7k\xb2\xefxq\x8b\xa9k\xdf\xdd:\xa9cL:\xc5\xa3\x11\xb7\xdb\xffwn\x07\xa9\xbd\xf5\xa0Iu\xb6\xb8v\x02`\x7f$\x00}s\x98`\xc9\xe2!K\x16\xf7,Y\x04\x96\xc4>\x95\x13\x86\x96\x0f\x0d-\xef\x0d-{C\xeeg\xf0\x96\x9e\x95\xffS}\x8b\x03\xe5Di\xfd\x7f\x12\x8c\xb7\xb7N\x07Br\xb2M\x14\xfb\x07\xb28\xe4\xadu\xb9<\x86G\xa4_:\xaa\xd6\x86\xad~\xaa\xcft\x81\xbd=Vrk\xcb}\xcd_!s\xab\x9eUmKW~V\xe1\xc5k\xb5q4\xa2^\xe7\xee\x85\xd6}+\xb4n,\xb4\xa5\xfd=w\xf20%\xad\xa3N)} \xd4\xd1\xb5p\xfa7-\xf3J\xfd\xa6/\xca\xfc\x9a[\xc5}\xcfOQL}\"\xf2\xf5L\xf3L\x12\xf6\\\x95\x8e3\xd6\xc9!\x95\xbb\x0d\xaac\x03\x13->\xc2\x0dL\xb47&\x928\xb9\xccvr<Va=\xa8\xf0\x96\x846\x1a\x9e|\xb3\xe5\n&\x88	\xe9o;\x9f\xdf\xde\xf2\xda\x9f/\xb0\xc6\x12	\x8c0j\xd7H\xc5\x1f\xbeY4\xe6\xe1m\xf3!\x1a\x8b\xd1\x90J\xeb\x7f/Me\xf2\xa7\x12\x8d\xb1\x10?\x0b\xca~{ \xdf\xe1ju/p=\xa9\x16+8J\xefx\xeb\x90jwK5%\xf5nS\xe6\xba\xb4/\xfc\xad\x03`\xbb\xc3\xfd9\x1b\x8a\x11@\xc5\xdbx\x00w\x87fq\x87\xddh\x15\x9a\x99\x0c\x15zE\xe9\xd4)\xf1\x1d\\j\xa3\x12/d}O\x18\xe2\xd9\x868\xb6}\x1c#7B\x93\x1a\xab;\x11\xcc<\xe8B\xed\x88x\xd9\x96\xa8I\xef\x05\xa2\xd3K\xb8\xe2\x85\x1a\xdf`\xfa\xe5\x96\x9e\xcb]\x98\xc7\xece\xf3\xe3H)y\x172\xd0tm\xe1a\xd4\x17/\xdf[\xa3\xf2\xa3W}:\xd9D\xa3\x85q\xc1\xfb\xebH\x01B.i\xf4\x1d\x14\x0b\x7f\x0b~!*\xfa\x11\xfdV\x91\xe2\x94\x9f\xf97?\x1c\x86\x13\xe4`\xa8\xc4u\xe3\xfbn\xfb\xbe\xef\xban\x9d\xf4\xa7\xeb\xbb\xb4\xfb\xf6S\"Z\xfd7\x00\x00\xff\xffPK\x07\x08\x9d\x9d	\x9f\xce\x05\x00\x001\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00public/gologo.pngUT\x05\x00\x01\xef\xdd\x02]\xec\xba\xe7W\x93\xd9\x17\xf7}\x05\x02$t\x08M	E\x91\x10\x19J\x10\x14\x10\xa4IQ\x08\x10$\x884	0\x14\x91\xde;1\xf4\x16dD\x88\x88TA\x8aRD@\x90^\x1d:\"\xbd\x86H\x95* \x1d\x9e\xc5\xcco=\x7f\xc1}\xbf\xb9\xd7\xbc\xc8\x9b\xf3\xf9\x9e\xef\xd9\xb9Vr\xf6\xde\xd7\xda1\x18=-&\xfa\xcb\xf4\x00\x000\xdd\xbf\xa7\xfe\x00\x00\xc0\x08\x00\xa0.\x82\xd0\x02\x00\xa0\x12\x82e\x07\x00\x00\xeav\xcf\xc4\x13\x00$\x84.> \xbf<e\x1f\x00\x10\x04\xee\xab\xabb\xfd@k\xd3\x16\x0f\xcc\xb5F$\xe6<\xa26\x8df!\xe1B}\xf5[\x90p-\xc1\x00p\x8b\x10\xaa\xe1\x8e\xac\xa6\x10\xea\xf23\x1cX|:\xdd\xac\x15\xc2(\xe4@z\xe6\x08\xbem\xb0n\x94Y\xcc\x16}4\x1eXz\xe2\x9f\x01Q\xfb\xf8E\x08\xbd \xf1\xd7{Y\xe3\x92\x93\x8d\xeeTIi\x00\x00\xf0\x8c\xe7\xa8\xd5\x8b\x18\xb8\x9a\xc1\x00\x00<\xd7D\x81\x00\x00x\xcb\x86\x06\x00\x00x\x02\x86]\xa0\xcb\xff\xa1\xff\xd0\x7f\xe8?\xf4\x1f\xfa\x0f\xfd\x87\xfeC\xff\xd7P\x83\xec.\x80\xa9\x0f;0\xbd\x9d\xdek\xb7\xa3u\xa6\x14w\xde\xdf}\xe7\xf1\xda^XhC\xc0\x8fM\xdfm\x1a\xa0,i\xb3!\xe8\xd0\xfa-\x90\xe5\xb4\x03\x81?\x04\xb5\x91'\x1a\x03\x0d\x96}C\x04@\xed\xbdN\xb7\x8e\xe1\xcd\x11\x03T\xc0A,\x8e\n\xe8*\xa7\xe7'\x8e\x9c\x9d\xd56/y\xd6s\x99N)\x16\xd4\x01\xc0m!\x12\x80\x87G\xb14\x982\nV\xb9\xf3\x82\xda{M\xbdi\x06J\xf6\x84\x1c* \x7f\n\x04\x1c\x9c\xfb\xf7\x15\xa9=\x9d>j<\x86\x9f3r\\\x84Gy\xdd\x99s\x8c:[\xbb\x13>\xd6\x16\x0c\xe60&\xd0\x17t\xad\x92\xc6\x9d\xc4\x9d\x1e\xf1)\x9b\x85\xd4\x8b\xfeh\x9f\xd2\xc4\xb8\xef\xb7q\xa3\xae4\x83\x01FWo\xb8\xcd^\xa5~\xeb\xfd\xb3\x8fE\x05\x07\xcb\xcbto\x03\x8b6uu\xb2\x7f\xee\x99FD\xf0\xcb\xa3n4\x83\x815W#6w\xb1hR\xdbU\xaa\xd8anTFn\xe5\x16\xb9l+\xff\x8b\x8d\xd9{\x1a\xcebe\x01~>\x90\x8f 	\xc0O\xc35Y\xdd\xcd1O<\x12\xc39P\xe9\xb9t\xaa\xe15\xeb\xfe\xc47\xcd\x00\x18\x06\x94\xd5P*K\x1f\xfb2\xeaT1^\x97\x98\xd7	S8\xa8j\xad\x9c\x91U\x8b&O)\xcb\xf9\xe4\xe7P\xdd\xbapx\xd7;J\x88\x16\xb2R7r\x19U\xa3\x17`\x9ck<\x9e\xd9\x0d\x82\xdb8\x16\xd7,\xe7:\xd2\xfb\xa5\xe1\xa8\x80\x06\x1fv\xab6^\x9b\xa1\xf0\xeeq'\x9f\x92XB\xdf{:6\x17*\xd1\xd6`0GQ\xed\x1c\xa8\x19\x0c\x9c,8\x88?/{1\x96\xff\xb3R\x94\xde\xa1\xb7\xd9\xd4;\x95\xbe\xf4\xd7ti\xaf\x96\xe8\xf5\xe5\x0c\x1c\x15>\x83O\x9d\xc7}\x1e\x91P\"\xa6\xcc\x9a\xde\xde\x97g\xc3\xff}\xcdo]S\xd0\xf3Q\xbdKnQw\xbaz5\x18\x06\xec:pj\"]7&\xaf*1\xa6\x1f\xa3T\x84\x17YZ Fl\xce&\xd37~,_\x9afC\x03\x03qF\xdem\x85\xed\x8a,\x10$r<\x00\xf68\xd8\xf5\xa8\xbc(\xc7m7^\x02\x82\x00\x1c\xa6\x0d\xc2\xd9\xb8v\x15\xb5TT\xef\xcb\x90m\xabnI\xaeh\xa8\x0e\x81a\xc0'G#>\xb2KHU\xe5\xaa\xaa\xd5\xbb\xbf\n\x9f\xb4tW\x9bwL]\xcb\xca\x92'@\x01\xd3\x1b\x89(5i\x9dH7\x81\xcd\xbc?r\xff\x8e\x96!\xdb\x9aQ\xd1\xa7	\x91\x80,\x9ab\x15\xb5sH\x87\x1b\x9f\xc0\xe5`\x08\xee^\xe7v\x83\x076\xcc\x02\x8at\x88\xc4Q\xe1\xd3\xa0\xd7H\xdf\x14NE\xc6^\x14It\x08fe\x05\x10\xa0\x80\xe3\xb5\xe7\x82\xdd\xc1u\x9bJ\x01TNd\xd4\x8c\xac+\xd3e\xa1\xda\xf8\xfdW[\xc9\xab\x10\x04P\x10\xa3Bx\x10\x14\xbb\x9f\xcd\xa7\xdd\xb0 7#R\x9a\xc0\x0e3\x99\x9ew\xb7k*\x8c\xc1Q\xe1M\xd3\xdb\xacu\x04U\xafs\x95\x1f(\xef\xab\xfb\xcd]\x9b\xe1%>}\xae\xe1\xcdm\xc4\x86\x060\xb5\x94\x839\xfbg\xfa\x852\x9d\x7fj\x08\xaa>\xe9\xd1\xc7\x92\x05\xf8a@\xc4\xed\xf8\xa7\x97\xcfm\x93\xf4\xc9)\xb6G\xb2\xe5\x07\xef\xf4\x8bYB\x04z\x8c9\xec\xe4\x95O\x15\xa8*j\xa2\xee\x16b\xd1\x00&\xcd\x1f{\xcf\x81]+A\xa9=\n\xdb\xbbt\x94\xa5FH\x9b\xea\x0c\xfc\xdde\x98+#\x8a\x00\x90\x7f\x17\xbd\xca\xc2\x10L\xebcVc\x84\xf4\xcb\x9et\x8a^+\x18\xf8U\x7f\xa4\x15~^$\x82\x8c\x8d\x85\x02\xdae\x8aU\xeeR\xaf{\x1f\xfb_\x96\xf9\xf1\xa6\xef\xa0W>\xd6~5\x85\xc9\xf1]\x00!\x07\x82\x00\xbe\xca$j\x9cIfP%\xd4\x12\xa7\xbd\xb5\x14V}X\xbf\xf4\xb8\x06\x08@\xd2v\x94BX^\xe7\xde\x13&\x01\x82\xdd\x06\xd8\xfb\xc8\xb0|\xef}6\xbd)\xbfo\xc3\xaf:\xd9*\x0c\x8d9\x87P\x9e\x1c+\xbb$\x00\xe7\xb8\x03\xb9v\xc6\xaa=\x10\x93j \xb1>B\xac}\xafTp\x8aH\xcer\xef\x96_\xd5\xd7\xea\xff\xfa\xd6\x9a\n\x7f]\xbc\xf8\x9a\x92\xe9\x8f\xa1\xf2\xfd\xf2c\xbd\x1a\xe6\xab\x8a\xc3Z\x04~\x8bR\x8bP\x86\x17\x06R\xa0&\x8e\x87\xc6\xf8\xb3+\xc9Y\x9e\x8dmQ\x8f\xf9\xb6\xbb\xbd\xb8\x8c\xd8\xd5\xa0\x01{\xb7Ch\x89\xf5\xd4\xa4\xbfb\xa1@\x87\x0f\x8f\x11;\xd1\xb9i\xd6%\x97p\xaf\xb5\xe6\xc6\xe7K\x90\x86E\xbb+\x88\\k*\xbc\xd4\x9b\xf6\x1a\xa3l\xf0I\xb5\xf1\xa0\xef\xfc\xc0\xe7\xb7f\xbd\x86j\xd0\x03\xd7\xc6v\xc5\xbc\x96\xb8\xc1B\x12\x80c\xecsh\xb5\xe32\x90\xcf\xe6=\xdb\xc5\xdeo\xb2\x9b\x9ah^\xc6\xa2\x01\xd4'\x1b\xfbOV6\x92X\xbd\xb7\x18a\xd0k\xcf\n\xd6\\\x18\x1a@\xbd\xb5\x0d\xeb\x0e}\xe2\x0653\x89\x80iE:{\xd1I\x8d4\xde<\xbbm\xe5\xd0\xed\xbfz\xf7$#\xaaY\xbe\x0b\xd4\xc4\xba88\xf7\xe0%xmiI\xeeq\xd3\x16\xd1\xc6\x89\xe6]Y\xa6\xbe\xc1\x9dl\x7f\xc9064PV\xda:\xf6\xec\xd3X\x94\x15D$\xd2\x97\xdf\xb8\x92\xaa\xd5\xad\x825\xcc#\x99\x1a\x1f\x9c\xde&\xef\x9c\x0df\\\n\xab\x98\n\x93\x8f\xce\x90U\x83\xf2\x8c\x14\x1f\x8c\x7f\x13\x88R- \x018\xe3n\xbe\xe9jM\x16Mr\x15\xf1T\xbe\x16D\x916\x96\x82p\xc2\x00\xa7\xa7\xfcZ\xb2\xe1\xec(\xfej\xef\xb5%\xb4\xd0cR\x1b\x83\xac\x0f\n|AZ\xeb\xcfQ\xe2_\x92&_u\xc2\xf7\xf2\xf3]/_\xceVfV\x80\xa1\x81\xb2\xeb\xba\x12W:\xaf\x06\x08;\xdc\xd8w&\x18~\x15I\xc7\x89\x10=\x802\xa8\xae\xc4\x95\xfe\"l\xc0\xc6%\xc5\x15d\xc7=$\xa8Ff\xd3V\x84\x00\x052</30	\xf6\xff\xa2\xde\xd7\xa2\x9c\x17,De)\x83\xdfa\x18\xc9`3\x18\x1a\xd8\x12\xef\xf6\xe8\x99M`\xe5 W\xb2\xaf\x94\xa63\xe8e\xdfk\x81V\xe5t\x82\x01}w>d\xf2\xdf\x976\x1aI\xb9YA\x8b\x7f\xdc\xbaJ\x02\xe6\xb2\xfd\x93\xe3\x1bo\xdd\xc0\xbc\xcf\xccy\xa7\x96\xd8c\x80\xe1&\x83\xfb\xd9\xd1@\x99K\xc9386{\xf4\xf5\xed\x1e\x03\x8c\x08\x19<{\x8d\x04\xe0\xda	\x02\xab\x1e\xbc\xc6\x7f\xfen\x81\xd3\xec\x99\xb6\xad\xcb\xcbf\\I\x1c<a\xc7\xbf\xa2Q\xc6\xae\x18\xc9\x84\xae5\xdf\xc0\xd7Hk\xa9V>\x1c\xe5\xdf\xe7\xc8\xffH\xc3	\x03 \xc2Y.\xe7N'\xa2\x83\xad\xa4\\s:\xfa\xbd/\x89\x99+3\xc4\xecm\xc6f\xb0\xcal8;\xa6\xb1\xddTC\xf5i8\xfb@\xc6\xa4\xdc\xc8\x95\xf4\xb9P\xf9.\xd0\x80d6\xf8\x13Y#Ww!\x9c} ?\x83\x98]~\xa5\x19\xac\x92\x19\xce>\x90\xb20\xfc\xca\x9e\xa9\xfej6\xf8\xd3\xba\xb3\x8d'W\xa0G2u\x96\xb2\x1a\xd4a4\x91\xc9\xab\x9e[[E\xd9t3\xa95\x12\x0c\x03\x82\xd9\xb4U&\x1d\x1b\xd5\xb0HP\xb0{\x02\xf2\x9c\x8c\xa4S\xb1T\xa2\x17\xcezR**\x91\xa8\xc4\xaa\xad\xe2\xfb\xf0\x8e\xc7\"\x7f\xf0,\x18\x06\x9c~\x8e\xf6\\V\x99\x0c).s\xb4\xe6o.\xc4P\x1d\xe5^B\x10\xa0x=s\xf5\xdc\xebY\x99\x99\x87\xa6r\xd6\xb4b\xa3H\x91\xe9D\xb5N0\x0cXW\xe0\xd4V\xf1u\x94\xffv%\x19x\xd8G\xb9\xc7\xcd\xf7\xed\x84=\xcb\xb9{:\x8a%C\xca\xeb	\x9b\xd6\xed\x0d\xfa\x90\xed\xa2\x1a\xbcw\xa5\xec\x96\xad\x96(\x02\x98\xf0\xbe\x96\x8c/\xa9G\x9eLUa\xa8\xfc\n\x16i\x1b\xd6T\xdb\xc1*\xaf\xcdk\xe8\xde\x9fp\xec\xa8\xdeO\xd1\xea	\xd9\x0f\xc7\x8b\x9c,\xd3\x0f6@\x10\xc0\x84t\xa2\xe5\xa2\xe4\x1d3\xdc\xad\xd7\xd3\xdc>.\x93l\xd2s\xf2D/\xaf\x0e\x03f\xfcos\xa80\xfe\xa6d\xaeZ68\xaa\x84\x06|k\xee\x9e\x96=\x16\x0d|j\x7f\xe5<'\x15W\x90v\x90.w\x9b\xce\xa0w!\xfaR\xd9u\xad\xdb\xb7\xb3\xad\xa9\xb2\xf8Jhh2~]\xadq\xb4\xf6o[\xcb\xc5P\x8d\xfdE_\xacW@\x02\x1cZ\x7f\xd1\x12\x95\x15u\xe6.)_\xb3\x10\xa8e\x14\x9e\xf1\x93kb\x0e\xe1\xc4|\xd2o\x07\xab\x9c2[\x0f\xb2l\xa5\xc7KO\xda\xabAq,\xf6\x06\xde\x1a\xadX4\xf0\xdd\x99\xbfW\xc2\xd2\x9f\xd8\x14\x99\xff>M.\xa3\xd7\xee\xfc\x17\x0e\x7f\xc7\xa2#AIF\xbb\x1d\xac2\xdd\x17\xc1\xde\x94\x92_\x94&\x17\x1fY\x07\x1c\x07\xfc<\x1a\xa8*$\x01\x05\xa3\x1f\x93\xa673\x04\xca\xc2\xca\x8b\x11XO\xd0\xdd/\xc4\x0cS\x99{\xed`\x957\xcc\x11\xecM\xfc\x1d%i\x9fY\xb5\x01\xea@q\x92Cj,\x14\x7f\xd4C\xd1\xdbL\xcd\xe0+\x8b*/\xba\xd0\xdb\xd4$\xe6\xcf\xcah\xb6\x83U\x82%\xc2\xd9\x9b\x80\x8e\x824L/M\xfcJ\x1e\x82\xe4\xfc@\n\x84\xe1VHjz\x1e\xf9\xfeb\x11\xad:\xac\x1e\x06\x86\x01{\x8f6\xd4\xcfV\xfc\xb7\xc3\x7f2\xd1\xdamL)\xb3\xd4%6yB\x04\xec+\xc6y\xa9\x19\xd1s\xf6\xd5g\x9f4P\xaa\x7f,\x96\xb0Z\xf7Z\xd5\x89D\xbaq\xc7\xad\xf7\xffM\xfd\xbe\xf5\xce~9o0l\xb3\xfb\xcb\xc1q\xc9foke\xcd\x8e\xe0\x99yH\xfdr\xc8\xdb\xe3\x8f\xd1K\x8d\x9b\xf0wiq\x9dS\x1d\x81?E\xcf\xaa\x873}\xcd\x1e\xae\xbe\xbf\xcc\xcf\xafxp\xc3~OgX\xdd\x06\x0c\xfb\xeeRS\xa0\x94\xf8%\xc1J,\xd3\xaeb\xdc\xfd\xd7\xb2\xc4\xecR\xe3\xb5P\x16\xf4\xcf\xdb\\\xdb\xc6\x81\xb4\xac\x93\xa6\xe2\xbf\x13\xe7uxF\x1a,m\x88\xe3\xc3Vu\xfa\xc1*k\x93\xeb\x13A\x95Gi-\x01e\x01\x1c\xb2\xb2\x03tF0&64F\x9a\xe1J\xfe_\xc1\xae\xbf\x7f5\x9eF\xbd\x9b\xae;.\xe8;\xfc\\U\xfc\x88\xe0\x0f\xf6\xd4\xda~'_}\xe7xCq\x06RV\xfb\x89?#E\xc6\xbew\x840l\xb3\xd9\x9b_\x98\xe6\x10\x8d#\x98>)kO\x0e\xd16\xf7\xea\xcd\x9d\x1a\x8ej\xbe5\xdd\xe1\xd2\xdf\x99T\xe2\x0co\xdd\x9eH\xdd\xb7U\x08\x99r\x8f2\xbbi\xac\xbc1J\x18*\xa6\"\xca\xb9\xac\n\xaa\xc5\xc2\x869t\xbb\xccs\xa5\x04\x9b\xc1\x95\xb5\xbf\xba#b\xfe\x14\xa0\x0c\xa0K\xee\xc4A\x84$\x10b\xde\xfc\xdd\xf7O\x82\xfc\x12G\\S\xdb\xeb\x1dK~\x7fh\x19*\xa6],6	\xe7\xe8\x1c\x84\xb5/>\xe4\xfdXlz)\x1aG\xb8<\xfe\xc6J\xf4\xee\xcd\x88\xc9a\x8f\xed]I\xfd/\x97\xaa\xa5B+\xfd\xf8\x7f\x0br\x1d\x89\x14!\x8b*\xd7+\xb3E\xb9'\xbd\x05<\x8dt_\x9a\x7f\x91\x8b\xf5\xd7)3m\xbcRz\x07\x11\x83#L\x1cdu$\xdfx-*\xdf2H\xec\xae\xe4\xeb\x1c\xf9pd\x90~\xd8d\xfa\xd2B\xb4\xd5\x89\xfdS^\x9ev\x893_[z^R@\xbd\x84\x10\xcf;k\x02\xd41g\\\xd8\xb0K\x82\xc9+<7$\xb5|l\xdd\x9b{I]\xc5 b\x7f;\xbd\xaa\x90\xd13<\x97o\xf4\xe93\xf5M0\xc7^W\x89\x9b-@\x80:J%b\xd4\xecm\xf3\xcb\xa2\x05\xc5~R\xe7ue\xd5\xee\xf1\xdfS\xbc\x96\x85\xec\x0f>\xd1\x9b^\x08^\x85h\xf1J\xe4\xae\xe6U\xafF\xebB\x90\xab?V\x07\xba\xa0\x88\x82\xf6\x08KV\xb4\xc3\xac\xc2\xe9\xbe\xd5\xa0e\xe9O\xb9R\xa7\xec\xf3\xcfC\x8f\xd6\x8a\x03^\xcf\xcc7W\x12\x8f\xeb\xb4\xf8\xc8\x05\xa3\xd6\x1f\xfa\x13\xe6v\xfd\xb5\xa4\x1e\xdfj_u\xe3}\xad\x8d\xd5F\xa92\xa5\xc4\x8d\xcf\xde\xb9\x8e\xf8\xeb4\xae7\xa7Qi\xdf\x80s\xae\xf6\xe3R/\xe5\x8e\x84\x03\xff\xe8s\xd5\xb6\xdbZr\xab\x9f\xe8\xaf\xf5\xbd\xac\xddT/0M\xb4(\xbd\x93\x0f\x10\xa0\xda\xf6lm\xdfo\x84\x16\x8aS')\xdf\xd9v|\xcful_\xcf\xd2\xc6L\xb4\x1e}h;\x80\xea\xa0\xbf\xca_\xbb\xd3S\xb4\xa3'(\x15\x1dF\xaa07\xb2\xac\xdag\xbbfp\xa5\x19,\xec\xf9\x07\xc800\xf5\xe4\xca\x8e\xe6\xdf\x0e7\x95\xda\xe7\xb5\xe4w7\x16\xcb\x07F\x7fx\xcbf\" WG\xc4\x97:f\x11\x07\x9b(\xc6z\x97\x8e\xec\xd8j\xa7\xb8\x83i\xb5\x080,\xb9'J&\xdfK@\xe1\xaa\x89\x98\xcfjY\xb4\x0d\xaf@\xa0@4\xc8\x7f\xd3u\xf1H\xd5\xa8-9\xc5\x93\xd2\xc8  p\xe6\xc1\xf7,<\xd7\x96\"\x1f\x8f\x19\xbe\\\x89>\xdfU\xa8\xd5#@\xb5m\xed#\xaf\x8b\xe7|R\xfc	\x89Z\xcd\xba\x1d\x14\x8bQ\xbb\xbf\xbbeNwZ9I\xa7\xaa\xbex\xbc\xa1\x0d\x0d\xdc\xe6*\xf7\xe9r\x9c\x9a\xe2\xe5\xb4\\\xa8\x8d\x9f\x1d\xc6j\xa2T\x0b\x95\xd2\xd6^\xdd\x83\xca^\xff\xec\x9d\xefTt-\xdf\x9d\xc7f\xea)K\xd8q%\xeb\xbc\x8d*\x88\xf43\xae[D\xa6\xd7F\xe8\x83\"\xd1\xf3\xe2I\x16.n\xeb\x8c\xb4\xb5<\xda\xb8\x1a\xffuc\xe6=\xd9v\xbc\xc4F\xc2C/\xf0\xf2\xce\x01n\xe6n\xcc\xee\xbc*\xab\xc1|`\xcf^\xbb-,-\x85|M\xd4\x08\x1b\xf4\xb2\xd5\x9dH\x0fE\x00\x1e\xd7\x93$\x8c}\xa4\xbf?\xae\x84\xeb\xfd\xf9;f,bz\xce\xa3\xf0/d\x1c\xc5\xc1\xd6==W\x8d\x00\xc5+j\xbf\xed\xfe\x14m\x9f\xebo\x9e\xf1\xb0%\xe6x\xac\xf2\x8fl\xfd\xb6*\xe6\x1c\xd3\x16\xba\x0f\xbfN\x8aR\xd5\x93\xc2a\x0bn\xff\xd3\xfaj\xbf\x8d\xf3\xe4M\xc0\xac\xab-\x16K\xa5?R\x84\\uT\xa2z\xbb\xaf\xeb\xbf!$\xd3\xabD\xf8:\x14\x0e[8\xf8\x9b\xf6\xae\xc5\xd9\xe01\x00\\\x93\xe7\xd4\xe6X\xba\xc1\xf5R-\xe6F\x10\xf1\xc1\x94\x06yv\xc9{\x0dw\xfeyD`%\xe7\x17f\xfe\x1e\xd2 \xfd')3\x9c\xdd\xf6KLf\xe6\xb7\xa5\x0f\xfc\xf0}%\xa0\xd2@\n\x84\xba3\x1a\x96\xa2\xf5\xc4\xabF{\xf2Y\x9d\xc5\xd3G\xe3\xab.\xe93\xb2\xb6\xfe\x02V\xb7\xf60\xf2I\x9b\xb72\xa8_\xb2\xa2\x01\xb4\xfe\xdd\x1a\x8feG\xcb\xa8\x9e\x10\xb1}\x16B\xf4\xc8\x1f\x8e)_\xeaC9\xb6\x82\xb7R3s\xa9\xa2\x8e \"\xbfN\x13E\xbf\x06@\x10@~\x9ei\x0e<\xee\x805_\xa6\x96\xb9\x1bXi\x05\xcf>\xd1	\xbe\xea\x93\xbd\xaf{)<\xdf\x99\xe483\xefN\xa8\x8f\x0d\x9a=\x06\x00\xf4\x1a\x16i\x00\xd6\xfd\xb1\xea\xc9\xa6\xf0\xbc\x91\xf1\xe4\x8d$\xbf9y|'p^\x1di\x00\xce~\xa2 1\x1f\xc9k\xf4\x97<\x11\x0c\xdb\xc3H\x81P|\xc3\xec\xb6n;\x9a\xd3\x91\x9f\xc5\xe7\x8fTy\x9a-\xb1:?`\xda\x9f\x9e\x98\x1c\xfe\xca\xdfW\xbd\xe7&\xd0\xcc\xca\xf9?\xdda8\xfb\x9eB\x8cq\x99\xae\xdc\x95h\xbf\x0c\xc7\x98 \xb5\x18\x9f7X\xbfSw\x86\xc7\x9a\xa8\xc1\xc9\xa4:\xd1B\x12@\x8a\xfc\x07T\xa5k\n\x8dw\x86\xe4\x83\xd9&\x0d\xc5\x93t\x90\x83J\xbd(\xcf\x13\xc3\xd2\x94\xdb\x05\xffj\xcc\xd5b~\xa7w\x96\x13)cl\xc7p\x81\xd5\xff_\x17\xf5\x1ab\x18\xba\x19,\x88\xf0\xb9F*\x08\x8b\x85\xe2\xb7\x0bS\xbez\x0b(\x18\xa9A\xfd\xd2\x1dc\x14\xd5b\xce\xc4Z\x87F\xebYZ\xc2K(\xf6\xf1-\xa7\xad\xd4T<o\x1cc\x14\xe3\xc6\x0c\xb8\xb5\x9b(X\x9d\x1f\xb7\xd9\xb4\xbd\x96\xae\x85\xc8J1]\xc1\x08\x83a\x00EA\xaa\xe3\xfa\x9az\xcb\x8e^\x82AZ\xe6K\x92\xef\x8a\x04\xd1\x0b\xf5\xe6\xae\x8d\x85R3\xb8\xe9\xf3\x9a\xe6b9\x0c:\xeb\xf3#\x05/\x93:(\x14\xa1\xf4FkS(\xff\n\xa9\x00\x8f\xa3\xc2\x99\xfb\x08y\x9e8\x8a\xb0g\xd8\x8e\xde\xea\xac\xd4\xbe\xfd\x9e\x05!\xfc/Q\xf4\x11\xf24\xefp\xe1\xd8\xfaDm_j8\xa55\xcb\xfb9v\xd68^\x1fe\x00j\x067\xf9\xadE\xa27G>\xb7jbu~\x0c\xc4\xa7z\x870\xbf\xfb\x9f#\xbc{\xfa\xabl\x87\xcf\x03\x979|o\xcf\xfa\xc4\xbb\x03\xa3Y^\xfeG\xb7Z\x82\\1\x9a\xfbW\x17\xef\xd0#H\x00O\xd4N\xeb\x90B\x8c\x06\xff\x96\xb3H	*c\xaaR\xc8N\x91\xdc\xb3\x97\xfd\x02s\xd3\xe0\xf3f\xa0\x00 8\xad\x1a\xc4D.\xca\xea\xbcN\"\x8d\x04\xf6JX\xd9\x10\x15t\x9ff\xabE\xb8\x1e\xc0\x1b\x1bg.\xbf5\xe0\xe3\xaa\xd2\xfb\x01jVW`\xbe\xea~\xa7\x8aAm)Db\x8e\xbe\xca\xc68\\ouD\xdebl,\xe9\xf0\x89\x87\x08\x91\xb4\x1d/\xdd\xdc\xb6\x0c_@\xa6\xe7\xc2\x9d\x1f\x9d\xb5\x1c\xfb\xa2\x1d\xd4\xa0\x01\xc6jy\x04\xc7\x98'&\xbb\xad\x8b=\xfaCGo\xd3\xe2\x98\xa6j\xb2m\xbc\x88\x1e\x1cz(\x83\xc6\x05*\xca\xdd\xcd0\x80fp\xd7\xc3tS\xe6EXB\xefz\x97\x9e#y\x8c\xd1\xeck\xc9o\xa77\xb11-P\xc4\xaaOA\x12{\x90p\xa0}\x97\xfc\xfc\xca\xd4kj\xab?\x8a\x84\xf3\xbd\xf3\xafH\x9a}\x8e\xcd\x8d\x89\xa7T\xba\x8bO\xfaZ6\x04\xad\x043:\xdeL\xb4P[R'7\x9e>\xafc\xad\xdb\xf1\x94\x7f\x95)\xf3\x91\xf0Al\x94\xa9\x94q\xfc\x9a\xcbKb\x05\xf5\xcb\x01\xcaQ\xc9\x02\xb7\xb1\x02'l\xefh\xb9\x95%h\xe1\x96|\xb2\xdc\xae\xa7\xfc+}5\xe8XW6\xc6\xfa\x01\xd1\xad\x8c\xe1\xf4^\x87\xb0\x98\x18	\x97\xa3g\xdf\x954g0\xbe$\xfd\xf2\xbe~k\x8d\xf3X\xc6\xe1\x93\xdb\x04C\x9f=\xf9\x19\xe5\xf8\xbb\x991\x04(b\xb5B\xb1\xf1\xccl3\x0c\xd0\x1f\xb0\xccaY\xeasn\x1d\xe9\xd1\x1fbK\xff\xc8\xa2\x8b\xea\xf4\x1cXex\xfa\x0c\x97#\x11\x85\xf8\xc3\xef/\x1bx\xf0#\x07?\xa8\x99\xb4\xb2\xee\xd2\x03\xb7\xae\x0c\xf8\x12O\".\x07G\x88	\xe8\x11J\xf6\x1b<Vi\x82\xc1)\xcb\xad&\xc9\xb5T\xaf\xa5\x95u[\xa5\xa4#\xe7\xe5\xe8\xa6\xd9\xd0\xdf)\xe6C\xa2\x07\x88N\xb9\x89\xa5\x00\xccX\xc2\xe1\x93\xcbK\x04C\xda\x1cGk\xb8\x96xC\xc1\xc3\x8b\xff+\xd6\xfaUC\xe3z0\xeb\x1c\xef?\x16\x9b\xd4[\x8c\x0c\x12\xfc;\xa1=\xfa/\xdcz\xe2\xaf<\xc9\xd0\xf6\x05\xc3\x16\xccB\xe6\x0e\xeb\xf7U\xf1\xf6\x7f\nP\xcax\xe5\x1b\x02\xef\xf84x\xb0Z\xf5&\xb1d<\xdf\xc8u\x84\xd2zq\xbf\x96(\xca[\x89\xcc\x85\xdb\xbe\xd6A\x93M\x18:\xe5j\x05\x93\x0f&\x15}\xec\xce\xf9\x86\xbd\xd4\xa0_\xdf\xe5\xc4e\x1f2\xa2\xeaZG\x1ect\xc00[\x85\xefk\xec\x0dO\x86\x1d\xc4vx:\x9f\xf1%\x0c^O:\x9b\x14-;\xcbKv\x85\xb8\xc1\xe8rY\xd1\x8c\xfdKE\x04\xf9.\x83\xaa\xd7\xcb]\n\x9b\xc1\x82sl\xc6\xe5q\x19\xf5<^%\xa1A\xdao\xcf?\xd2\x0b\x83\xad0\\\x9c\x16\xf1\xe4\x8e\x82o/6\x1b\x82\x0e\xe7\x9fQ\xaf\xb9\x1cf\x1f&l\xf3\x0e\xb3\xf8\x08\x9d\xdb\\\xd2\xae\x93\x98;\x06\xce|\x8a\x15\xd3\xd9\x82\xab\xba\x95?o^\x0e\xbc\xd3\xeb\x96L\x8dS\x16Etx\x8b'\x1f$\xc2\xb2\xc1\x1c\x7fKIw\xbcu\xb4\xf6\x1bq\x8e`G\x03kz\x02\xa4\xed\xebIg_\xc6\x851)\xeci\x92C\xb7\x82\x11\xcb\x918*\x9c\xef\x83}w\x93\xdd\xf3\x05,@\xab\xf1\xed<\xb2\xbc\x90\xe7-/\x01\x8a\x9f\xaeAx\x99s\x9d\x86\x1a\xe9\xa4sm\x05\x03\x96\x86\x7f\xb7\x8d\x81a^wQ\xa02\x05\xa6\xfe\x8e<S\xeb[\n\x86\xe2 \x8dZ\xdf\xb3L\xa8_\xe2 S3\xb8\xc9e7\x95'\xd5\x06^1\xde\xect\xeb8\x0f\xbfI}\x90\xe6\x08\xcd\x1f\x08\x1c\x16\xe4cC\x03k\x9a\xa11\xee{\xfc\xda=9\xa6T\xc8\x17\xf2\xf48\x95\x8bc \x88\x8e\xe1\xe3\x9cP\x13\xf1\xed\xaa\x8b\x0d\x13\x1f*\xce\xd1\xcb#8\x96\xae\xa3\x0f\x98\x0b\xbe,)U2Sz\xcfP\x1c\xb4\xe0\x91 e\xe8\x91\x1e&\x01A\x00U\xd5u\xd7\xc9y\xee\xbc\xbd\xdeK\x0cR\xc3\xec\x98\xaa\x990C|3\x14\x01T\xbd3\xb56\xaf\x97<2\xd2Q\xa9\x99\xea\x00\xa1\xa8\x9a\xc1M\xbf\xd3\xf5\xe1\xc6\xfd\xcd\xe1\x8eP\xa4\xd9\xe6\xa9+)C\xf0\x9fW9U\xef\xac\xad\xcd\xef\xd0\xc1\x8a\x84\xb3\x9c\xea\x8d\xbd\xa4\xee\xdcA,\x13pT\xb8@\xba\x0e'\x99\xd1\x84\x86\x12\xdd\x82\x14\xfc\xafB\x12\xbe\xb6P\xe5z\xf2\x05(\x8d\x1fw\x08\x98IP\xbc>\x17\x08d\x8cx\xba\xd1\x7f\xc0<{\x80l\x067\xf9\xec\xa6\xf2\x18\x97*\\\xea~\xfdX\x8f\xe1@	\xffN\xbeC\x95V\xe0\xed\xca\x85\x9d\x82'\xe5f\xd1\xe3\xb0\x18[\xe4v\x0e~\x93\xda/\x0dG`\x1c\xe1\xf3RE\x81\xca\xf8\x12$;+H\xbdv\xbe\x1f\x1a>\xbbb\xc4A\xde\xach ^\xfd\x024\xb7\xf9au\x80\xbco\x96\x04\xb1\xcb.C4\x17G$c\x89\xef\x96pT\xc8\xd7\x8e\xd0\x8f\x90\x7f#U(M\x18u\x08p\xda\xf2\xce1\xa5*\x9a\x8bUu\x08{^\x8e\x99\x0d>\xfc\x95\xb7/\x85\xbf\xc2\xd7\xb6\xf1\xce\xe3\x9c<\xdd>f\x17\xeaw\xf3	q\xe9\x1d37c\xf7\xfe^7U\x03\xcb\xdcd\xc8\xf2':\xe6\xc3\xf7\n\x91{\x16:\x92\xf9\xc2\xb3\xfbJ\x00*k\xa1o\xa9f\xba\x7f\xb0\x8e8'\xb2S\xabg\x1cq\xe3-,\x1b\x8c\xbd\x8b\x04\xa1\xae6\x83\x19m\x0e\x9f\xed\x85\x95\xc3\xdf\xd8p8\xff\xfc`:\xff\x1e\xbf	\xf2\xcb\xe8H\xfd\xb3\xfc\xca\x8d\xf7\x1a:\x92\xf97\x03\xd0M\xa8\xa0\xa6\xe50\xa1\xdb\x8c\x0b\xf7*\xc1\xd8\xdc\xce3\xd9d\xd5\xd6u\xab\x15NCT3xm\xb3M/DV\x8c\xb5\xc3sr\x95!\x90\x80\x82\xad\x92[\x19M\x9c\xa2\x07\x98\x9a\xc1k\xfdt\xf1+\x7f\xa6\x0e\xd1#:\x86\x86\x88?\x92P\xb0\xa2\x1ck\x82\x18!\xc6\xea\xd0D\x82\x84\xa3\xfa\xed\x99\x0f\xff\xf2\x07\x17\x84\x91\xdc\x86T3\xc2\xa2\x9f/4}e\xe9\xea\xf7h\x06\xc3\xca\xbe\xf8\x9b[\xfe\xed\xe5\xa5\xe1j,\x99 \x99\x1a\xd7SM\x13$\x00$o\xbe\xb4W\x97\x04\xc3\xca\x14\x8c\xc3\xf4$\x15Hn\xc9a\xc1\xf2G4{\x06g\xba\xb4\xb2\x1e\x8c\xa5\xd5\x93\x8dEWI\xf8\xe9\xb1\xbd\xb5\x17o\x18~\x973V\x11\x13\x04\xb1Z\x87\xb2\x97\xe0\x02y\x7f\x10\xa0c\xbd\x11\xee:V\xee\xf7z\xf5\x8cL@eW\x9a\xdb\xb6\xde\x0b1 H\xf8\xf5\x95\x82L-y\xf1\x9bC/h\xf1\xbaPD\xa4=\x9f	\xaa\x19\xec\xb49E\xca\xdbf\xc8\xb3\xf8\xa0<	\x1bf\x07\x9c\x1c6\x0e\x96\xe7>\x96\xa6/]\"@\xc7\xfab,9\no\xe8l\xad\x99\xc7\x17\xef\xfd\xd2\xd6\x95\x04\x8d%w\xa4\xb6V\xd4\x18\xc95\x83\x9d:\x83\xbf\xf9\xcf\x88\x9c\xefO\x7fm.Ia\xe8I\xd5d\xc13A\x11\x91\xef\x1a\xd5\xc9`X\xd9\x9b\xc4\x8aq'\xab\x1eD%|\xd3\xb7o\xc9P\xea\xc2\xbc\x95I\xaf\xb5\xbb\xc1#\xc3>\xc8\x15\x8f\xfbC\xd6\xa7\xcf\x8a\xe3\xc3\x999\x9fC\nC\x13\x173v<A\x0de \xc7	\x03\x92\xb3\x86\xf9\xf9\x8b\x84\xb3B\x88\xee7\x84\x87\xd9)\x9f\x1d\xb75*\xd5Q \x80\xf2\xe8\xe1\xb3\xba\x9bU\x98;\xcd`\xc3o\x96\xd6~\xfb\x1f\xea\xb3\xb8\xa0\x08\x00\x07?\xca\x01\xca\x12l\xb3N;\xa2\x99\xf4(\xdd\xf4\x90\x8b5\x91\x0e\xce\xb4,kB!QX\xb1\x0b\x00.\x05\xdeWW\xc5\xcauH17\xab\xd3\x80/\x0e\xa9\xe4\x87\x89\xa4\xa5\xaaJ\x83ah\x0d\x94\x14\xf3\xa9\xb0\xa8 	\xc8\x02\x7f\x98\xee\xca6\xa5\x9a\xb8B\xc2\xd1\xd3\x11\xdd\xe35\xdb\xff'\xcf\xc3\xcd\x0fpf\x0fp4\x83\x0d1\xe2RD[Jw\xbfxC\xd9\x08\x04\x01 \x9b\x8b#3\x08\xf9\xa9\x03)\x0fK\xa5\xf3\xc5B|\xe3\xefV\xaa_\xd4\x1a\xc99\x11\x16\xf7\x8d\xc3\xd5\x02\x1f\xcb\xf8M\xc5\x7f\x84\xaf\xe8\xc8\\>\x15\x16\xbdB\x02\xb28\xc4\xc1\xb0\x00\x1d\x95\xd7X]\x14\xa2Q\xa1C\x88T\x10\x8e\xa3\xc2I\x87\x94'l\x16<\x1b\xc5\xb9C\x9d\x1d\xadBr\xd0t\xa2\xeb\x9a\x0bH\xad\x7f\xcd~\xc7\xc6\x84u\x04\xd8\x02\xa1\x86R\xcd\x8f\xc0\x13\x94\x1a\x97\xbd\x88\xca{(\x90\xcauG\xcb\x83I\x83\x08\x9f\xc3C;FO]I\xad\xbc\x89\xca\xab\x04(\xa0\xbd]\xf4\x8b\xd6\xb3\x84#\xdc\xa7\x1es\xb3\x19\xa2G\xa9o\x97S\xba<zQB\xde\xf2u}\xafiOfI\xd6\x1e\x06\xdf} .U\x12q\xc6\xee\xcd\x86\x06\x9c6\xfa\x1e%\xf4\xb5\x08 _\x1e\xc6\\)L\xb9\xb8\x15_\xb2\xa1\x01\xcc_\x94\xd8\xcc\x8e%\xaa\x06:\x9c\xa2\xab\xa8\xb2\x8b\xa6<V'\xbe_\x8a\xd8ua\xb5\x14\xb6t\xe0\xb8!m\xe5K;\xf1\xce\x91p\xa5 \x85's\xf6\xef\x8b]N}&\xedO\xbe\x9b\xdet\xa78\xdepx\xdckd\x91l5\x8e<\x94\xed\xb7\xc6V\x13gG\xb4P \x95'\x8e\x8f\x0f\x04:	\x10\x87\xa1r\n|me\n \xf6I\xfdS\xec\xdf\x12	\xce\xd4\xec]\xfa\xac\xacU\xb8o\x0b\x9ae@\xd5q\x17!\xc6Z\x0dN\x886\xe6\x84\x98\x7f\x15$\x92\xde\xba.J\xdf\xaeb\x9c\xa5b|mt\xdc\xe9\xf7u\xa2\xdfg\x17\xae	\xb7y\xf9\x8f\xb9\x0c\xd4\xb2\xb5\x81Z\xae?\xaf\x91\x80\xb1\xf4\xc5\xd4\xa1'VZ\xbf$\xb4}\x12mR\x9d\xfcK\x0e\x14,\x15BO\x13mnp\xcc{\x9f(\xb6\xde\xea\xb3\xab\x18\xd7\x11\xbau{\x98\xfd\xe5\xd1Fw\xaad}\"\xa8\xe9\x16:m\xe8P\xf5%\xfd\xd3\x94+\xfd\x92\xb4\xe1ju\xbe\xd3Bfr\xe9\xbdv\xa1\x97\xcb\xee\x9f\x14X\xe7\xe8\x89\"\x80\xaa\n\xd4\xe3\x17\x06\x12\xbd]\xe5\x18BK\xba\x03\xe1o.8\x99\x14i\x03\xe7\x84\xa1\x01\xa7N\xc9\xba\x15\x08\x82\xd4\x1e\xa5\x1c\x17\xa0\xbd\xa3\xd6\x0e\xe65}\x18X\x7f\xe3\xd1\x0b\xb7\xf8\\k\x02\x14\xbf.g\x8az\x93\xda\x02\xb5\xce>lQ\xc1\x086\x83\xfb\xe5\x1f\xac\xdf\xbcn\\\xfe\x10\xfd\xef]\xfaI\xec\xe4\xd8\xe7r\x02m\xb6\xbad\xf8\xbb\xa0\xd6)\xe6\xb3\xb7.\x1f\x06W\x11$`\x8c\x98\x0b\x1f\n\xe0T\xd0\xdb\xb5\x1f\x17\xdb\xf0\xed\x7f\xd3\xf2\xe6\xe7\xcdf\x186PW\xa7R}\x01\x0c\x03&\x93:\xf47\xdfWs\xc77\xb0c\xef\x96B\xf9=K&\x9ez\x14\x19\x8a\x8a\\\xec\x8d\x8dY\xa9\x7fxs\xf0\x8dt\xef|\xc1\xd7\xc2\"\xe8H\xfa\xf2\x1fR5\xdd\xe3N\xbfk*F\xa7\xe0\x17\xa9\xc7%a\xfc\xc7Z\xc6~\xebp\xd6\xf7\xc5\x88\x1b\x1e-\xbf \x13taR\xf2O\xc3i/2\xd0\x92\\\xe7\xeec\xd1\xd4\x8d\x96g4\x88\x97\x01\xc5\x1f\x8b\x03\xbb1\xf3E\x17\x1d\x82\xeb\xd4\xa4 	\x18\xcb\xa0\xc0\xe5\xfb\x92ud\xf5+\xe4\x1c\xf3\x1ds,\x8b\x1b\xe4\x16KWV\xee\x0eC.\xcc\xaf\xed\xc8'\xebT\xaf\xabYBE\xf7\xbb\xa2\xdeJ\xf4\xdaX\xe0\xce\xd2f\xd4\x86\xba}\xfe\xcdoW\x13=Vn\xbd\xe9\xa8\x88\x988\xe5\x12>\xddUE&\xfd\xbc\x80A\xfb\x0b\xdd\xa93\x9d\x00\x8e\xdbwp\x1f\xbf2\xdd\xd92k\xfd\x96I$\xad}\x904	\xc1\xf4\x1a8\xc3\xb4\x7f\x1c\xab\xce\x96\xfa\x14\x90\x80\xb1\x84\xa2\x18E\xc9\x97:\xb2\x94\x81m\xf8\xe5\xdd\xae\xe3Z\x7f\xb5\x98\xa0T\xb5\x98*\x08\x02\xf0\xc9\xedW/\xb1\x9c7\xbd\xbfZ\xb9\x8a+5\x81\x8b\x07\xf0\x0e\xab|\xd2B\x1a\xd0\x92-m\xc00\xe0\x8b\x81T\xd2\xe6\xd5\x92\xd4\xb7Jj\xd0\x00\xb7\x92Yu\xcfpv\xdb\xcfU\xccO\x13\xf9a\xc0\x17\xe9g\x8b\x9e)_.\xd0\xc7h`\x18n\xd4\xd8\xfa\x19\xa4\x16Z\x18\xa4\\h\xee\x9c\x18\x0b\xc5O[\xf0~\xf1\xea\xf3\x18\xd5@\xaa\xba>n\x0b\x0c\xd9\xf8\xdb\xd8\xf9\xa6/T\xf8\x97\x05\x83\xf0\xc4\xc5\xef\xf1\x8de\xbc\xad_\xf1%;\xcep\xf6\xddU\xa3\xb5O\x86\xeflK\x9f+\xf7Z\xb4o\x1d7\x18\\\x14\x0f&\x0e)\x14\xd9\x84\xc5XDr\x96oi\xae$\xb3\xb7\xbc\xef\x1bw\x03\xac\x8e\x0c:\x11\x8a?\x0d\xfamU\x8cT6;\xe1\xd6B\x82B\xbfp\xe4<\xb4\xe2\x7f\xcdR\x1a(\x10\xdd\xb2,>\x88\xe2\x84\x01\xc7\xdb\x0c\x1f\xc5j\xe7R5\xa0^%\x93\x19\x96A\xd93\xf4\xfaq\xd5N\x05A\"\xc9H\xa2cL\x07\x14\x01\xfc\xa6\xc8i\x99<LX\x0c\x87\x1d\xea\xbdTyw\xdc\xf8\xa6\xf1\xec\x01T\xa4\xabr\xcdr+\nUy/@GF\x0f\x05*\x13X	\xf6\x7f\xdc&Q\xef\xb1\xa2\xd8\xcdb\xd1\xb8\x7f4\xcfP\xaexc\xeb\xdc\xaeb<x\x93\xda\xa0\xc0V\xce\x9dRE\xb4\x86\xb7m\xee\xcc\x06\x08D\xab\x05\x7f\x9cq\xcc\xc4Q\xe1\x94}\xbb\xf2\xe2\xf6T\xe5\xa4j\xc2\x0e\xca\xf6\xaa*F-\xd2\xda'6r\x8aJ<\xeacY\xcc\xe8\xeaY\xd4?\x0e\x91\xaa\xe1\xd3\xc8\xd1'\x9b\xd4\x03\xd4\xcd\xe0\xa6\xb3\xe4\x1f)?\xc3~\xf5\xff\x0do\x9f?3\x12)\xad\xfes\x89-\xado\xed\x06\xd7\xa6\xd1\xc9\x94\\\xc8\xadz\xa3\x97m\x9bD\x17\x8e\xf5\xd3\x89 \xcd\xd6\xa9.PYc\xab\xdcm\x95\x1a	\x9b\xce\x85\n{\x99Xi\xe1\x81\xf6Gmt%{\xfb\xb5\x93a\xab[O\xc3\xb5\xe37e\x16\xc5\x95\xb9m\x03\x9d\x94r\x0dD\x11\xc0o_\n\xdcw{\xc61V>vx\x90)[]\xbe\x8f\xdf\xa4M\xa1\xcf j\x96b\xb2\xa2 \x95\xd1J\xaa\xc0\xde\xffN.?\x8c\xd7\x84\"\x80H+\xb2e\xc3\xb0\xbfK\xb0H\xa4\xfb\xea\xb2\xa6\xfbF\xe6\x98\xbb\xef\xd4{~\xd7\xf9\xa5\xd5\x82\xfdg<c\x8a!\xccg2\xf6F\x94\x874\x06\x96`\x18\xd0Z\xb6U\xda\x1a\xb7\xac`e\xec\xe1\x1b\xd5g\xcb\xfd\xb3\x90\xd6\xdd\xb8\xa8\xb6\xe8\xd7\x8d\x92\xf7\x8a\xb2\xfaL\xf3b\x01\x1c\x0e]f\xdcL\x92\nB$\x80\xa7#aR\xbe\xe8\x17\x82\xab\xa8\xb3\x9e\xa5\x8dk\xb1\xbc\xe9\xdcp\xff\xe8\xaa\x85\xb2aM^Q\xb1Rc\xdb&\xd7\xcew\x17=\xeb\x8cs\xddh\xd37:	\x81\xc7\xb9\x87\xc2Mnz6\xdc\xafj\x83b\xed\xdf\xfd\xdc\x1f\xd2\xbb\x9a\xecg\xb2*\xfbu-\xca~\xa6\xb5;D\xf0\xfc\x16z\xb8\xf6\xf1qVo5\x9fFe\x8c\x10\xaf\xf7\xb7\xad\x8f\x86\xa2\x08 \xd2F\xeb\xa3^\xb1\xf4\xb4}W~\xe3\xc7Z\xaa\xdd0L\x8e\xc3\xe6\xad\x83\xa6\x80wE\xc5\x8e\xa5B\x8fj\xa3Y\x9c\x83\xd6\x91>\xe3\xe7\xac\xdaX\xdd\xd6n\xbd\x8b/\xef\xc9\xfde\xf3\xfb\x92F\xf2\xf1\x8c\xfc\xe8\x91\x00\xd7\xc1\x9e_\x11y\xc1F\xe6\xaf\xe2Y3P\xbb\xb1\xdeU\x95\xd3V\xa9\x8aU\x8b\xd7\xef\x8e\x16\x90tMe)\xf6\xcf\xcem\xd1\xc5\xd5Ge\xbe\x83\xe3}\xbd\xf7\x83L\xa6F)\x99f\xd8\xa7\xbdBA\x9e3\x0d|\xa9O{\xf9\x02o$\xd6\xacbo\x1aKs\x0d\x9a\x8f\xbb\xe7\xfds\x8c\xaf}\xf6\xf7\xf8vS\x95\xc1U\xeb'\xfcq\xc7\xb0\xcd\xc9U\xf3M\xb7\x0f?\xfce\x8c\xde\xd6\xef\xb7h\xd0\xc9\xaa\xf3u\x04y\x8b\xe1\x9e\xf6\x95\x16	\x1f]4\xc33&p\x86,\xf1L\xeb\xc1Ym\xc9$\xca\x81G\xc4\xe37>\xd3\x16\xdf\xc1\x15\xceq\x95\xf8\xf9\xfc$\xe3\x00V\xcf\xe1\x98\\\xdb\xde	\xe1?\xf3\xa4\x08P<\xd9\xe4\xa0\xda\x99\x8d\xdc\xd6I\x7f7\x96R)\xf9%\xc1{\xd2\xdew\xbb\xcb\xfd\x8d\xc4TIDa\xa5Q\xd0\x87\x96\xe8\\\x07\x0c\xfa\x0b\x9c<\xa7\x0c\x86\x01\x94\xcfe\xb0\xe9\x08\x8d\xa0\xaf\xc7<\xa1)Ue\xb7\xa3#N\x1d\x9fI\xc6;\xb4:\x8b\xfd\xf0\xf5\xe6H\xa0Z\xf9y\xa7B\xc9\xf8\xe5\xb2\x83s\xc3\x83K\x83,Zr\x07,\xcd\xe0&9\xb1\x93c/\x81\xb8\xb3\xd7\x95\xa5\x85i\xf6}\x94\xd6U\xab\xbas\xe6\xbdx\x87\x91D\xea\xe4\x95\x9f\x11C\xd5?I\xc5\x0fw\x86\xbf\xeb\x86,=\xf5\x1cvl`O\xff\xdd\xc9\xc6|\x96\x8a\xa3\xc2)v\xe5\xbd\xd20j\xd7\x0db5?O[\xdfz6\x87\xe2\xda\xdd\xa9l\x14\xd0\xb4\x0dgy\x15\xec\xc7\x1d\xec\xf4\xbbR\xb1\xf4<{\xbf\xf9G\xe8\xb42{\x013\xdb#\xc5\xf4b\xe6\x88\xfcp\x8d\x93\x8d\xd5\x95\xc5?\x1aTQ \x14?C(\xee\xde1?\xd9\xef\xde0\xf8\xf5\xe7P7\xf4zy\xd3\xbc`\xc3\xc8\xf9x`\x9c\xeb\xf8b\x91\xcdmA\x12\xc0\x93\x1a\xa1\x8c\n\x97\xba\xdc\x06\xd5\x7f\xc6\xb8I\x990>\xb0<Gq\x00\xb9\x97s~\\\x9es\n_,\xe2.\xe7eF\xbd4\xcb\x06'\x1aH\x81PJF\xb0&%\xa5v]\x1a\x1b\xccM\x10\xda\x96\xadWb\xdfyF'\xec\xe21Ul\xd1\xf9\xdc\xd4\x08\x93#_G\xd1\x04\x0b\xfb\xfd\x95\x0b\x07(\x86\x12Lk\xab~\x84\x92=b:\xc8&P2y\xce\x0c\x0c\x03\x86\xca\xb6\xe8\xaa\xf6$J\xf8\xf8'5\xd2\x8fiy\xc2\x1a\xd8\x15\xbb\x0d\x98\xf1+b\xfd\xd8\x86\x1b3M\xb4%\x14\xb1\xdf\xd8\x94\xf3\x0f\xca\x8a<8\x9c!\\+\x17$\x8a\x00\xf2m\xaa\x81\xb16\xeb6\xbbT\xfd\x9aX\x14\x18wOK\x0dz\xeb\n	 \x11#\x94\xcbb\xae\x1fi+.\xb6\x0d\x81hw+x\xe5\xc6.\x9a\x1cS\xae\xb3\xa6j\xf4\xb0\xf1\xbc\x94\xef\xf6,\xdd\xd8\xe8\xf1\xb2%5\xef\xa0\x0d\x15.`\xa9b|\x8d\x9b\xc2\xac\xb8u\x9d\x0c\xe9\xbc\xbb3F\xf6\nF\x96\x15\xb3/\xde\x10\x15\"\x01\xa4\x19E\x1f\x84%O\xa0\xe0\x14\x97\x17W\xe0\xe1\xb3yK\x9a \x01\x95`\xed\xb4\xee\x82\xe8\\80t\xbcv\x8f\xae\xcc\x8e\xcdS\xed:\xc3a\xe2\x11\x19Px\x04\x11\x1e{\x89\xa3\x12\x8c\x8aP.\xf3x\x80\x0b\xfb\xfc\xe2\xe09\xa8\x0c\x9a\x0d\x0edC\x03?\xca\xb7\xe8B\x12\xb2>\xcatS\xec\xec\xa7\x95\xf9p\x86\x9d.s8\x01\x02\x14\xaf\xc8u6P\xdd\xed\x10iV\xe4\xf9\xd2\xa2\x896D\xafN7Z\xd3!\x04\xfa\xdc\xb5\xa6u\xab\xcb\x13_\"\xc6\xdb\xc6\xf7p\x8b\xc0bM\xfc\xf6\x9e\xdb\xaf\xa7\xbf\x84\xc6\x16\"\x8a\x00~\xf1)|\x03Y\x98x\x7f(}\xd6%1w=U\x0d\x1ay\x95\x04|\xb5'\xb3\x9c\"\xd7i\x89\xbab\x89^A\xa2\xe7\x93`\x84\xd7J\xa4T\xe4\x0f$\x9d\x8a|\xca\x92\xb4e \xff\xc0{\xa3\xd1\xa3\xcb\xbcm\xbdB\xea\xdeo\xa9Y\xb5Q\x17\x95\xf5\x82\xdb[\xea\x89\x87\xf3\x95Y\xaf\x8f\xe0\x9e\x1c\x03\xb2\xd9\xe0\\V4\x90\xdc\xda\"0\xb1\xe7\x99=Jp\xb11\x19\xde+\xc9p\xe5\xe6!a\x08\x83r] L\xc6\x82\xff\x86\xa5\"\xb7CGi\x84%C\xed\x07\xdb\xa6_\xf9%\xae\x97\xc6\x86\x8a\x9e\xb8\xea\x9e\xba\x17D\xe3\xa8\xb2\xd0G\xba*\xbc\xa6SV\xe9\x9dI\xc9\xa94A\x92M\x07\xe1\xec\xf1z(\x10\x06\x91\xb0\x98\x15\"\x9ezG@\x13\xefH$TA\x85I\xe1\xfc0\xc0\xf6\x08\xce\x00\xca\xd2\xfb\x90\xd9\xfe\xf8!\xfa\x9f\x1e\x9dR\x13\xcf\xfa\x00\xd9\xbc\x0c\x86\x01\x0b\xb51\x91\xfe*\x16\x01OX}\xb3/!dtru\x10\x0bH:\x95\xc3\x94\xb8\xf3\xea\x0c\x186\xbb\xb3\xbdN\x9e~\xe2j2\xce\x83\x00\xc5\xdf^&\xb0,\xfc\xf4\x96RN\x0c\x81\x95\xd2\x1f<\xc6\x9fB\x84\xef\x91\x91t*|z9 NL~\x06\xf7Nq\x86\xdc\"\xb2`R!\x1bl\x82E\x03\xdf[u\xf90\x08\x85\xf8P\xf9\xe8\x99\x8b\xf5\xfe~w1\x86\x07\x05$\xa0\xa0\xd3\xd1\xd8\x83\xfcJU*\x9c=\xf4\x8b\xb4\x96\xaa7\x97\x1aT;\xcb\x9a*K)\xca=c\xb2\xe4\xda\x0fvm\xc5>8\x8d\xf7#u\x92\x1f\xc5?I\xb7\x1d\xac\x12\x9c\xc2\x10m\xd3\xcf\xf4@>\xee\xf4\xd3\xeb\x832\xea\xe4\x9f\x0f\x91\xaa\xf7\xc10\xc0\xbb6\xf6\x9b\x0e.\xa0;\xb3^\x9ar\xa6+\xbb\x88D\xa6`\x08\xf3\x10\x04\xb0\xeamEfy\xe1\xc9\x15\xe8\xb9\x88D\xf6\xdb\xc2\xab\x8f\xd6\xc61\x7fm\xdea\xc2\x17}P\x12\x0c\xde\xe2\xb1\xc2\xf6Yov\xc0i\x16X\xdf?\x10\xe3\x84\x01{\x15\x7f@)\xb5\xdc\xdak\xb6\xf2Z\xa7C\xce|\xcf\xed\x0e+\xf6_`\x08\x7f\xd5\xbc\xa4\xce\xe2\x13\xf1\x11\xb6r+\xae\x18\xeb\xca\xd8_\xaab&\xcd8\xcb\xf44H\xffNF\xb7\x83U\x14$\xac\xb5\xdaJ\xae1\xb6\x9a\xacY}p\xf9\x89\xd2j\x96\x91\xa0\xd8\xeb!U\xb7\xc10\xc0\xb9\xe6\xe3V\x94\xea\xc9\xea\xed\x01\x83\xf7-1\xc7\xb4\x96\xbc\xc9\xd6lZ\x8a\xfa\xe0\xe2\xef-\x06\xcc\xf8[\xd7_EN\x8bYK\x9d\x0f\x1e$8\xf2{~/\x8e\xb4\x14\xfa\xf3s\x82<Z\x1f^\x94cM\x95\xa5\xdbM\xf3\xb7\xaa\x18\x06\xdf\xfb\xfe\xe8>D\xb8c_\xbe\xe3\xa8v\x8f\x9b\x1eV\xfb\xbd \xd5\x14\x8a\x00&l\xc9,\xaf+\xb22g\x1c\x89\xb5k\xeb\x11\xadM\\\xee;	\xb9\xbcX\x1d\x14h\xe0\x16C\xa8\xdfL)\xd7n\xa1\xde\xfc%`\xf2\x0b\x91\xf2Z\xb4\xbe \x0dG\x95\xc5\xc2u\xb6UiK\xdd!`_1^S~\xdf\x97_\xa1\xe1\xf2~\xcb\xe2L\x8dw\xd8C\x87\x10\xe8@\xea\xf0\xa1\xbf\xae\xd4\xca\xf4\xc7\xd8e\xecKuwf\xca\xaa\x8d\xae\x14\xf9\xc9e\xf3opN\x18\x10\\\x13\x1b\xd1\x98O\xda\xed\xba\x19`kf!rT\xb8\xff\x0c/R\x177KQ\x83\xa6]%\x01\xcb\xfdo\x1a`^\xab\x01\xceZ\x19\x1e\xe2\xd4\xb96\x9d\xfds\x8dS\x1d\x83\x15\xd2\xadjq`\x18\x10|\x04W\xe8P\x0e<\xf3\x9a7\xb6//\xaeXS\xd8~\xd1\x00\xf3ra\xdbI\x15\x85\xa6\xe5w\x82\x81>\xaf\xa1\x1b\xf6\xe5?e\x13\xe5Wu7\xa9U}Kl\xce\xd8\x0b\xbe)r\xc2\x80)\xb9	\xd50\xae>kY\x9bS\x87\xa8Uh\x80\x9b@\xf5\xf7\x82_9\xbc\xa2\x08\xe0\x91/\\\xe1\x85\xcbz\x96\x9d\xcf\xa5\xc9\xbc\x96\xb8\x91\xfa8\x1bt\xda\xce7NN\x18\xf0\xfe\x98\x87\xe1\xfci\xaeZ\xc4CY\x971[\xa7Q\xdd\x1e\x83\xcd\x00\x01HZE]\xccb\x94\x83\xcb\xf5\xe4,\x05\x02\x14\xe07\x9d\x9e\xc8vJK\xab\xcb\xec\xb5\xa3s\x99Z\xee0\x99\xc2\xcc\xad\xda8\xfa\xf2\xea>N\xa4)\xdaE\xa3@M\x86}F\xfa\x98g?\x0f\xf0\x01\x8e\xa9\xe5\nE\"\x05\xe3\xc1X?\xf1\xd9V\xe3\x8ck\xf5\xff\xcc\x13\x14\x89 \x87O'\xca\x99z\xc5\x8f}\xb8\x84\xb7\xd4\xb0\x8ee\x8e=\xc5-\x0c#\x0e!\xd0\xa6|\xf3q-(\x97Ao\xae<<Q>f?\x92\xb6!\xb9c\x8afV\x90\x04\xe0\xcc\xb4\xd80u2\x1e\xe8\xb5oIL\xb7P\x89\xa2\xb1\x96\x8c\\4\xac\xf7\x9ah\xc10`mUL\xe9\xda\xc4\xab?\x9dR\xb7C\x86x\x88\xce\xef\xb5\x17,yo\xb2C\xae\xe1\x18\x08P\xe0\xb7T\xa2\xc6\xa8Y\xeeW\x08\x98\x9dQ\xf5%\xb7!\xfb\xbd&f0\x0c`$\xa3\xb8\xac|\x10\x97\x08*\x04\xa9\xf4\xb6cm\x95f\x9e\x0d\xca\xe9\x9e\x81 h\xf2\x9fq\x90\xdcG$(r,B\x85p\x1d\x82\xe4\xf9\xe6\x8d\xfd\xf1\xd9\x8a\xfa\xf7E$\x8c\xda\xef\xc6\x93?\xda\x7f\x84 \xc7:m{\xccJ\xfe\x88\x04A\x10\x00\xcf\xd0\xfd\xa0\xc2\xac\xb9+\xb6\xfd\xc9j*\xcd\xf9\x1bB\xf1\x9fQ\xae\xcde`\x18\x80nfcy\xa8\xd4\xder\xd6\x15\x8d\xe1Q\x15\xd5\xaf\x89g\xdd\xcbe#\xb8C\x10\x00\xe9\x9b\x81\xd8\xc9\xf4\xfc`\xeaq\xa5\xf4\xd8\x91\x16\xe6\xf6\x0e\x87N\xf5\xd3\x9d\x94U\x08\xcf+\x1c\x15\x9e\x91\xae|K\xf9M\xaf\x9d\xa4\xe23\xbbq\xdb\xb7vtO\x8d8\x88\xfe\xbbd\xa5\xc5b\x86z!\x9c)\x01\n|\xbc\x9e\xe9\xd2]t\xb7~\x86\xed\xd3\x0f\xb9\xc5B\xd9\x0cGO\xae\xa7\xa0\"V\xce\xbf \x08\x00\xd9\xae\x8a\xc5\xa4\x17\xe4\x14\xf4\xad\x9b\xf2\x90\x0bh\xc5\x15\xa2\x9f\xcfc95\x86\xc3\xd90<\xcd`@\xf86Q\xd2\xbe\xae\xdb\xbb\xbe\xaa\x80[s \xb7\x15\xf2 1\x82\xc3\xc8\x0dMM\x8f \x01Y\x7f\xeeT>W\x83z\x97\x0c3S^\xda\\\xde\xe2\xbc\xa2\xf1J\xe9C\xaf	\x15\x16I\xc2Q\xe1M{r\xcd\xceD\x0b\xdc\xa6l\x82\xaa\x9f\x1a\xb1\xf9\xf0XIG=\x95\x0d\xac\xbba\xf7\xa26Ap\xbe\x8b\x8d\xa9 \xeeB\xc6W\x12\xc1\xc1u\xde\xfa\xc8\xf2\xbbea,\xbe\xfeCQ\x03q^\xe7f{Y\x8f\xc9\x00\xa4\x19\x0c\x88\x93J\xc5O\x12\xc1\x9c\xbc&\xea\x9cmG9\xfc<\xa3\xe4\xb0\x1a\xed\x85Q\x9f\x12\x9b\x92Z6u\x95\x8bl\xfd\xc9\x81\x13\x0b/9\x1d\xdf\xa1\xc3\xef\xb1\xce#\x1a\xd2\xab#\x83\xe8X\x16E\xb3WgKF\x17\x8by;\xff\xcer&@\x81\x00w\xd6\xb8\xf3a:|\x18mw\x117\x0b!jxv]T\xed\xceC\xd5v\xc7\xbe\x80\xe9\x83\x8a/D\xf9U\xf2\x14\x8d\x97:\n\xa4\xb2I\xcci\xb1\xdeS\x9d\xcb\xda\x1a\x98\xbf}Vgb\xd6\x13\xea\xc3A\x9d\xe6S\xf6\xd0\xa2\\\xc9\xf2%\x7f\x89\xc4\x88Z\xe40|\xcfm\x83\x8a\x15\x0d4\xc9\xbeW\x02zF\x94xz\xf8O\x8e\xc7'\x1a'\xf3\x19\x8f\x8f\x92B\xab\x1b0\xe7uUY\x8d\x9e\xbbT~-\xe4\x89t\x99P\x94r\x82w\xbc\xf3\xcd\xd0;7\xf5\xa1\xda\xcf>@\x10\x00\x90\x15\\<g\xbd@VV\xb6\xbb\xe3\x87!\x03\xf1\xff\x1b\x8f<\x8c\xca\xa6\x03\x00\xe0\xe3\x85\x04\xc0\xcb\x10\xa0\x00\x00\x08&\xe0\xa8\x00\x00\xb8'D\x02\x00\x00\xf8\xf6\x1f\xfa\x0f\xfd\x87\xfe_Fs\xe7\xd56\x9d`\x00\x00 ak\x99\x13<\x81\x82\xb4\x00\x00<g\xfbg\x9aZ\xf3\x9f\xf9\xe9KR\x17\xb7\xc5\x9fm\xe0\xff\xc0\x7f\xe0?\xf0\x7f\x0c\xc8T\xf1\xbdh\xfbr\xf5\x8d\xb0\x8f\xfc\xc5\xe2}\x0d=\xf5\x125\x1c\xe1\xff\x0b\x00\x00\xff\xffPK\x07\x08Y\xc6o\xd9\xd5)\x00\x00\xd59\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00public/highlight.pack.jsUT\x05\x00\x01\xef\xdd\x02]\xac:\xedr\xe38\x8e\xff\xef)\x14\xde\xacB\xb6\x18\xd9\xd9\xd9\x9b\xda\x91\xc3v%i\xeft\xaac\xa7\xc7N_\xcf\x9d\xe5\xc9\xd22ms\"SZ\x8a\xca\xc7\x86\xbeg\xbf\x02\xf5a;\xc9\xde\xaf\xab\x8a%\x11\x00A\x00\x04\x01\x90L\xe7\xc3\x91\xb7\x96\xabu*Wk\x13\xfeQx\x0f?\x87\xa7\xff\x11\xfe\xe4Y\xefb\xf2\xe9G\xefZ&B\x15\xc2\xb3\xdeJ\x9aPf\x9du\xfaG\x91\xd6\xc0\x0f\x9d\x7f;Z\x96*12SX\x90\x97\x07\xae=\xc5P6\xffC$\x061f\x9es\x91-\xbdG\xa9\x16\xd9\xa3\xefWok\xdf\x10\x14\"]\xfa><{\xa8T\x0b\xb1\x94J,\xd0Q\x83\x16Oy\xa6M\xd1\x17\xb8\xfe\"\x91\xf2}\xacB\x90\x85	\xfc\xb2%\x145r\xec\xb8V||\xbfz\x87|\xb3h\xbe\xf1tF[\xb9\xc9\x8b\x16\xa6\xd4\xca\xab\xf8m	\xd9\xe2\x16\xc9+\xa5\x06l:\xa3%\xbbqr\x87\xf7\xe2\xb9\xa0#\xf6\xb2\xa5+x(\xd6\xf9\x1d\xab\xec\xa4\xdf\xda\xd1\xe6)\x97\xca\x1a\xf1d\xc8\x0f\x1dI\xc7\xac\x13\xcfS\xaeV\xb8\x1f\x95|%H\xff\x04O\xe3\xc7\x93Y@\xe2yGR\xc3:\x18\xff\x8e\xcf\xa6\xbf\x7f\x9c\x05\x1fml,	,\xeeG\xb1\"\x84tV\x1b\xaa\xd9K\xc2\x0bq'U!T!\x8d|\x10\x11J\xae\x10M\xc5\x93\xd8\x88\"B)\xa2I\xa6\x0c\x97\xaa\x88P\x82\xe8\xbdx~\xcc\xf4\xa2\x88\xd0=\xa2E9\xbf\xe6j\x05CG\xa8\xb8F4IyQ\x8c\xf8\x06\xb8\x8c\x10\x9d\x8b\x95T\x11\x9a\xd7__\xda\xbe\xf3/\x88\n\xb5\x88\x90p\xef\xe2\xbb4\xeb\xaf\\\x0be\"$\xbe#*\xd3T\xacx\x1a!\x89\xa8xJ\xd2r!.*^\xe2\xa2\x85\x0c\x1c\x83\x01\xa2\x95\x9dk\x02}\xd1\x00\x1c^;|*\x1e\xb8JD\x844\xa2\x0f\\K\xaeL\x11\xa1\x07D\xaf>\x0dF\xb7w\xe3A\x84\xae\xc6\x88~\x1b}\x1a\x8c'\x977\xe3\xc1\xdd\x0e\xf1\x0d0\xa3o\xc3\x8b\xc1\xd8\xb5GcD/\xef\xf6\x00\x97\x00\xb9\xb8\x1a\x9d\x8f\xffk\x1f|\x01\xe0\xf1\xe0nr{>\xbe\x1d\x8c'\x0e8\x9e\x00\xed\xf9\xe5\x97\xc9\xf5\xf9\xe4\xf3\xdd`ry\xfe\x15h\x07\x88\x9e\x7f\xbd\x99\xdcMn\xc7W\xa3_\xee\x867\x9f\x06\x11:\x9f\x0c\x11\xfd\xf5\xdb\xcd\xed\xe0\x10\xfe+\xc0\xbf~\x1e\x9fO\xce\xaf\xef\xbe\xdf\x8c?Mj\xc4\xd7\xefC\x90\xed\xfaj4\xb8\xbb\xbc\x19\x0eA\x85\nsy}\xe9P\x17\xd77\x97_^\xe3.\x00\xf7\x19\xe49D|\x06x\xadQ\x05\x19\x0d\xf7t\xaf{\x03\xecP\xfb\nq\xe1\x88'\x93W\xe4\x93	\xc0\xc7\x83_\x06\xbf}\xada\xe3!\xa2\xb7W\xb7\xd7\x83\xba};<\x98\x8a}\xd47\xc0\xd5RF\xe8\xb2v\xac\xb1\x88\xd0|\xec\\	>\xc5\xb8\xf5 h\xcaq\xeb\xcf\xd0L\xc7\x88\x1a\xa17Rq\x93\xe9\"Bf\xbf}\xe7\xdc\xd2\x0c\xd0\x96\xce\x19:\xeb\x149W\x1f\x11]\xb3\x17\xe7\xdb_\xb5X\xca\xa7\x08\xc1\x8a>A\xd4\xf0\xf9X\xe4)OD\xa4\xca4\xa5e!.\xc6\xd1\xd1)M\xebeQD\x0f\x99\\x\xddm\xafY\xfa\xde\x1dD\xb4:4\x88PW\xddq\xc7\xef\xac(\xf2\xf9&\xef!\xb2\x83\x9e9hj\x0e\x80\x1f\x1dp\x05\xc0m\xcbvq\xc0Ve\x0b\x01\xeb04\xd9u\xf6(\xf4%/\x04\xde\xa3~\xc0\x82\xaa*\x06\x19&|_\x84\xe2I$X\x91^\xcd\xc2\xf8~\x971fB\xa9\x16\xe2i\xd71\xdf\x1bF\x85F\x14\x06\x8b=\xbei\x1b\xae\xa9\x81\x18\xa6\xd9\xb9\xd6\xfc9\xccuf2\x88\x9f\xa1\x0b\xf2a\xc2\xd3\x14s\xbd*7B\x99\x82\x9e\x92\xde2\xd3XyRy\x82\x98\xa9\x9a11U\xb3F\x18\x1d.3=\xe0\xc9\x1a\xefg\x85\xf7zl	5;i\x86\x8d4\x9cM[f-V`EM\xc5\x06h4S\xe1R\xea\xc2\\\xaee\xba\xe8\xe9\x9ef:T\xe2\xc9L\xe4<\x95jE~d\x0c \xd9B\xdc>\xe7\xa2o\x82\xba\xf5\x9f<-E\x98\n\xb52\xeb\xe8\xf4\x80\xc8\xf71\x0f\xf3\xb2X\xe3\x17\xf1\xe0\xa2[a\xb86\x88f\xcbe!Ld(PF\x1a\xc4f\x02kj\x08]`M\xc2\x0d7\xc9\x1aw\xe6\xda\xae\xb5\x95\x9b\x95\x95*/M\x87X\xfb\x86_\x96\xbf\xc3n7\x8f[,h\x97P\xbe\xb3\x8a\x04\xab\xc8%\xd6\xbe\x7f$B\xf0\xd4\xf3\\\x8eEa2-\x16;{T\xa6}\x8dgG]\xaa	\x98\xda\xf7\xb1\x98\xea\xa9\x9aU\x96'=,\xc2\xc4\xda\xe9\x8c\x84I\xa6\x12n\xb0\x08\x1f\xaav3{\x92lwblpB^\xdaV\xb1\xef\xbe\xe0\x8fEV\xeaDX+v=\xb2\xcai\x1b\xef\x13\x8f\xdeX\xac\x06O9\x86\xce\x14mP\x80\x930\xb9\xea#\x89\"\x84H\x80U\x1f\xad\xdc'\xd9\xb6\xf5\x84\xa7\xb0\xa1\x95\x01\x8eL\x98d\x9b\\\xa6\xa0\xb6\\\xe2]\x13\xb44\xe1=3\xe1\xbd\xb5&\x9c\x7f\x81V\xe5J\x1a\xdc\x9a\xb3\xd6\x11\x1d3\x18\x16\xec\xc1^-8BEX\xe4\xa94\x18y\x88\xbc\xeb\xc5Um\xd3\x92YDzz\xaa\xa6\xdd\xd9\x8cM\x0dU\xd3\xd3Y\x7fTn\xe6Bc\xf8&\xd1\xe9lK\xb6=T\x18-\xd5jW\x94\x98\xf0\xbe\xcf1\xaa\xd33r\xf2F%\x86\xd7\xbb\xc3r,\x80f*f\x04\x9c/\xbcgzk\xc2t\xcc2l\xc2\xd4\xdaN\xfc\x18t\xe8Q\x97Ppb0A\xf5b(\x8e\xe7\x18\x05\x00\xd9W\xed\x8fL*'}\x80H\x1c\xcf\x11\xf0\x9c[\xeb\xbat\xe2\x0b\x1b\xcf;\x0eT\x0d0\x87o\xa1\x16\x13\xbe\x11\xe7\x85\xcb\xdc\x8e\xbd`\x0d\x0e\xcc.\xbe;\x06b\x9fA%\x8d\xa8\xd8\x08\x02 3`\x85kX\x8b@q\xf1\x1d\xfc\xc7\x0c\x1c\xa5\x19\x04\x0c\x90}d+\xa7\x00\x8c\xeb&\x1d^V\x9c$!\x14\"8D<\xed\x10\x9a\x9d\x02U\xe2DH\xd8t\xe6Zo\xc2Y\xe5\xea!\xcf\xf3\xf4\x19\xca?\x13&\xe1\x86\xe7\x07\xb6\xae\x1c\x16+\x86\xa0\x14E\x8c1\xd17\x91 \xe1\x83\xef\x1f\xa90\xe1\xc9Z,\xee\x9a\xba\xc4U\xa0\xaf`L\x85\x0f\xff\x82\xad\x97bE_\x1e\\\xfe\xd9RA\xb6\xa0\xc9\xeb\xfe\xd6*g\x96i\x8a\x15\x99Y\x0b\xb1\xd59\xde\xd6\x99\"y\xd7E\x14\xb8H\xe5\x1d.j\x15\xbe\xafp\xf3M\x05q\x1c$\xfb\xd7\x1a{\"\x9c\x7f\xe9\xa38\x0e\xfb\xb8\x1f\xa1@\x84s\xe7\x1da\x1fE\"\x9co\xdb@1\x85y\x82)\x99\x11\xc7\xaa \xe1R\xa6Fh|\x91e\xa9\xe0\x8a\xf4Lh\x98\xac\x83l?\xdb\x1b\x0c\"B\x13\xb2\x0c\xeb\xc4S\xdc\x8f\xa6\xbf\xc7q<\x9b\xd98\x0e\xc9\x87xfc\x1c\xf7\xfb6\x8e\xf1\xf4\xf4\xe4\xe7\xd9\xb4{\xf2\xf3\xec\x03\x01l\x87j\xd6\xa5\x9c!D%\xeb\xf6\xe4Y\x13\xc7{2\x08\xaa\xb5\x990M3V`1\x95\xb3*Ku\xcf\xc0ux\xc0\x14\xe9u\xcf\xb2\xa6GE^2S\xa5\xd2\x8c\xf4\xe4\x12W>U\x92\x17\x1e\xb0\xac7\xd7\x82\xdfo\xe13,\xcay\xb5\x84q\x97\x96U\x92%4;@\xd4\xe0\xa0\x9cvg\xf5 \x84\xa28F\x8c\x01h\xda\x9d\xf9~	\xf1\x81\x07\xb0*Q0\xa9\xfa\xd5\xe1\x02P$HH\x04\xa2B\x07\x8ap\xdd\xd5\xf7u\x10\x90\xed\xb6\x9e&\xbe\xc5\x92\xc2\xda\x85\xf5\x1e\xbd\x80\xf8\xd1;\xdb\x18p\xb0\xedv\x8b\x93\xbd|\x7f	\x13@3\x97G\x1bX\xe9`\x86\xea&\xf7\x1e\x9fA\x15\xe5\xb9\x02\x8a\xa1\xe3\x00\xeb>B\xd1:\xdc\xab\xa8\x9a|\x05\xb2\x8a\xe0\x18}<&\x81\n\xb0\x01\xc2\xf9\xdex\x05&/\xab\x80\x81,Gl\x10\x16\xd7\xfd=Aa4\xc1\xde\x84F \x83\xb9\x10\xbe\x7f4\x9aBkFj\x9d\xee\xf0\xb8rc\xc5D\xff\x12\x03\x8e\x8e\xe9Q\x97\xca\x9a.\xba\xc1c\n\x9f\x8d\xeb\xc1w]\xd3\xb59\xb6{6pAc\x110\x15\xea*b\xd6\x0c\x98\nM\x96\x13Zb\x1565!U\xe1\x03\x94\x0c\xf4\xe8\x14\x0c\xbe\xc5$z\xadDe?\xca\xa9\xa4	\x88~4\x08\xef\x0fd\x06?\xd4\xe0\xb6\x8au\xe9 L\xc7a\xca\x0bs\x05\xfe\xc2\xba\xd40\x07r~8&=\xd3#:`wx\xbc\xe7\\\x8a\xd6\xa5\x1d!\x94\xb3\x01\x95\xcc\xd0\x84-!}J\xf0\xb7\x834\x16\x01\x88b\xc1xx\x1f\xaeyq\xf3\xa8\xbe\xea,\x17\xda<\xe3\x84\xf8>\x0f\xef\xa7\xc9\x8c\xf4\xc1\x02bz:\xa3:`%\x16\xd0\xe9\x0e\x9biwF\x08\x89\x9c\x08\xaeA\x15;\x14\xf9\xb5\xc0M\xd9\x17\xecd\xc6\n\xf6\xcf\x84\x8e\x19B\xaf\xca\xcdU\xc0D\x98\x8c\xfa%\x14 #\x8a\x90sc\x84\xe8\xa0\xd9X'Zp#\xb0\xa0/y\xb5\xc9|q\x13\x10\x0d\xb6\xdb=\xdf\xd2U0\x91K<\x0e\x98\xa8\xd3\x81j\xcc^`B\xbb\xbd*\xca\x1cF\x1f\x07\x83\xd9r\xb3bX\x97B)\x994q\xc1\x9c\xe9\x9e	\x02\"\x97\xf8\x01\"\xfb\xd4\xcc\xc2\xf9\x98\nB\xda2\xda\xc1\xde\xa4\xc3\x06>f\x98\xb3\xb6ce$A\xc0\xb8{%\x10\xdfm\x0f\xa6'q'\x8e\x7f\xff\xe1C\xd0\x0f1\xb1\xd3x\xf6\xb2\x9d\xc1\x96!\x8e\x7f\xf0\x91+\x94H\x95$\xa6f\xb6\xc5\x8a\x0e\\\xa82\x8d<&,\xeee\xde\x1f\x07LE\x90:/|\x1f,\xa2\x08\x05\x1b\x98P_\xb8\xe4|a-\x1e3E\x08M\xb1\xa9\xe0\xfdn\xa4\x1a\xb5]\xad\x04\x1e\xcc^\x17\xdc\xb5!\xc4\x98\x1aR\xc5\xee\x9e\x02\xed\x8b\xea\x04\xc0\xf7UXMS\x8f(\xd6~7\xc6\xdaBT\x0d\xc5\xf7FZ\x81\x1b\x12HVx@\x95\xd3F6\xd1{\xe0f%\xd9\xd7)	\xf5\xc0\xda$\x14\x03P\xa1\xd5\x0c\x00\xa0*\xe8\xd4\x1b\x84\xc9\xc8\xf7\xf1*`s\x02\x01\xe0^\xe6\xd6\xc2\x92\xb6\x16\xbc|\x00\xeb\x1c\x0f\xd8\xa0\x1e\x9b\x1c1&[\xb1\x1bae\x9b8\xb1|;\xbf\x0d\x16fX\x86b\x0c\x86l`\x04\xc4\xd1\x83=\x83\x82\xde\x9a)\xb7X\x8f2\xdf\x7f\xc0<\x94c\xaa	1k\x9d=\xbarx\xa0u\xa6\xf1\xf1U\xb5\xf7\xf5\xaaM\xaf\x87\x8e\x03\x15\x1c#o\x99io\x93-\x1c\x00\x83~\xd6\xa2\xb3R)\xbe\x11\x8b\x8f\x88\x04\xc7\xe8\xb8\x95\x1c\xacB\x9b\xa1\xad=\xdd\x821\x97l\x82\x85\xb3\xee\xd1\xf2\xed\xa8\xdf\xd4\xbd\xca\x1e\x95\xd7\x84\xb9\x08\xc6\x11\x15\xd7\x0d^Vq\x96\xd3\x013\xd6.\xa9\xac\x8e\xb9\x10r\xd3\xc3\xd9\xa0\xc7\x8f\x18[\xf68\xe3\x8dIy=\x05\xac\xc4|\xb7\xb0\x83U\xc5	\x02\x01]\xb0n\xcf\xe8\xe7\xb6\x00HhN\x87\xac\xdb\x1b\x84f/ \x0ei\xc2\x00\xd2lqI\xce4V{\xc1pH\x93&\x05'.@\x0dY\x0d\x08\xf2*\xd4\xb6\xe4xX\x05\xcc^#\xe6{\x12\x83\xd3\xd4\x96|\xd1\xd1\x82V\xd1f\xd5\x9e\nD\x82\x9a,\x87\xf0\x93\xb8}^\xb5\x1d\x11\xe1F\x14\x05_	\xdf?9=b\xacmW\xa2\xdc,1\xaag\x165\xb1\xe3EG\xdd\x9a\xfb\x1dVd\xdb\xabfE\xecm\xb3n\xaa\x1d\x8a`\xc2\xdau\x9b\x83\nkK<\xaa,\xa9\xd9\x01\x1bC`\x7f\xa3{m\x0dW\x97b\x93\xb6(+\xfe\xaf\x9d\x0cT\x04\x86\x1e\x9d\x92\xde.\xe3A4\x0d\xf5G\xee\xb2$\x87\xf5\x06M]75\xc4K\xa83y\xdb\xc1\xf7\xb1\x0e\x0b\x91djq7\x17\x85a\x9cP\xbd\xd3\xe9b\xaf\xc6\\\x87\xbbC\x18\xd0\xd0\x1d\xc1\xf4w\x07+\x86\x1eF\xeb\xb6\x9b#\xf4}\x14\xab\xaa\"Ggs\xfd\x11\xca\x92\x1d\xbf\xbe\xdaE\xd5\xd8tVt\x1f	)fK\xa2\xbd\xfdi\xb2w\xf8\xd1do\x9a\xd1\x92\x164\xa5K\xf6\xc6V\x0d\x15\xa4\xaf\xe6\xe04@\x1er\xf1\x0b\x92Z\xe5V\xa3l!\xfa\xfb\x8dp\xef\x98\x15Q\xc3\xea\x9c \xdb\x8c2\xc1\x06\x8a\xbf><#\xa4\xb2\x93\xf6\x04\xb9Zn\xca\xe5(,\x99\xac\xb7p\x9d\xb8\x08:\x844\xb1[\x9d\xe9\x9e\xaaRV\x8e9\x93\xb0\xbd\xb7v\x82y;\x02\xdfB$\xc8\xf1\x92X\x8b\x1b\xa3c\xc5\x16Y\xe2\x8et\xea\x94;H\x05\xb4F\x13\x8c\xd6\xc6\xe4Q\xa7\xf3\xf8\xf8\x18>\xfe\x18fz\xd59\xfd\xf9\xe7\x9f;Ok\xb3I\x11E\x0b\xf9\x80\x08	\xa5RB\x7f\xbe\x1d^3\xb1\xfb\xde\x9b\x04\x05y\xec\xe0\x80l\xae\xa7^\xdc\x99}p\x87b\xb1B$RLP	\x85\x97x2\x97\x992\x90\x1a4[\xf6/\xf1\x92JW\x1b\xdc`I(6l\x08UE\xad\xb4\xefc\xcc\xff\x1f\xe5\xd7u\x91W\xbf\x0fj\x06\xc8\x82\xd5\xcak7\x1f\xd3Yo\xcf\x8f\xf6vP\x8dtM0\xeeCY\x15V\x07>G\x8c\xa9]k\x1fsv\x00\x8fTs\xf0\xc4\xea\x1e\xee\xf4\xc8!D\xcb7R\x07G-n\xcf\x82\xceP\xb0\xc0\x82\x04\x03\xd8\x98U\xc7v\"\xe4\xc6h9/\x8d(\xe8\xdb]\x1f\xf2`\xa7\xd7\x1c@\x06\xc7P\xf1\xdfaQ\x99a7s\xc7\xe8\x98\"\xff\x1fefzU\xee\xd96\x87\x08\x88\x04\xe8\xe3^\x91W\xb6\xb2tja\x0e\xd0\xee\xe4\x08\xef\xf4\x13\xb5rYT\x12\\	B\xb6\xae\xca\x10m>S\x87\x9b\xb6\x94%\xd8\xe55\xeej\xd4\xbd\xcc\xa0i\xba3$!T\xb3\xbd6Ma\xb8\xaa\x84\x91\xa1\x16\x0fBC\xb5\xdc\x06\xc7\x92\xf4\n\x9c\xba%\x96\x08\xdc\xa5\xa7\xc4%\x17\xec\x86#\xd0\xd7\xf7\xd3v\x82\xf7\x183\xc6t\x8f\xf4\xde\xe3\x99\x91\xadH\x0b\xb1S7\xddM\xa7\xac\x8e\x06\x1d\xc4\xa9\x1d\xc90\xcfr\x0c\xe5\xcd\x1b1\xda\xcd_\xb0\xd3\x18k(\xb2\x0d\x1dbN\xa8\x04uk\xe7\xbd\xc0\xf5\x17\xa1\xe2\x1d\x1f\xdf\x8bH\x0c'\xfb\xe1\x8cflIK\xa6w\x9b\x9f\x82e\xfd\xd54\x9bE%M\xd94	\x8d\x96\x1bLf4i\xce<\xe3\xf9:\xfd\xa3\x88\xe7\x1dbmZi\xe4\x8e\xdb\x11\xa1'\xa7\x8c5\x99\xf9f\x89\x0b\x02\xf6s\x14\x05\xa1i\xed>\x1e\"5S\x10V\x8b\xa2L\x0d{iS\xef\x9e(\x1aZzK\x0fR\x8d\xefcq\x90z\xf6\xbb\xee\xc1_\xb1\xd9\xc7\xe8-!\xfbK\xa9:l\xcc\xdc\xea\x11\x0b\xf2\xd2|\xb1\xa3jC!va\xe7\x1f\xa5\xd0\xcf\x13\x91\x8a\xc4d\xfa<M1\xca\xb5\xf0\x92l!\x10\xd4\xa4\xb5\x17\xd4\xeb\x90&\xfb\xe7\xa9\x93\xfd\x83\x17\x86\x85\xb5\x10(\x0f\xf6qt4\x153kG\xd3\xd5T\xccf\xaf\x96P\x95\xbe]\x85\xd7\xd4\xda\xeexj!\x0b>O\xc5yi\xb2\x850\"1\xad\xe7\x84mZa\x97t\xaf\x05\xa4\xec\x86\xf2p)\x9f\x86\\\xdf\x979\xbb\xd8\xc7_\xa4Yr\xcf\x12\xca\xc3$SK\xb9*\xb58H\x8ek\x96\xe25\x15P\x87\x84RI\xf3\xb9\xe9(\xd5\x8ae\xef\x00o\xd4u\xc6\x17lo\x17\xcd\x17\x8b\x01\xac\x89kY\x18\xa1\x84\xc6\xe8\xd3\xcd\xb0N\x06@+\x16\x88fP\xa5\xd0\xb7\x94i\xc6\x1b,H\xa0\xc5\nP\xba\xb9\xe9\xdc\x0d\xa3\xa8h\xaePF\xee\x02\x02s\xd2\x93\xd5f\x88\xa7\x92\x17\xa2\xf0\xfd\xf6\xf3\xdd\x9a	&\x82\xa9\xad\x1b(\x95\x85i\x06)\xd8\xdb\x03\x18(\xd8\x80n%Z26\xa1<\xe4\xa5\xc9>\xb9\x99\x91\x99b\x85\xb3\xcfZhiXJyx5f<ln3\x19\x9a\xf2\x93\x7f\x9e\x9f\xfc\xf7,\x8e\x1f? \xca\xc3o\x0e\xff\xce\xc5gKz\xd7\xd2\x8e\x80\xb4\xbd\xe1t'\xc1q\xbc\x08p\x1c\x87\xf0&} \xbatT{\x17\xa4\x0c\xe1\x93>\xc1q<\xefN\x9f~\x9bM\xf9\xc9\xf2\xfc\xe4o\xdd\x93\x9fg\x81\xc5\x87,>\x90\xbem\x98\xe1\xa9\x18\xcc\xa6'\xc1\xac_\xf1&\xc0\xfc\xc21\x7f}\xd7Z\x9dIw\xe7\xd3\xee\xe9,pt\xe3	\xd0\x1d^\xbe2td\x8f\x98=b\xcc\xfe\xc9\xfe\x89Y\xdf\xfa\xbe\xf5\x99\x8d\xe3\x0f\xf0\x83\x8f\x00~\xccR{bO\x98\xed0\xdb\xb1\x91\xed\xd9\xb33{v\xc6,\xfcY\xc6\x98\x85?\xfb\xf1\xe3Gx0\xeb^\x1f-\xfc\xd98\x06\x05\xa66\x8e_l\x1cc\x1b\xc7\xbf\xc3\x0fx[\xf8\xb9\x0f\xf8\xfe\x1f\xa7\xcd\x00\x94yu\x19\xcc^\xe6\x11\x8a\xe38\x9e\xc6q\x11\xc7\x93\x19\xa2:\xea\xc2\xbc\x9fO\x86\x8c\x87\xaf\xaf\x89\xd9K2\x8a\x9a3/:\x8f\xd01\xa2\xc2=%\xf0Q\x88&\xd1\x14\xc6\x9a\x01\x8f_\x1d\x8f7w\xca\xaf\x99@r\x16\xee\xf9\x1e\x93\xaf\xdf\x81\xc9\xdb\x0bh\x10\xbd\x13\xcf1\xb7\\Y\xb3\x16\x96ka\xaf\x8e7V\x16\xea\xd8\xd8EV=\x85k=\xba\xd6\xbc4\xf6\x8f\xb20\xb6Xge\xba\xb0\xb9\x16\xc6<\xdbBn\xf2\xf4\xd9\n\x95\x95\xab\xb5]eJq\xbb\xca\xa4Z\xd9G\xb3\xb4Ef\x8b2Y\xdbG\x99\xa6\xf69+\xe1\xa7a\xc0g\x9b\xca{a7\x99\x16$\x9ew@\xd6Kp\xc6\xea\xbe\xf8_\x94`\xedr\xc1\xce\x08I\xb6\x81H\x0cV\x10TD\n4\x9fm\xa9\xb1\xf6e\xbb;\x99\n\x93*\xef8c@\x9e\xac\xdb\x8e\xc5\"K\x0c\xaf&\x03\xf7\xa3\xdb\x9bO7\xf6oW\xbf\x0d\x07vts;\xb0\x17\xdf~\xb1\xbf\xfd\xf6\x1b\x89\xaa\x89\x85\x8d\x0d\xc8y}9t\xeb\xe6\xcd\xe5=@1\xeat\x10E? \xd8$]^\xd4\xa4o/\xf3k\xda8\xfe\x80(\x8a\xe3\x0f\x1d\xd7\xe1\xb3\xa3\x7fs\xc1_\x11\xff{\xcbw4\xdc-\xef\x9dS(w\x9a\x0c\xca@\x00h|\xf1r4\xdc_\xe5\xef\x93_\xee\xe8/\x1c\xfd\xdb\x7f\x13x\xdb\xe9bo\x90\xc9\xa4\x1a\xe6\xf0\x9f\x08\xde\x93+@\xf8OVl\xacx\xb2\xc9\xdaj\xb1\xb1\x0f\x8f\xf6am\x1f6R\xd9\x87\x0d\x7f\xb2\xc9\xc6n6V*\x9b\x1b\x9b'6\x7f\xb2\x0b\xb1\xb2+\xcd\x17\x16~0\xad\xb6\xb0\x9b\xc2~\xfe\xa7\xbd\xff\xfcO\xbb\xc8\xa5]\xe4\xc9\xc6.\xf2\xfc	B[-\xd4x\xe8\"K\xfb\xef\x0b\x954Z\xac\xc4S\x0e\xd2t\xe2N\x87\nxMWrS>\xcf>t\xa8\x8c`\xe7\xd2\xac!\xea\x96\xc9\xb4\xa2\x9au\x80\xf1ny\xb9\x05v\x0bc\xec\xfe\xed\xa1\x1a\xc2H\x93\x8aJ\xdf\xab\xd6D\xdf\x1c\xe9\xbb\xff,\xf1\xa6\xd7\xb7]\xb7\xe1\xe0\xf6\xf3\xcd\xa7\xbb_\xbe\x9d\x8f?\xd5\x01'\x8c\xe3\xe2\x03\n\xf6\xc9\xb6\xa4\x07\x85\xd7\x9b\x0c\x88\xd1*C\xf4\xf5v\xd6\xb0\x97\xfa\xf61B\xee\xa2\xc3[\x88%/S\xe3n\xdc=\xa9\x8c\xd0K\x9e\x08\xafp\xe5\x8d\x97\xf0Bx\x1b\x9e{\x85\xd1%\xb4\xd7\\yP\xdbz\xab\xccd^\xce\x93{\xbe\x12^\xf1(M\xb2\xf6\x92L\x15\xc6[\xf245k\x0dQ\xc1\x93KOs\xb5\x12\x9ey\xce\xa1DRF\xaaR\xb8S.\xb9\xc93m\xbcz\xb1\x82p\xab\x0c\xa4\x11\xda\x9bgY\xea\xcd\x9f\x0d\xf4\xd8\xe4\xa9x\xfa\xe9/\xcd\xd7\xe9\x9f\xff\xea-\xd3\x8c\x9b\x1f\xff\\\xbd\x7f\xfa\x0bH\xfdWx\x9c\xfe\x04\xcf\x1f\xff\x0c\xcf\x9f\xfe\xe2U\x91\xd2+\x1d\xba\xac\xf0eEPV\x14R\x19\xf7\xe9\x1e\xb9\xd1\x9e.\x95@4\x95Fh\x9eF\xc8h\x90\x95\x83\xba23\xdcS2Et^\xca\xd4\xdcI\x15!\x9e\xe7B-\xbc\x84\xe7^\x92fE+\xad\x97d\xf9\xb3'7|\xe5\xa5By\x1b~/\xdc\x19\\\xce\x95L\xbc\\\xc3\x88\xee\x99*O\x0b\x9ezZ$\xd9\x83\xd0\xdeB\xa4\xc2\x08\xb4m\x8e\xa5\xea\xa2$\x9a\xa2U\x06\x95,\x9a\xd1\xfb\xc8@\xb8?\xeb\xb8h/\\H\xa2\xc2\x85\x1bz\x90\x1f\x1e\x00\xfb\xebd\x08\x8e\\\xa7\x1bw\xe3\x16\xcf\x8e\xd1\xd6\x01\xff\xee\x80\x7fG\xe0\xce\x07\xab\xf5!\x9a\xbe\xcc#\x01\xa1!@\xd3\xc52-\xa4Kp\xa7[\x18i4\x9c\xb9\xfe\x9d\x88u\xea\x8e\x8d\x97!:\xffR\xb5\x90[7\xc5\x87\xf8\xa5C\xc5 :\xeaV\xd2\xde\xd6B\xe6\\\xf3MQ\xadD\\\xad1\xd2\xa9u\xebL\xd1\xf1\xac\xb3\x85U\xb6%\xbd\xff\x0d\x00\x00\xff\xffPK\x07\x08\xa2\xf3\xc50W\x13\x00\x00\xa3(\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00public/main.cssUT\x05\x00\x01\xef\xdd\x02]\xa4Wmo\xdb6\x10\xfe\xae_qkP\xa0-LW\x96\xe3$S>u\xed\xb6\x0eX\xb7b\x05\xb6\xcf\x94x\xb6\xb8P<\x81\xa4b\xa7C\xff\xfb@\xbdX\xefM\x83\xc2h\xa5\x90\xf7\xf2\xdc\xddsG\xea\x82\x17\x05\xfc\x17\x00\x00\xecI;\xb6\xe7\xb9T\x0f1<{s\x8fZ\x9ag+x\x8f\xea\x1e\x9dL\xf9\n\xde\x18\xc9\xd5\n,\xd7\x96Y4r\x7f[)\xb2#&w\xd2\xb1\xca\x80\xcd\x89\\&\xf5!\x06\xae\x9d\xe4Jr\x8b\xa2\x11\xcc\xe93#{\x9aH\x1e\x0c\x7f\xb0)WX\xcb\xa5\xa4\xc8\xc4p\x11\xa5[\xdc\x85\xb7\xc1\x97 \xa8pf\x9bU\x90E\xab \xdb\xae\x82\xecr\x15d\xbbY\xe8\xff\x90\xb9\x83O\\\xdbgC\xac_\x82`\xfd\x1e\xb9@\xd3\xa8%tb6\xe3\x82\x8e1\x84\xb0-NpY\x9c \x04sH\xf8\x8bp\xe5\x7f\xeb\xe8\xe5\xaa\xd9\xf3\xffXT\x9c\x06\xdb\x9b\xcbj\x7fS\x9c\xe0f\xa2\xbb\x89^\xd6\x01%<\xbd;\x18*\xb5`mla\xc8\x85\xb8\x99l\xcb\x9c\x1f0\x06%5r\xc3\x0e\x86\x0b\x89\xda\xbd\xf0\xe2\xd1\x8f\xe9\xaa\xd5k\xecf(\x0f\x99\x8b\xe1&,N\xf5\x8a\x90\xb6P\xfc!\x86\xbd\xc2f\xe9\xdf\xd2:\xb9\x7f`)i\x87\xda\xc5`\x1d7n\x90\x8e\xb5\xa2\x03U\x02\\\xeasz\x8eR\xb8,\x86M\xf8\xbc6\x94K\xcd\xda\xb5\xdd\x93<\xa6\xa8\x1d\x9a\xa1\xcbl\xb3\xe4\xf0\xa6u\xf8\x14\xd3#\x80W\x1d\xc0\xd7\xaf\x80\xee\xd1\xec\x95/\xb3M\x0d)u\x0b\xaf^\xf7\xc1d\x9b\x06AS\x9dc&]\xc3\xc5o\xa3\xd6\xd3\xbd\xfb=\x87'\xc7\xba]TJ\x16V\xdaiiF\x05\xe9\xac\xb7\x04\xe0\xa5\xa3z\xa5 +\x9d$\x1d\x03O,\xa9\xb2\x8d\xc3Q\x11\x03\x8b\xae\xbdfe\x9er\x84\xb5En\xd2lR\x88*\x1cn\x0eR\xb3Jo\x13\xedZ\x8f\x0buy\xb46\x9dO\x83\xb6T\xce.U\x7f7C\xb7\xab\x8e\xe05\xac\x18<\xa2^\xd8O\xa1\xca\x19\x8a\xd4E\xe9\xce\xb3\xc0\x0841h\xd2M\xc6\xea\x15\xe6[\xb0\xb41\x9c3\xd0J\xfa\x9e\xb7\xa4\xa4\x80\x8b4Mo\x87\x0c>\xe3-\xb8\x10\xd5\x8c\xbbnW\xea\xe1'?c\x0c\xdb\xab\xc1\xe2\xa34\x1b\"\x8f\xf7\x94\x96v\x15x\x1aq\x83\xbc\x89\x84J\xe7\xc7G\x1d\n\xfc \xf3\x82\x8c\xe3\xda-\xa2\xafgKm\xfd#O\xef\xf8\x01\xbf\xb7$_\x82\x80\xc7J\xea\xbba_]\x84\xe1\xf5\xee\xa69\x0d\xfa\xfe~\x91\n-\xb4\x114\xd435\xb7w\x0de\xdb\xdd\xaak\x04\xa6dxM\xf4\xbad\x95\xcb\xccw\xd3\x92\\\xa9\x05\x1a\x9f\x9aF\xf8^Z\xe9P,@\xf4\xe8\xf6\x1e\xd6\x84\xa83\\\xf3o\xac\xeebCG8\x1a^\x0c\xf2\xf9\x8eR\x98?g\xff\xa2\x84\x1cM\xeb\\\x18\x9c\x95\xffD\xa5I\x11\xde\x92@\xf8h\xbcbN\x9al\xc1\xd3\x96\xb6\xe7\xc3$\x86\x0bD\x1c\xd1p\x13~\x0b\x8fG\xdc\xef\x94&\xb3lP\xc5R\xa7\xd0\x01\xaf\xa6(\xab\xa0\xc5~\x99\xd5i\xa9\xb6\xc8\x08\x96\x18\xe4w1T\x0f\xc6\xbd\xad\xf3\x96\x97lw\xfc\xc2\xc0\xcdoZ\xe0	\xd6\xd2?\x98t\x98\x0fi\x93\x90s\x94w\xbc\x19\xaa\x0dh\xf9\xfaU\xaf\xecU\x13\xb4\xa3y\x8e\x0d\x8d\x9d\xf1\x99\xf1\x88\xdbv\x8e\xd7}\xc8\xac\xe3\x0es\xd4\xee\xe9\xd5\xed\x19\xfd\x83\xdf7\xfas\x85\xed3@$(\xda\xfb\xd7(\xd6%2O/\x0c\xde?K\xd0\x1d\x11\xf5\xdc\xbc\x9a%\xf1\x0c\x8f\xc6\xb9\xf1a,\xcc\x89\x0ea\xe5\xea\xd8\x1ct	)11\xb1\xd6\xfc\x9eY,\xd0pGf\x9c\x98\x10\xc2\x19\xb7\xeb\xa2~g\x9a\xe7\x83N\xfb\xba\xa7\xbe\xd6`\xda\xa4\xa5\xb1\x1ezA\xb2;e\x1a\xcdOe\"\xa4\xc1\xd4\x91\x91h!\x8b\xe6\x993\x869V\xdb\xce\xabm\x1e\xd1[\x1f\x8c\x14\xcbC\xcc\xef\xd6\x89\xae\xe4\x1c\xe6\x85\xe2\x0e\xfdE\xb5\xcc\xb5\x8da\x1b>\x87\xeb\xf6\x04\x18\xca\x18:\xda\xfa\xf2\xd1\xcd\xfd\x05\x14\xb6\xe0\xfa+\x0d\xd3\xab\xd8y#Z\x8f\xce\xdc.\xe4\xd1\xc8\xf2n\xffFc%\xe9w\x86\x8awt\xd4\xb0\xa6\xfd~\x1c\xeb\xf9\xa4\x98H'\xa5s\xa4\x97y00#\xb5?EX\xa2(\xbd\x1b\x8d\xd71\xe2\xc9\xed\xa1=,\xc3\xdby\xda\xc0\xec-t\xd0\xd2)n\xa3\xabhr\x99\xd8\\\xb5<\x98\xa4BI\xeb&\x0c\x18M\x8e\xe5\xac\xd4\xf7\x8cGo\x17\xde\xc0\x07\x12\xa5\xc2\xdf\xa5\xad\xc7[\xf7}\xd2\x0c\xa7\xf9\xe3\xa3\xa7\xb5\xce\xab\xf7n\xac\xf7\x02\x8c.k#\xd3!1L\x7f\x8f\x84?\x9fx^(\x1cG<\xaa\xc6r+\xb5\xfa\xa3\xbf\x07_\x91\xb3%\\\xa0\xd02\x8f\xfb7\xa3q#|/\xdc\x9fH<4`\xfbD2\x87\xe4E\xb4\xdd\xad \xba\xdc\xfa\xff\xda\xaf\xca\xc5\xc3\xbf\x9f\xbf\xeas\xb7y\xcea\xa8|vw\x81E\xe4MS\xfd\xf9\x95f\x0d\xd6\xbf\xd2\x07\x12oG\xfc\xed\xb8\xd4}\x06\x0c\xfad\xcf\xfd\xafv3\xb2\xd0\x9bF-\x9b\xf8Ut\xc5/g\xa5\xe5\xd2\x15\xf1\xff\x00\x00\x00\xff\xffPK\x07\x08\x96\x16U\xd8\xf9\x04\x00\x00>\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00public/normalize.cssUT\x05\x00\x01\xef\xdd\x02]\xccXK\x8f\xe4\xb6\x11\xbe\xebWT\x16\xd8\xcb\xa4\xd5\x8fY\x8c\xd7\xd0\xc0\x87\xc4Y\x07\x0b\xc4p\x90\xf1\xcd\x18\xa0)\xb1\xd4b\x86b	$\xd5\x8fY\xe7\xbf\x07$%\xb5\x9e\xbdI\xe0	\xbc\x97\x9d\xa6\xa8z\x7fU_is\xf7\x07P\xa4K&\xc5+\xae3c\xe0\xf8\xedz\xbb\xde\xc1\xaf\xf0\xe3\xe7\x9f\xe1o\"Ce\x10~\x85\x83\xb0E\x9d\xae3*7\n3\x92\xccl\x86\xef\xddm\xa2hs\x07\x7f\xa1\xac.Q\xd9\x08\x00\xbe\xfb\xcd\xfe5\xd2\xef\"\xb8\x83\xdd\x1a\xbe'\xad1\xb3`\x0b\x04)\x14B\x81\xe2PX\x10\n\x98\x94\x90j:\x19\xd4f\xed\xae\xdf\xaf\xe1\xef\x1a\x8f\xa8,0\xfe\xcf\xdaXg\x9d\x01\xca!'e\xc1\x88W\x04\x96[\xd4@Z\xa0\xb2\xcc\nR\x90\x15L\x1d\xd08\x89\xe2\xa7''h\x13E\x85-%|\x89\xc0\xeb\x8c\x83\xce\x04v\xeb\xdd\xc3#l\xee`\xe7.\x01\xc4'L_\x84\x8d-\x9em\xec\xc4\xc7Ao\x02\xbb\xed\xf6\xbd\xbfy\xefn\xfe\xcb\xc7\xeb	3\xa7\xd0\xbc]\xbc\xfe\x81%\x1d\xd1\xc7\xaad\xfa\xe0<\x9a\x86i\x13E)\xf1\x8b\xf7.\xdcJ`\xfb\x18ll\xa4(\x8e\xdaK\xd9\x97L\xa8=\xa0D\x17J\xc8H\x19a,*+/N\xf4\xe7O\x8d@w\xcd\x0b\xe4\xc2T\x92]\x12H%e/}\xa9\xfdD\xf6\xd2\xa1xk*)\xd8\x17\xbbN\x99\x81\x93\xb0\x85P\xb07!n{w\xd9I\xda3mE&q\xef\xecq\xa1\xf7\xb9\xfb\xbe\xd0T\xe2\n~\x10\x1as:\xaf\xbc\xe8'\x963-\xda\x9c\xee\xbc\x89N\xb9OV\x02\xf7X>\xf6\xa3\xb0\xfe\xe6#\x96]0\xe0\xaf\x9a\xeaJ\xa8C\xd0\xf3\xc6\x85\xfe'\xce}l\xb2&N)\x9d]\x88\x9cz\xa1Z\xaf\xda2\x7f*\xe8\xe4o\xd3\x11u.\xe9\xe4\xee|\xe2\x87\x10\xd0.-\x85\xf6\x1e\xa7t\x8e\x83\xa8\xa4u%N\xe9\xdc\xaf\xe4\xb6\xc2\xb7\xfd\xc3Vx\x02GaD*qT\xd1s\x18\x15\xaa@-,SY\xb0\xc5dL:\x1f\x06 \\\x00o_\x0eq\x0e{,\xf7\xdd[M f\xaa\xb9\xd2xMl\xceJ!/	\x94\xa4\xc8T,\xc3\xd5\xf5\xcf\xbeo\xbd*\xd8a9\x86\xea\xcf\x0e\xd0\x12\x8f(\xc1`\xc9\x94\x15\xd9\xff\x07\xb6\x07\xcd.\x90\xb2\xec\xe5\xa0\xa9V\xdc\x81\x82eV\x1c}\xf3{1\x01t\xb0\xdb6\xae\xb3\x90\xdf\xee~\x9c\x91$\x9d\x80\xd5L\x99\x8aiT\xf6q\x98\xab\x9e\xae\x94\xac\xa5\x12R\xd2\x0e\xee\x1d\x84\xe0\xe1c\xdc$d\\\x93\x0el\xc01#\x1dZg\x0fv\xae\xf8V\xf0\xf9\xd3\n~\xaaP\xb3\x19\xf8\xb14\xd5\xbfXa%>7U\xe9\xf4\xc6\xc1\x8a\x04\x14\xa9A\x82|O\xbd\xeaJ\xa0v]\xc9\xb5\xe3k\xb2n\xdd\x02N\xd6\"\x9f+\xd9\xb1[\xbe\xc4N\xdd\\\x19\xba4\xf1#]E\xc6jR\x87k\xd1\x9d\x1a\xf4\xa4$9\xea\xc7\xdf\x15:2\xe2\xb8\x8a^R\xbe\x8a\x0c+\xab\xdf\x18)\xcb\xf1\\re\x13E\xa6t\x87\xa3^\xfc\xed\xf6}?p\xed \xdf\x9b:\xf5\x9d\xdf\xfdU\xf5\xa6C\xae\xa9\x04\x96\xe7n8\xa8\xc3\x0c?pR\xe6\x94\xd7.\x81u5\xd6\xff\xf1\xe1\xfd\xe3x\xdeo\xddIEF\x84\xda\xd2(\x99\xc3\xa2;=\xa2\x1bBL\xc6L\x8a\x83J e\x06}q:\x1fL\x9d6%\x1ej;\xde\xae\xef\x1f\xdc\xac\xf1\xcf\x82jK\x95\x7f\xd0\x9eo\xee\xe0S\x99\"\xe7\xc8\xdf~\xde\x0c\xba\x80\x87\xbfCs\xc9\x02\x0f2\x82/\xf4\x1bQ\x1e\xfa\xd85\xf6\"\xb1\x81n\xe3\xc3\x0f\xa4\xcb7l\x95\x0eO\x9e\xaf\xf5x\x843\xc2,\xa1f\x96\x125\xd3t\x06\xdb\xb5\xb5\xa4V\x91PUmW\x11U\xd6\xf5\xd5j\x15\x19\x94\x98\xd9U\xe4\xfa\x0d\xd3\xc8\xa60j\xa0\xbd\x84\x9c\x96\x0f6\x8fn\xd2\xca++\x9b\x01\xdb\xec\xe8\x0f\x13\xdfEg\x91\x19\xccy\x08_nN\xfb\x01'\xec\xc2\xd8\xefa\x94\x87\x99\xe0\xe7MN\xbal\xb5\x8dx\xd8\xd5\xbe\xffFP\x8f\xf4\xf4,\x0f\xa9\x18\x98\xee\xa7@\xf7\xea\xa0 '\xdcS(\x96\n)\xec\x05,\x85\xda\x81L\x8a\xec\x85\xa5\x12\xc1^\xaan\x13X\xae\x8e_\xdc\xb5\xef\xde\x85\x9f\xef\x9e\xbb\x03\x8d\x06m\xef\xb7\xa9\xd3R\xd8wa\xdc\xb5\xbb\x02\xab*d\xda9\x9d@\x90\xb0\x18g\x85\xba\x85\xa7\xb3\xa5b\x9cO\xe9`gW\x92\xc4%\xbd\xc69e\xb5\x89\xfd\xcb\x13Ko\\il\xbfq\xa3\xf5fze\xb1%@k\xf3d\xc30\x96t\x8b\xe1\xac6-\x88ke\xd0Bz\xf1O*\x8dGA\xb5\x01]\xcba\xf9\xf6,\xd0B\x1d\xa6~.<o\x9d\\x\xdcy8|\xee\xdd\xa3\xda:\xcc&\xb0\xab\xce\x0d\xb5\x80?{u\x8e+.\x15\xdbb\xcar\x81\x92;_\xbf\x0c\x82\xb4\xfe\xf0\xe0\x16\x90\xf5\xc7\xf0\xdf7\xf7\xd7\xe90G'<dN\x9aUU\xa3d\xb4\x02\x8ci\x83g\x87\x03\xdc\xf9)\xbao\xad\xe9\x8d\xd7kS\xf90\x00m\xeb\x91!\xe0\x8e\x1fS\x85\xda\x00\xd3\x08\x8a,d\xacv\xc3\x97j\x0b\xa7\x02\x95{\xe3\x02\xaf\xa8\xc9\x1d9a\x00K\xdafF\xb5\xc4\x03*>Ya:\xe28\xd8`\x1a\xe6\xdbo\xc3\x0dG\xecvR\xcb\xda\x1d\xa6k\xb4\xe7\xf8$\xb8-\xa6\x0d\xbaW\xb8\xee\xf4C8=\x15\xc2b\xec)R\xd2|L\xb9\xbe\xb4L\x88Z\xb2\x00\x9e,\xf8Uzic\xf5\xec\xb9[l\xe8\xa0\xd1\x18\x1f\x81\x9b\x84c\xda=8\xe6\xac\x96=\xd5&\xd3$e\xcat7\xd3\xff\xd8\xa8\x19\xcc\xb4\xeb\x14`\xb5\xa5Q\xed\xdd\xdeQ;\xa20\x9a\xbb=\x10\xf4\xb9D\x03\xba\xac\xc0\xec%\xa5s\xbf\x8f2.\xe8\xdd\xf3\x7f\x98\xf8Q\xa6F\xe3rP\xfe\xb56\xa4\x9b\xa6O9\x08\x95\xe9\xf0a\xc3E\x9ec\xfb+\xb4\x91\xdeW\x85\xa1\xc5\xaa.S\xd4\xbe\x116=\xddw\xc1\xd8TB\xc5\xa3\x191\xbdK\xb5\x1d\xde\xf5n\xb6T`&\xe8c\xde\x7f\x1d \xbd\x9dm8\xab&\xebBh^\x8d\xe7B\x0d\xc7Z\xdb\xfd\x90\xe9\xac\xb81\xad\\\x9dx\xec\x0e\xbe\x11\x04\xd11\xe5\xb9A\x9b@|_\x9d\xe7\xf20\x19l\xbd\xaa\x98\xf8\xe0\xd8h\xc9\xb2\xeek\xdc\xc8\xc2k0\xc3Io\x01\\4~L	&\x8b\xd9\xff\xc6\n|\xa4\x03!\xf5d\xb4\xd2\xae!Z\x81\xc6	\xda7\xddh?\x89\xf9\xd5\x85\\H\x8c\xebJ\x12\xe3\xfd\x82\xb8A\x17F\x0cs\xa6\xe7\x05*\xfeYY\xd4\xe1\xeb\xc1\x9b\x10\xf2\xb9N\xd7\xf4\xda+\x0d\x0c\x9d&\xf4\xb6\xe1\x08\xe4h\x99\x90f\xf9\xb3\xe1W\xe4\xcf\xeeve\xc9\xf4e(R\ncca\xafk\xd6\x8f\xc2do\x12\x90\xafY<\xea\xbae%\x99\xc5\xa1\xb1\xe32\xfd\x9a\xb4\x16 \x85\xe0\x1c\xd5\xf3\xac\xb0\x7f\x07\x00\x00\xff\xffPK\x07\x08\x8a\x0f\xc7\x0b\xae\x06\x00\x00\xf9\x17\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00templates/Header.htmlUT\x05\x00\x01\xef\xdd\x02]l\x8f\xc1\x8a\xc20\x10@\xef\xfd\x8a!\xf7\xdd\xd0{\xda\xd3B\xf7\xa0\xe0\xc1\x1f\x88\xc9\x98\x04\xd2Li\xd2\xa2\x84\xfc\xbb\xb4ZQ\xf46\xbcy\xcccr\xd6xv\x01\x81\xfd\xa3\xd48\xb2R\x84]'P^\xc6\xd8l\xbc\xad\x00\x00\x84v\xf3\xb6\xf0d\xe8GQH\xd2\x85\xa7\xb0J\xae7\xaf\x12\x838\xaa\x86\xf1a:y\xa7\xb8\xa1\x05\xfe\x0e\xc10\x90>5\xac#\xd8-\xda#\xc1\xb5\x9b?k\xb6\xfe\xde\xb2u{\xb4\x08\x1d\xc1\x9e\xf4\xe41\xc2a\xa4\xcb\x15\xfeHM=\x86$\x93\xa3 \xb8\xad\xdf\xae\x0b~\x7f\xb2\xadr\xc6\xa0K\xb9\x05\x00\x00\xff\xffPK\x07\x087\xfbiR\xa5\x00\x00\x00\x08\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00templates/Home.htmlUT\x05\x00\x01\xef\xdd\x02]\x94TA\x8b\xdb<\x10\xbd\xe7W\x0c\xe2\x83\x95a\xa3|\xf4\xba\xd1RZR\xb6e\xb7\x85nn\xa5\xb0\xc2\x1aoTl\xc9Hc7\x89\xf1\x7f/\xb2\x9d\xc4q\xd3\x94\xfa\xe2h\xe6\xbdy/3c5\x8d\xc6\xccX\x04\xf6\xe0\ndm;[jSC\x9a\xab\x10d\x1f\xbb\x9f\x01\x00\x8c\xc3\x01\x95O7\xf3\xd4YR\xc6\xa2\x1f \x1d\xcc\xd8\xb2\"0Z2c5n\xe7\x03\xb6\x0b3(s\x95\xe2\xc6\xe5\x1a\xbdd\xcf]\n2\xe7\xa1p\xba\xca1\x08!\xd8\xb9\x08\x03\xda\x95(\x19\xe1\x96\x0eN\x16\xda\xd4#S'-\x8f\xa1\xca)\x1cK\x0c\xe7\xdf\x8c\x0e\x15\x86\xd7l\x19RoJ\xeas\xa9\xb3\x81\xe0\xc8\x00	\xda\xa5U\x81\x96\xc4+\xd2*\xc7\xf8\xf3\xdd\xee\xa3\xe6\x13\xd1dD/\x9c\x0e \xe1\xd3\xf3\x97\xcf\xa2T> gM\xf3#8\x0b\xa2mYr7\x82z\xb4\x1a\xfd\xd7\xbe\x08H\xe0\x1eC\x02\xf2\x1e\x9acO\x03\xd2\xda\x14\xe8*\xe2|\x92:\xd5\x89\x9d\x18yM=*\xc2\xc1.g\xda\xd4\x07\xd9\xc3\xa3M-\xba>=\x9a@Bi\xcd\xd9S7\x85x\x9e\x82=\x06\x919\xbfR\xe9\x86\xf3\xc2\xe9\x0b6NV\x0ca\xf1o^\xe2\x13YSC\xfdZ\xccc\xea\x12\xa5\x97SW\xb4\xd4%\x9a\x12\x1b\x8f\x19HxY\xfc\xd7\x14N\x8b^\xa6]\xbc\xad\x87@\xae\x08\x03\xb5/\x97\xb8\xc6Z\xf4k\xdc\x12H8\x91\xff\xf0\x7fTY\xa2\xd5\xef7&\xd7\\]\xb0\x12\x870\xc6D\xd2\x04\xd6N\xce\xc7\xd5<s\xc2\xd8U\xd4\xc3\xfa\xe9\xf1*jlB\x9bz\xa4\xd9\xde\xc2\x9b\xff\x87s\xdb\xbf\xcev6\xaeC\x18\xf2\x7f\xf9T\xce\xee\x82$\x8exU\xa3\xa58o\xb4\xe8#,fn!\xablJ\xc6Y\xe0\x98\x8c\xd6\xac\x9fw\xad\xf2\nA\x02\nR\xfe\x15It\x81\xbb	*\xab\xf6{\x90`\xf1'|\xa8\xf6\xfb]\x7f\xd9tfo\xe1\xdbM?\xb5\x9b\xef\xc9\x94\xe7\x8f_b\xac z\xcb\xbc\x93\x18a\xcf;0p\x0eMJf\xcb\xc5\xe1J\x995\x0dZ\xdd\xb6\xbf\x02\x00\x00\xff\xffPK\x07\x08\xe9\x16\xc8\x81\x14\x02\x00\x00h\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00templates/Package.htmlUT\x05\x00\x01\xef\xdd\x02]\xa4\x91\xcdn\x9c0\x14\x85\xf7<\xc5\x95W\xb0\xa8\xa7\xca\xb2a\x90\xda4I\xbbhU)U\xf6\x1e\xfb\x02\xee\x18\x9b\xda\x06e\x84x\xf7\x8a\xdf\xa13\x03]\x94\x8d\x81{\xfc\xf9\xe3\xd04\x02S\xa9\x11\xc8\x0f\xc6\x8f,C\xd2\xb6A,d\x0d\\1\xe7\xf6\xf3\xeb$\x00\x00h\x1a\x8fE\xa9\x98?o\xf8\xcej\x02\xb4m\xd7\xe6_\x90	\xb4\x9b\x91\xcf\x86\x13\xa0\xe7\x87\xf5\xe4\xe3\x1b+J\x85\x8e\x00\x9dn\xd7\xc3_\xb5\xc0\xb7\xcd\x83\x9f\xe4\xc0\xea\xd7\xb6\x0d\xc6\x1c\xc8\x142\x0f\xa1B\x0d\xf4\xc1h\xe7\x99\xf6.\x82\xf7#)\xce\xef@\x8a=)\x8f\xd9;>\x8dI2'\xe3]~7\xf5\x85Z\xcc\xe7\x83e:\xc3\x05\x12\xce\xa3+\xb7Wf\xddR\x1eP\x0bXq|eV\xb2\x83\xc2\x15\xc7z\x1a\x93dN\xfe\xcbq\x0e\xfe\x8f\xe3\xc8z\xaa4_p\xae\x7fC\xa5\xf9\x123\xf8\\@~\x9eJ\xdc\x82t\xf3U\x88L\x81>\x9boF\xdch'3\xb40\x82$\xcf\xfd\xba\xec\xe5\xaf-\x97Z\xcb\xfe_\xaa\x83\x90\xb6k\x7fCp\x08!\xf7\xc6J\xbcY[\xbc\x13\xb2N\x82\xd8q+K?X\x08\xc3\xab\x02\xb5\xa7\xbf+\xb4\xa7\x17T=\xe0\xa3R!)-~\xd0\xc6\x87\x83\xe6\x83\xd1\x9eI\x8d6\"\x11M\x8d}d<\x0f\x0f\xca\xf0#\xec\x13hzXw\xe5\xea\x97\xa3\xb9\xccr%\xb3\xdc\x7f\xea\x02C,\xba\xef3mt\x1f\xc4\xbbIa\xfc\xea?\x01\x00\x00\xff\xffPK\x07\x08\xa9E\x9b;y\x01\x00\x00'\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00templates/PackageDoc.htmlUT\x05\x00\x01\xef\xdd\x02]\xaa\xaeNIM\xcb\xccKUP\nHL\xceNLOu\xc9OV\xaa\xad\xe5\xb2I\xc9,SH\xceI,.\xb6E\x96\xb1\xe3RPPP@\x96L\xcc\xc9I\xc9O.\x86\xca\x80@u\xb5^m-D\x9d~Jf\x99\x1d\x17\x94\xaa\xaeN\xcdK\xa9\xad\x05\x04\x00\x00\xff\xffPK\x07\x08\xb6\xcd\xea\x0bS\x00\x00\x00r\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00templates/PackageExample.htmlUT\x05\x00\x01\xef\xdd\x02]\x8cQ\xddn\x83 \x14\xbe\xf7)N\xb8\xd2d\xa3\xf7\xabz\xd1\xb5\xc9\x9a,\xeb^\x81q\x8e\x8e\x14\xc1(63\x84w_P\xd7vM\x97\xcc\x1b\x81\xef\x87\xef|x\x8fT)C\xc0\xde\x85<\x8a\x9av_\xa2i5\xb1\x10\x92\x1c\xd5	\xa4\x16}_\xdc\xa2e\x02\x000\x11\x14\x16\xcc{\xbe\xdf\x86\xc0\xee\xb3_H u\xac\\\xb6\x90z\x0f\xfcM4\x04!d\xf9\n\xd5\xe9\xbe\xdd\xe3\x87\xc5\xf1\x0f\xcf\x8d\xc5\x11P\xf5\xad\x16\xe3\xa1\xaa\x96@\xf1\xf3\xdeQ\xd3j\xe1.3m\xadd\xc0\xb7V\x86p\xa6\xe5mGe\x0c\xf2l1\x06\xc9W\xf1\xe0\x02\xc7T\x87\xc1\xb5\x83{\xba\x8ax\xa5\xe43\xfaK\xb90\x97_\x92\xf7\xb2S\xad\x9b\xb1\xb4\x1a\x8ct\xca\x1aH3\xf0g7iM\xef\xe0s\xaa\x08\n@+\x87\x86\x8c\xe35\xb9\x9d\xa6\xb8\xdc\x8c{L\xcf\x15g7\xca\xd8\xd1?ts\x97\x17\xf1|!\x17\x88\xbb\x13\x19\xf7\xaazG\x86\xba\x94I\xad\xe4\x91=\xc4\x90E9\xb9\xf3\xe9\x01\"\x83;[\xd7\x9aRvU|\x96\xad'\xd3\x90\xa5\xd9:\xc9W?#{O\x06C\xf8\x0e\x00\x00\xff\xffPK\x07\x08b\x9a\x02\xad(\x01\x00\x00`\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00templates/PackageExamples.htmlUT\x05\x00\x01\xef\xdd\x02]\xaa\xaeNIM\xcb\xccKUP\nHL\xceNLOu\xadH\xcc-\xc8I-V\xaa\xad\xe5\xb2I\xc9,SH\xceI,.\xb6U\xd2C\x97\xb7\xe3RPPP\xa8\xae.J\xccKOU\xd0\xab\xad\x85\xf2KRs\x0br\x12K0LTBR\x93\x9a\x97\x022^?%\xb3\xcc\x8e\x0b\xca\x05\x04\x00\x00\xff\xffPK\x07\x08BW\xfc\xd0^\x00\x00\x00\x8a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00templates/PackageFiles.htmlUT\x05\x00\x01\xef\xdd\x02]T\x8cA\n\x830\x10E\xf7\x9e\xe2\x93\xaeUp\x1d]vYz\x85!3jP\x93b\xc4M\xc8\xddKm*u6\x03\xff=^\x8c,\xbdu\x02\xf5$3\xd1 w;KP)\x15\x9a\xed\x0e3S\x08\xed\x95u\x05\x00\xe8\xb1\x81\xe5V\xbd\xa6\xa1\xec\xbf{\xb6ph\xba\x1e\x9bl\xfe\x85\x0e\xb34\xdemd\x9d\xac\xb9\xf5\xb9\x18\xb1\x92\x1b\x04\x15R:WM\x18W\xe9[u[<\xb37\xe5\xe6\xd9\xab.FT\x0fZ\x04)\xe9\x9a.\x11q\xfc\x0b\xe8\x9a\xed\xde\x15\xf9\x9d\xec\x1d\x00\x00\xff\xffPK\x07\x08\x15\x0f\x04\x05\x94\x00\x00\x00\xf3\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageFunc.htmlUT\x05\x00\x01\xef\xdd\x02]t\x8fM\x8a\x840\x10\x85\xf7\x9e\xa2\xc8\x01\"\xb8\x8eY9\x03\xb3\x98a\x18O\x10\x92R\xc3\x98(1JCQwo\xd2\xf4\x0f.zW\xc5\xf7U\xf1\x1e\x91\xc3\xc1G\x04\xf1k\xec\xbf\x19\xf1s\x8fV0W\xca\xf9\x03\xecl\xb6\xad=!]\x01\x00\xa8\xa9\x01\xefZA$\xbf:f\xa1\x87=Z \x82\x80yZ\xdc\x1fZ\xf4\x07&\x90\xdf\xa7\xbd\xcf\xc9\xc7\x11\x98\x8b*\x7fL@`V\xf5\xd4\xdc\xbf\xae	u!\xbd\x1f\xa3\xc9{\xc2\xe7\x81\xaa\x0b\xbbYD\x19\xc3:\x9b\xfc\n\xdd-V\x80\xec\x16\xcb\xfcN\xf9\xb8\x98\xb0\xce\xb8	\x90\x8f\xb1\xb4\xac\x9d?tE\x84\xd11_\x03\x00\x00\xff\xffPK\x07\x08\xbe`\x83\xdc\xb1\x00\x00\x00\x0d\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00templates/PackageHeader.htmlUT\x05\x00\x01\xef\xdd\x02]\\\x8e?o\x840\x0cGw>\x85\x95\xbd \xc4\x9afbh\x97\x8a\xa9\xbbE\xdc&:\xf2GI\xc4\x0d\x96\xbf\xfb\x89\xe3Xn\xb3\x9e\x9f\x9e~\xcc\x96\xfe|$P\x0b\xae7\xfc\xa7/BKE\x89t\xda\xfa\x1d\xd6\x0dk\xfd|{\x9a\x0e\x00@\xbb\xd1\xe4\x93\x033\xf4/\xe7\x07\x03\x81\x88\x1e\xdcxy\xd3\x95\xf1!\xa7\xd2>j\xc3F\x81bS\xe6$\xa0\x8e\xc0\xf7\xf3^\xb09\x10Qzp\xd3\x19`n\x14\xf2\x86\x8d@\xfdR\xa9>\xc5\xb9\xa4<\xa7{T\xd0\x1fK\x07\xebw\xd31S\xb4\"\x8f\x00\x00\x00\xff\xffPK\x07\x081R\x16\xe2\x9b\x00\x00\x00\xd3\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00templates/PackageIndex.htmlUT\x05\x00\x01\xef\xdd\x02]\xe4\x94O\x8f\xda0\x10\xc5\xef|\x8aQ\xda\x03\x1cH*\x8eU\xc2\xa5\x7f$\x0e\xad*Q\xf5\xee\xe2!\xb1\x08&J\x0c\x02Y\xfe\xee\x15\x8e1\x8eC\xa8Y\xede\xd9+~3\xf3\x9b7\xe4IIq\xcd8B\xf4\x8b\xac6$\xc7\x05\xa7x\x8c\x94\x1a\xa5\x94\x1d`U\x92\xa6\xc9\xbao\xf3\x11\x00@Z\xcc\x80\xd1,\xaa6\xf9\x94\xb5\xbf\xeb\xe74)f\xadBJ`k\xc8\x05\x8cK\xe4\x10\x7f\xd9\xf1F\x10.\x9a	|\x02\xa5\xda&\xce\x0c\xddd\xca\x04n\xcd\x04- P\xd4\xb8\xce\xa2\x0f\xb6<\xea\x17LK\xc67\xd1\xdcJ\xd2\x84\x18\xc8\x84\xb2\xc3\x85\x0695c=\xb2?\xa4f\xe4o\x89/$\xb3\xe5\xc3dVr\x9b\x0c\x90S\xb8\xb2\xd5\x84\xe7\x08\xf1\xf7=_5.\x8f3\xfc\x7ft]B)!\xfeI\xb6\x08J\x0d3\x9eEK\x96s\"\xf65.E\xcdx\x0eJY`\x0f:\x80\xff\xf7\xa9\xc2\xbb\xfc\xa4\x0c\xe6\xd7\xf8\xf7\xe8\xc5\xa9Bp\xf6\x1c\xe2\x86\xfe\xf9\xb5\xcf\xce\xe9u\xc5\xbe\xec\xc2\x0c\x9c\xc5\xeaK\xd6\xd5\xc3\xa3\x0b\x04\xd8\xaf{&\xfe$\xf7\x8f\xdd*\\\xf6\xeeq\xa0\xbf\xfd\x0f\x14\xc5\x8e\xfa\xfbK	\x1f\xcf\x96j7?g\xd6\xd6\x10\x83L\xc7\x87-\xb2\x03\x95\x8a\x03\x0c\xeb\xf5\x82\x96\xe2\x86\x8b\xfd\xb1!\xb6\xfa\xc6\xf9\xc6^m\x0f\x8b\x99oG\xb2\xad\xbc\x94\xe9\x87\xe8E\xe5\xe6\xa81\xf6\xf2\xf4Z\x91\xb0\xf8z7\x10\xcc8\x18;\x9f\xd5$(\x0f\\\x0b\xdc\xaf\xc6\xff\xf1M.tc9\x9dtO\xb1\xdc{\xb8\x96\xc9\xa7\xa7[\xaf\x13<&\xbbL\xa1y\xfc\x17\x00\x00\xff\xffPK\x07\x08\xf0+\x90\xdc\xa0\x01\x00\x00\xed	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00templates/PackageNav.htmlUT\x05\x00\x01\xef\xdd\x02]l\x90\xc1n\xf20\x10\x84\xef<\xc5*?\xc7?\xe4\x8eBn=TB\x94W\xd8\xe2Mb\xe1l\"\xdbu\xabn\xfd\xeeUB0\x81r\xdd\x9d\xf943\"\x8aj\xcd\x04\xd9\x11Ogl\xe8\x80!\x83\x18W%c\x80\x93A\xe7v\xcbW\xb5\x02\x00(\x95NOC\xb5\x9f\xcf\xd3\x0b\xaf\x8f\xe1\xe2\xca\x19;\xca\xa0\xb5T\xef\xb2\xc2\xf7\xaa\xff\xce*\x11\xd8$jG\x10cY`\xb5\xbdQ\xdc\x80|\x05\xe9n\xe8\xad\xcf\x07\xf4\xed\xc5\xf9:\x1d\x8e\xe8\xdb\xc98j\xe7\\\x85\xd2\xe1oD\xab\x9bv\x99Q\x04\xd6\x8caO\x0c\xdb\x1d\x18b\xd8\x1c0\xec5\x9f\xddX})3\xe8\xfc\x8b\x19e\xee\xe3\xfd\x8d)\x19\xefu\x16\xb9!XkV\xf4\xf5\x1f\xd6d\xa8#\xf6\xa3\xed)\xb9\xbcEN'\x9c'\xfa7\x9c\x9b\\\xc4\xf7\xfb\xfe\x93lb\xc58uO\xe8\xcbb7bq\x8f\x14\x01]\x83\xf1s\xa6T\xe41\xc4u\"\xc6\x90;\x1a\xc8\xa2\xefmV\xfd<\xe1\x11\xab\x87\xd6\x8b\xcb\xbc|Y0\x86j%B\xacb\xfc\x0d\x00\x00\xff\xffPK\x07\x08\xed\xe8\xecZ\x16\x01\x00\x00]\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00templates/PackageSubdirectories.htmlUT\x05\x00\x01\xef\xdd\x02]t\x8e\xc1j\xc30\x10D\xef\xfe\x8aE\xf7X\x90\\e\x9fz,%\x90/\xd8Z\x1bkq+\x1b\xc9\x0d\x94e\xff\xbd4V\xb1u\xe8Ih\xe6\xcd\xec\x88x\xbas$0W\x1c&\x1c\xe9\xf6\xf5\xee9\xd1\xb0\xce\x89)\x1b\xd5\xc6y~\xc0\xf0\x819w\xff@}\x03\x00\xe0\xc2\x19\xd8wf\x99\xc6S\xe5\xbe\xec\x1fg\xc3\xb9\xd0\x87\xd61\xb1?\x0ds\\\x91#\xa5R\xb7U^\xfa+\xae\xc1\xd9p\xa9\xd5\xdbw\x9c\x97\xcc\xb9vD\x12\xc6\x91\xa0\xdd\x06f\xd5=\x94\x17\x8c;\xf9\x94\x10B\xa2{gD\xdaW\x8e\x93\xaa\xe9E\xa0}\xc3O\x02Ug\xf1p\xd3\xd6\xf9\xad\xee\x97\xfe[\xf2L\xd4\x90\x08E_68\xeb\xf9\xd17\xe5)\xceO\x00\x00\x00\xff\xffPK\x07\x08G\xa2$\x83\xc4\x00\x00\x00~\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageType.htmlUT\x05\x00\x01\xef\xdd\x02]\x9c\x8e\xc1J\xc3@\x10\x86\xefy\x8aa\x1f \x85\x9e\xd3\\,\xde\x14\xa1\xd2\xfb\xb8;&\x8b\xe9d\xd9\x9d\x16\xca0\xef.	\xab\xc5\x83\x8a\x1ew\xbfo>~\xd5@\xaf\x91	\xdc\x13\xfa7\x1c\xe8\xf9\x9a\xc8\x995]\x88\x17\xf0\x13\x96\xb2\xfb\x82\xfa\x06\x00\xa0\x1b\xb7\x10\xc3\xce\xa9\xb6\x8fx\"3\xd7\xcb5\x11\xa8\xc2\xfa\x01f\xddf\xdcV9e\xea\x17r\x88\x03\xa3\x9c3\x1d$G\x1eVia\xab\xa5*tJ\x13\xcam\xcb~\xf6\x0e\xda\xfd\xec\xcd\x9a\xea@F\x1e\x08\xda\xbb\x99\x8b K\x01\xb3\xef\xce\x8f\x98\x8b\x83\xf6S \x0e\xb7P\xed\x1c1G|\x99\xa8\xfc3\xf3\xb1\xe7\xfe\xcc\xfe\xa7-\x0b\xff5\xf2@2\xce\xe1\xef\x99n\x13\xe2\xa5o\xea\xf3=\x00\x00\xff\xffPK\x07\x08%\x08\x07I\xc7\x00\x00\x00\xd3\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageVars.htmlUT\x05\x00\x01\xef\xdd\x02]T\xcd1\xaa\xc30\x10\x84\xe1^\xa7\x18t\x00\xfb\x02z\xaa|\x80\x07\x86\xf4\x8b\xb4\x11K\x1c\xd9H\x8a\x9be\xef\x1e\x9c&\xa4\xfe\x86\xf9U3\xdf\xa52\xfc?\xa5\x07\x15\xbeQ\xeb\xde\xcc\x85,'\xd2F\xbd\xff\xfdPt\x00\x10\x8e\xc6Q\x15\xd3*\xa5\xd2x5^G\x93Z`\x16\xe6\xcb>+U\x0c~\x1e\x1b\x8d\xef\xff\xb2'\x8fi\xd9\xd3\xd5\x98\xb3\x9c\xd1\xa9r\xcdf\xef\x00\x00\x00\xff\xffPK\x07\x08	\xbfb<u\x00\x00\x00\x8b\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00templates/VersionDropDown.htmlUT\x05\x00\x01\xef\xdd\x02]\x8cSAo\x9c<\x10\xbd\xef\xaf\x98\xcf\xfa\x0e\xa0\x16\xb8\xf4\xd4\x02\xaa\xda\xe4\x10)U{\xca\xdd\xc5\x03k\xc5\xd8\xc86D\x95\xe5\xff^\x19X\xe2e\xbb\xc9\xcee\x17f\xde\xf3\xbc\xf7\xb0s\x0c[.\x11\xc8\x13j\xc3\x95\xbc\xd3j\xb8S/\x92x\x7f(\x19\x9f\xa0\x11\xd4\x98\xea\xa2]\x1f\x00\x00\xca\xff\xb2\x0c\xca\xe3'\xf8\xda\x08\xde<W\xc4\x1e\xb9\xc9\xad\xea:\x81\xa4\x86,[\xc7~\x8f\xd6*	\x9cUdZ\x88Lv\x9a\x9a'B9\x07\xf9\x0f\xc5F\x81\xeba\xe0\xfd\xd6,\xcd@\xcf\x08\xb2A\x8c\x86\x9c\xd6\xa3Z\xab\x17R\x7f(\x8b0W\xbf\x01\xeb\xb9\xdc\xe3@\xb5-\xa9\xb3\x18[\x16\xcb\xca\xebS0\"&\x11\xdc\xd8\xacQ\xd2R.Qol\xe7\xaf\x17\xdaX\xde\xff\xbc\x1f\xe0s\x05\xf9C?(m\x7fQ{\x8c5:\x07\x9a\xca\x0e!_\x0d0\xb1\x01\x8cO\xafd\xf3\x1b\nG\x8dmE\x9c\xeb\xd0>\xa1~\xe4\xf2y9#\xf7\x9e\xd4\xc1O\xf0\xbe,h\xe4GqF\xe3\x1cJ\xb6\x1e\xb2\xb6\xd6\x9fCi\x1a\xcd\x07\xbb\xcc&\xed(\x1b\x1b\"IRp\x1b\xbcQ\xd2X@\x01\x150\xd5\x8c=J\x9bwh\xef\x05\x86\xbf\xdf\xfe<\xb0\xe4\"\xef\xf4\xcb\x0e\x1er\xbc\x81`\xc9\xfb\x02=\xc7y\x0b|\xc9\xfd\x02\x1f\"\xfb\xbe%v\x03\xcf.\xfa\x88P\xa0\x05n~\x0e(\xa1\x82\x96\n\x83\xaf=\x149e\xec~Bi\x1f\xb9\xb1(Q'd\xbe3\xe4c\xf0\xb4\xaa#[C\xf1\x16\x92\x85,\xdduB\x05/\xf2\xf9\xa3\x0bl\xb9\xc6^M\x98\x90\xf0\xc1E\x0b\x9dj\x96\x1e\x8dS\xc6\xae\xce\x9e\xf9q#\xe6\x9a\xe8P\x1eP\x18\xfc\x87\x84k\x07\xbd\xa3e\xa7\xfc\xad\xb5\xf6\xb2\xdfa\xdeTX=\xeeElO~\x05\xfa4I\x0feq\xba#\xeb=\xfa\x1b\x00\x00\xff\xffPK\x07\x08hO\x10\xf0\xd6\x01\x00\x00N\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00templates/index.htmlUT\x05\x00\x01\xef\xdd\x02]\xa4TOo\xd30\x14\xbf\xf7S<|\x19h$\x81\x1b\x82\xa4\x08\x8dI\x03!\xadb\xa0\xc1\xd1\xb5_\x92\xb7:~\xc1~i\xd7e\xfb\xee(K\xbb\xb6\x1b\x9c\xd6K\xe5\xf8\xf7\xcf\xfe\xbd$\x7f\xf1\xf9\xfc\xe4\xc7\xef\xd9)\xd4\xd2\xb8\xe9$\x1f\xfe\xc0i_\x15\n\xbd\x9aN&y\x8d\xdaN'\x00\x00y\x83\xa2\xc1\xd4:D\x94BuR&\xef\xd4\xfeV-\xd2&\xf8\xa7\xa3e\xa1~%??%'\xdc\xb4Zh\xeeP\x81a/\xe8\xa5P_N\x0b\xb4\x15\x1e0\xbdn\xb0PK\xc2U\xcbA\xf6\xc0+\xb2R\x17\x16\x97d0\xb9_\xbc&OB\xda%\xd1h\x87\xc5\xdb\xf4\xcdV\xc9\x91_@@W(2\xec\x15\xd4\x01\xcbBem7wd\xb2R/\x87\xc7)\x19~J\x88\xb2v\x18kDQ \xeb\x16\x0b%x-\x99\x89\xf1\xb1\x8c\xe7\xd0hG7\x98\x0e\x9b\xcf\x10j4\xf9\xe7jh\xe1&a\x8f\x89\xa3\xaa\x96}\xb5h\x02\xb5\x021\x98\x1d\xba\xa6\xaa\x1e\x81\xad6\x8b\xf4*\xaai\x9e\x8d\xc0\xfd\x0c\xa3\xc5\xd0e|\x9fe%{\x89i\xc5\\9\xd4-\xc5\xd4p3D\xf9X\xea\x86\xdc\xba\xf8\xces\x16\xbe\xbd\xe0.\x18<>a\x8b\xc7\xb3\xc0\xb7\x97\x1c\x16\xc7\x17\xdaG\xf5\xe4L\xa3W\xdfS	)y\x8b\xd7ww\xff\xcf\\v77\xffH\xda\xf7\xe8\xed\x96'$\x0e\xa7e\xb8\x9f\x19\x9bg\xe3z\x92g\xe3\xe8N\xf29\xdb\xf5\x96\x07\x0f\xb6\xb0\xe5{\xde\x97\x1e\xa3H`_M/\xf1( D\x0ea\x0d\xf3N`\xeb\x01\x961\xfa#\x81\x15\x87\x05\xb4\x81[\x0cn\x0d+\x92\x9a;\x81\xafz\xa9/\xc6\xc3\xa0\xd7s\x876\x85\x99C\x1dq\xb3\x06\x12\x10~\xb0\x1b~\xc3\xc4\x93\xef0\xcd\xb3\x8d\xf9\x18.;L\xd7\xf70\xf8\x1f^\xd9.x\xed\xaeb:\xbc g\xdb\xae\xc9W\xe7\xfe\x1bk\xfb\xf2\xd5\x87\x8d\xe2A\xe5\x96\x96@\xb6P\xbam\xd5N\xa7\xef\x05\x9b\xd6iAPg\xa8-\x06\xb5\xb1\x84\xa7\xb7x\x00\xe6\x06\x15\xa4V\x8b>$\xa0\x8b\xf8\x08;\xd3f\xa1\xab\x1d\xfc\xd1\xd92K\xcb\xa1\xc5\xb1\xbd\xa1\xce\xe1\x13\xf57\x00\x00\xff\xffPK\x07\x08\xdc\x90\x984'\x02\x00\x00\xb2\x04\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x01\xc9\x04\xe87\x02\x00\x00\xf5\x04\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x00\x00\x00\x00public/atom-one-light.cssUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xf7\x871\xc7\x19\x05\x00\x006\x16\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x87\x02\x00\x00public/favicon.icoUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x9d\x9d	\x9f\xce\x05\x00\x001\x11\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe9\x07\x00\x00public/fuzz.jsUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdNY\xc6o\xd9\xd5)\x00\x00\xd59\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xfc\x0d\x00\x00public/gologo.pngUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xa2\xf3\xc50W\x13\x00\x00\xa3(\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x198\x00\x00public/highlight.pack.jsUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x96\x16U\xd8\xf9\x04\x00\x00>\x11\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xbfK\x00\x00public/main.cssUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x8a\x0f\xc7\x0b\xae\x06\x00\x00\xf9\x17\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xfeP\x00\x00public/normalize.cssUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN7\xfbiR\xa5\x00\x00\x00\x08\x01\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf7W\x00\x00templates/Header.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xe9\x16\xc8\x81\x14\x02\x00\x00h\x05\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xe8X\x00\x00templates/Home.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xa9E\x9b;y\x01\x00\x00'\x04\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81F[\x00\x00templates/Package.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xb6\xcd\xea\x0bS\x00\x00\x00r\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0c]\x00\x00templates/PackageDoc.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdNb\x9a\x02\xad(\x01\x00\x00`\x02\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xaf]\x00\x00templates/PackageExample.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdNBW\xfc\xd0^\x00\x00\x00\x8a\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+_\x00\x00templates/PackageExamples.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\x15\x0f\x04\x05\x94\x00\x00\x00\xf3\x00\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xde_\x00\x00templates/PackageFiles.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xbe`\x83\xdc\xb1\x00\x00\x00\x0d\x01\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc4`\x00\x00templates/PackageFunc.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN1R\x16\xe2\x9b\x00\x00\x00\xd3\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc6a\x00\x00templates/PackageHeader.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xf0+\x90\xdc\xa0\x01\x00\x00\xed	\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xb4b\x00\x00templates/PackageIndex.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xed\xe8\xecZ\x16\x01\x00\x00]\x02\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa6d\x00\x00templates/PackageNav.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdNG\xa2$\x83\xc4\x00\x00\x00~\x01\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x0cf\x00\x00templates/PackageSubdirectories.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN%\x08\x07I\xc7\x00\x00\x00\xd3\x01\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81+g\x00\x00templates/PackageType.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN	\xbfb<u\x00\x00\x00\x8b\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81Ch\x00\x00templates/PackageVars.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdNhO\x10\xf0\xd6\x01\x00\x00N\x05\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81	i\x00\x00templates/VersionDropDown.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x87\xbc\xcdN\xdc\x90\x984'\x02\x00\x00\xb2\x04\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x814k\x00\x00templates/index.htmlUT\x05\x00\x01\xef\xdd\x02]PK\x05\x06\x00\x00\x00\x00\x17\x00\x17\x00\x17\x07\x00\x00\xa6m\x00\x00\x00\x00"
+	data := "PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00public/atom-one-light.cssUT\x05\x00\x01\x80Cm8|\x94\xcb\x8e\xdb:\x0c\x86\xf7z\n\x02\xd9\x0d\x8e'q\xe2\\\xc6\xb3:@\x81n\n\xcc\xa2\x0fPP6\xe3\xa8\xd1\xc5\x95\xe8\x99\x04\x83y\xf7BVl(\x08Pd#}!\xff\x9f&i/\x9f\x84\xf8\x9f\x9d\x817K\xf0Cu'\x06y\x85oh\x15i\xf8\x8e\x06;\x12o^u\xca\xa2\xceb~^-\xe3\x05\xf8D\x86\xe0\xe8\x9d\x81\x13s\x1f\xea\xe5\xb2S|\x1a\xe4s\xe3\xcc\x12\xd9\x99\xa5\xb3T\xe8\x98S\x841G\x08\x89\x81j\x00\x80\xc5\x11\xe3O\x18g]Q\xd6\x00\x8b\xcda\x83\xd5:\x81u\x04\xbb\xc3N\xee\xf7	l\"\xc0\x15\x96\xb8\x17\xa7\x81\xc6\x0cX\xac\xcaC%\xe5\x08b\x06,\xaa\xd5\xfep\\\x8f f\xc0\x02w\xeb\x1dV#\xa8F\xb0]aY\x1dG\xb0\x1d\x01U\xdb]\xf5\x92@TY4/\xe5\xba\xda\x8c`7F\xbc\x1cv\x87U\x99@\x8a(\x0f\xd5\xaa\x14\xe2i)\xc4\xf3I\xff\x0e\xf0)\x00Z\x15z\x8d\xd7\x1a\xa4v\xcd\xf9U\x00\xb8w\xf2G\xed>\x8aK\x0d8\xb0\x8b\xac\xc7\xb6U\xb6\xaba\xf5\xbc%\x13I\xe3\xb4\xf3\xf5\xf4\xfc\x91Hl\xce\x9dw\x83m\xeb\xa9O\xaf\xe2\xeb\xe6U4\xce\x18\xb2\xfc\xdf\xed\xfagpL\xf0\x99	\xa56E\xa1\xa3\xb3\\\x04\xbej\xaaA1j\xd5d:\xadk\x18\xbbI\xe6L\xd7\x0f\xe7\xdb\xe9zt\xde\x0c\x1a\xefu\xc7^f\n\x81\x1aV\xceN9\x16\x0dM\xe7@\x9a\x1av\xbe\xc8,Z\xd2\x94\xc7\x87A\x06\xbesH\xb3\xc8\x1c\xb4b\xf2\xa8\xef\x82\xd2\xd0\xb3\xa0\xc0^\xd9\xd9\xc5SG\x97~\xba\xc5n\xe7\x9e\xc8\xec\x95\x1cx.\xd4\x10\xe3M\xe1\xce%\xedI\xe6\"\x07\xa5\xf9\x97\x9a\x9f\xb6\xd1\x18\x02\xa43+\xd6\xf73H+\x92\xa5G\xe3)\xf5\x1d\xbdB\xa9\xe7\x1a\x98L\xaf\x91\xe9\xf1\x8fk\xff\xd8\xd1\xd1\xf8\x81\xe6\xfa3\xec\x03\x0d\xad\x9bb\xed`$\xf9\xbb2\xd3jge\x86\xab\x91NO\x19r\xd0\x9a\xe6M\xd3\xca\x9e\xa7sl\xdb\x83\x9d\x9a\xd7\xe7\xb1!\xe9\xcd\xcc\x9c\xc8\xf4'\x0c*\xbd;\xff\\\xd4\xc0\xde\xdd\xa63\xc6}P\xfc\xa4\xd4 \x9dn3\xc1X\xde(\xc6t\xe1\xa2\xa5\xc6y\x8c\xa3\xafa\xb0-y\xad,\xbd\x8a/\xf1w\x00PK\x07\x08\x01\xc9\x04\xe8;\x02\x00\x00\xf5\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x12\x00	\x00public/favicon.icoUT\x05\x00\x01\x80Cm8\xecX\x0bLS\xd7\x1b\xff\x80\xfa\x87?l\x88C\xe7\xea\xa6\xed\xe2|\xc4\x18\x137\x95\xdb8'f[\x9c\x99\xdc\xaa\xbd\x1d1h\xbai\x1c\xa8sn\x02E\xd4)n\xba\xc5\xc4'8D6#\xceL\xa7\xd9\"\n\xc6,\xea\xd6B\xe2\x94\xe1t\xc4'\x96\x97\x15E\xccP\x06\x85b\xe1\xfc\x96s\xdb\xdbVS\x0cq\xb2lI\x7f\xc9\x97\xf3\x9d\xf3=~\xdfw\x1eiz\x89B(\x94\xb4Z>j\xe9\xbb\x18\xa2\xe1D\x14\x13\xc3\xe7\x11\x94\xd2\x87\xe8\xb7\x18\xa2\x91D\xa4%\xa2x\xe2\xeb\\{4$Q\x00\x17Vg\xeeUQx|\xccn\x04\xf9\x83\xfcA\xfe \x7f\x90\xff\xbf\xc1_i]\x80S\x85sd\xa99\x95\xf2H_\x7f\xfdI\xf5\x7f\xb3\xee,\\\x9d\x90\xe5z\xe5\x89n\xfd\x9e4\xbf\xd3\x96\x8a\x9b\xe5\x8b\xd1|\xaf\xd1\xcb\x7f\xbb\xfe2n\x94-\x92m\xdd\xc5=\x89\xfe]\xd5\xe98\x90\xff.\xb6\xe7\xac\xc2\xf6\xdd\xc7\x90\xbf\xcf\x8a\xaf\xf6\x97 \xe7\xebB\xe4\xe6\xac@\xe1nS\xc08\x7f\xf9;\xfcN[&\xcc\x9b,H\xdbX\x1aPVm9\xd6m\xecc\xf1__	\xd6t\x18h\xbf\nt\xd8\x01\xc79\x14\x1c8*\xf3\x19\xe6\xad\xc7+\xbai\x18\xff\xeat\xcc^\xb4U^+,>\x02\xfcY\n\xb4\x9c\x01k:\x04f_\xf3\xf8\xfd\xdfX\x07\xdco\xc0\xc3`\x00\xca\xcbNB\xa3\x1d\xea\x95\x11#G\xa1\xaa\xfa\xb2\x9f\x97\x07]\xad`\x0dy\x0f\xd4\xd0S~8\xab\x95,\x01Q\xb0\xc3\x8c\xe9S\xc7a\xe6\xdb\xe3Q\xf4C\xbe\xcf\xf00\xba\xda\xc1n\xac\xefI\xff-\xdc\xe6\xb4\xa5\x815|\xe9\x97\xc0\x8d\xb6\xb66\x98\xde\x9b\x8f\x91\xa3\xc6`\xe5'k\xc0\\w\xc1\xea2\xc0nm\xf3\xfa(\xb8p\xe1\"\xe2\xa7\xbc\x81\xb1/O@Q\xf1Q\xa0\xd9*\xf3\xf3\xdc\x9e\xfe[\x02\xec\x7f%\xb7\xdd9\xbf\x14\xac\xa9\xc8\x93\xc9\x87\x1dy\xf9\xe8\x17;\xd0+\xd6\x92R\xb0\xfa\xcf\xdd\xf7\xe3!\xcc\x98)y\xfd4/\x0eC\xa7\xa3J\xe6o<\xbf\x94s\xc3 \nW\xfd\xa8e\x18\xf5q\x87\xb8\xad\xec\xe8|\xb0\xbb\xc5\xbed\x1e\xe4\xe6\xe6ys\xf2\xd1b\xb1\x82\xd5\x7f\x11\x90_?\xc3\xe0\xf5\x1d\xa2}	\xaeV\x9b\xcc\x7f\xa6h\x9e\xd2\xffa?j\x92D\x9d1q\x96\xaeu\xebZ\x11\xd7\xcf|\x00\xd6\xb0\xc3\x97\xcc\x03\x87\xc3\x81\xa49&\x0c\x1b>\n\xe6\x8cL0W\xb3g\xffs\x14\x17/~\xaf\xa8\xc0\xc4I\xf1\x18=f,\n\x0b\xf9\x9b(\x91\xf9\xafXR\xb0|\xc9\x9b\xbc\x06\xa7\xa4\xd7%svi\xba\xee\xf5\xc4\x19:Vq\xfc}\xfcQ\xf1\x11:k\xcc\xe8\xaa5\x03\xceZ%]@\xf03R\xee\x94\xfc>\xbbC\x97\xd3}N\x1e\xdf\xf6k\xa9\xf8f\x9b\xc4k\x80Q/$\x1aE\xe1\xf8\xa7\xe6ih\xb9\x92\x8a]\x9b\x0c\xd8\xb5y\x16\xda*S\xd1e\xe7\xef\xaf\xd1\x97\xc7\x1f\xad\xbf\xba{\xf7\xe4d\xf6\xb5\x81\xeb\xedj\x03\xbb\x9d\x0fVk\xc6\xa5\x9f\x92\x91\xb7a&\x0e\xef\x9e\x0d\xc7\xd5T7\xbf(\x9c\x97\xf4B\xed\xe2y\xf1\xb8_\x9d\x86\x8e\xaat\xef9e\x7f&\xc2q-\xd3}\x17\xda\xab\x80\x8ez\xc0Q\x01\xd6X\xe0\xed\xfbA\xc9\x00\xbb\xb3\x17h\xf9\x05h-w\xef\x8f=K\xe6\xda\xb3M\xc2\xeds\x1f\xca\xbd\xf3=\xbeV\xbaP\xb9\x07\x0e\xa3\x18g\xe5\xfa\xeaeSq\xa4 	\x1bW'(6\xa4\x98&\xc3\xf2\xbd	\x1d\xb64?\x1es\x8ft\xfe\xd6xl\xb2i\xb2\x9co\xaeq\"\xf6f\x1bqpg\"\x16\xcc}M^3$\x08\x97\xf8\xf9K\xa2\xd0\xa9pzG\xbd`WtS\xe2Dd\xaf\xd3\xa3xO\x12.\x9cL\xc6\xad\xb3K\xd0|\xe9cy\xcf\xb8p\x9d\xafq\x1b\xf7\xe1\xbe<F\x897\x8a:\x9b\xa2\xfb\x8d\x8c\x9f\xbf|\x07\xf5\x13&Iz\xdd\x8f\x92(T\x1bE\xa1\xcc(\n\x0b\x89(\xd4\x90\xa0\x9bm\x10\x85\x9a\x00\xb1=\x1a\x0d\xa2`3$\x08\xef\xf0\xbf\xc0\x92($I\xa2P\xea\xc9g\x91\x12\x84i\xbe\x17\xd8=\x8cFc\xd8,1n\x8a\xa4\x17\xb2%\xbdp\x82\xd7(\x89B\x93G\x14\xcef\xcf\xdc\xee\xf6\x89\xdb\xc2{\xca\xca\xa2P\xff\\\xffFX\x88\xc2zK\xb2\xf8\xa7\x87\x00\xe0\xeb \xe2/\x14\xfc;E\x8c\xe7[E\x08E\xf8y=\x88~\xb1\x03I\xa3\x1dJcF\x8f\xa0Dq,\xff\xbd\xa0\xf4\x05q\xb4<e\x1c\xadL\x15\xe9`\xf6[\xf4m\xb6Di\x1bK\xe9\xf4~\x916\xef\xdcG\xfb\xf6n\xa0\xd3E\xcb\xa8\xe4\xc8\n:w<\x93\xca~\xdeB\xe5\xd6\\j\xb9\x98F\xa8\xca \xd4d\x10j\x96\x13\xea\xd2	\xb5\x99\xc4\xea\xcct\xab\xae\x82\x1cm.j\xbf\x0fru\x82\\\x0c\xbcP\xffR\x82\x08\"\x88\x7f\x18Z\x95\xda\x0f*m/\xcdc\xfa\xaa\x07\xf4\xef\xaf\xcc\x9f\x8b\x1c<8R\xad\xcc\x07\xa8\xd5OGF>\xa5\x1e\xf8\xac\xdb\x1e\xab\xd1D\x87\x84Dk4\xb1\xee\xf9\xa0!\x14\xaaV\x0f\x1aL\xa1j\x95V\xf5\xbf\xa8A\x1ay\xae\xa1\xd0\xa8>*UTD\xf4\xf3/D\xab\xd5\xd1\x1aMtD\x94J\xab\n\x8f\x88\x8a\xe2\xec\xcf\xf4\xfb\x7f\xb8J\xcb\x11\x1e\x1e\xa6R\x85\x85\x87k\x03 +\x84\xa8\xa7\xa2\x80\xeb\xf7\xfa\x12\x01D\x7f\x0d\x00PK\x07\x08\xf7\x871\xc7<\x05\x00\x006\x16\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0e\x00	\x00public/fuzz.jsUT\x05\x00\x01\x80Cm8\xd4WM\x8f\xe36\x12\xfd+\xafuh\x90h\x86k\x0f\xf6\xb0\x90Fi,\xb2\xbb\xa7\x04\xb3\xc0\xe4&\x18\x81LS\xb6\xda\xb2h\x90\xd48\x8a\xc7\xff}Q\xd4w\xda\x9e\\\x82\x05r\xe9\x96\xe8bU\xb1\xea\xbdW\xd4S\xd1\xd4\xca\x97\xa6\x063\x02\x05\xc7\x15\x91\xd9\xbei\xe5#\xa4)|{\xd6\xa6\x80\xfe\xf5l\xacwx~\xbe\xf7\xeb\xc9\xec\x9aJ\xe3\xb5\x7f\x90\x83u\x8a\x82q\xc4\x88\x86\x18\xf3M;]\x94\xb5&\x8f\xdd\x93\xccO;\xbc\xf6/,\xdb\x84d\xe2o%\xf3:\xa4%\xff\xd3\xfc\xf6[\xfbY\xe7V\x1d\xc6\xa0\xe6\xce\xea\x8dEM\xddE\xd8Ex\x1a=^\xcazg.x\x1d\x1eb\xf8C\xe9\x04\xa6\xdaP]\xac\xf6\x8d\xadg\x8b\x86V\xbf\xe4\x16\x05R\\o\xc9\xf4\x93b\x9e~+\x0b\xb0\"\xf3\x1b>\xee\xcd\xfcf(O\x12\xb6ZJ-\xf3\x1b\xf2\x802\x86\x17\xa8b<\xad\xc5p\xb8\x18\xd7\x1bn\xc9\xe0\xc1\x90\x07\x95W\x15\xb3\x83#\x01+0{S\x9c^+\xa4xZ\xcd~\xc0m\xf0\xa1\xe4	)\x8c\x80\x92\x8a\xc2\xd3\xc3\x0e\xe9\x94~\x80\x82@8\x83\x92\xa6G\xc6\xd7\xaf\xf8\x14\x9a!\xbb\x12\xfe\xd7\x9a\xb3\xb6\xbe\xed\xad\xaf\xd0us\xd26\xdfV:\x0e\xa1\xf7\xda\xc7\xf0\xb8q\xdc(\x84]\x86 \xe7\xf7\xdb\xf1\xb9=mME\xd0\xe8\x9e\xa47\x9f\xbd-\xeb\xfd\xcf\xf9\x9eV\x1ff\xf1\xde\\\x84\x0eU\x8d\x8e\x11\xfd\x14`\x1a\xe1\xc6\xc5c\x17\xd1/\xbfh\xd7[\xce6?\xad\xc6c\xf8w\x95\x1aZ\xbd\xc63\nJ\x90\x19\xa4P\xccp.\xf0\x0fZ\x1c\x01`\x92`\xf9\xf7\xc1\xf2\x0e\xbc\x0dy\xe8\xfe\xc8)\x99\xb9\x07\xc2\x0de\xd1\x1fBY\x9d{\xcd\xea\xa6\xaax\xe7^I\xcb\xfc\xc3Sz\x81h\xa7\x8b\xbc\xa9|t\xafm}\xbdL(\xd4\x871S\x17Z0\xa7\x8d\xe1(\x8c\x05\xa3|,\xca\x9a\x16\x94\xdcQ\x00;'O1c\x8f\xc9\x8a\x0dnr[\xd6\xbb\x90\xb1\x80\xe5|D\xb7\xefJ\\#}D\xb3w\x85\xc1\xeb,\xd2<\x90\xec\xcf\x88\x1b\xe2\x07&3^Q\xde\x85@\x94G$<\x02E\x97\x89Yf\xd27\xbb\xcf\xb6\xaf\xff\xd9\x1ao\xa8y\xf2\x90\xbbO\x97z\x80SG\xd3nOpvF\x8a\xe8o\x91\x80bJ\x92<\xae8n,\x9br#[\xe2/\xae0\x03\x9f\x03\x92\xd6\x81Bw\x0d\xa3\xc6iPo\x94\x8ff\n\xe4\xfb\xc0\xd7\xa9E\xc4\xf5U\x02\x85\x8f(d\xa5\xeb\xbd?$P//\x83\x8a\x11\xa4\x8aLm\x12x9Q\x19\xe9\xf2\xf5\xeb\xd7\xa0N^*S\x17\xe5\xbe\x19\x8c\x88\xf0Q\x80NDP\xf0\xd4'\xe6\xe5\xc5\x96~\xb4\xf8\x16\xf1\xbc<\xea6h\xce\x0d7R\x0bV\xf0\x99D\x8eG\xa7l\xc77\x13^\xef\xcc0\"\xd9\x133(k\xe7\xf3Z\x11\xaf\n\xce\xe1\x0f\xd6\\P\xeb\x0b~n\xcf\xfa\xdf\xd6\x1a\xcb\xa2\x1f\xf2\xba6\x1e\xd4-\xe4PU\xee\x1cr\x87|\x0c\x13Q\x9b\xba\x89`(=*&\xf5I\xc0\x8e\xf8\xa1\x11`D\x90\xf3\xec\x8a\xa3ncD{\xed\xff\xa5\x9d\xd2\xf5.\xaf\xfd\x80\x8ah$\xd8\xe0\x1elh:9\xee\xc8\xd3\n8\x81\xad\xc0Q\xa0A\x8a\xdc\xee\x9b\x93\xae\xbd\xeb\x1b\x87\xef\x89\x99\xcf\xf8b\xca\x1dVxJg&\xd9\x87\x0d^\x97\xaf1\xb2M/\x0d\x83X}\xb7F\x9a\xa6`-\x01L\x96\xf5N\xff\xfa\xa9`\x91\x8c8\xc7k\xc0\x82B\x0c\x16\x1e\xa4\xabJ\xa5\xd9J\xa0\xa5\xd92[j\xf1\x825\xe7\x02\xc4e\x92\x06\xe6\xfa\x81\xc6y\x08c	0w\xb4\xa3\xbbK\xd4\xcdi\xab\xed\\S\\\xb7-\xca:a\xc4?\xad\xcd\xdb\x0d]\x1c\xd2\xf7|\x1b\xe6B\xc74\xc7{=\xda\x12\xd0\x05\x8eH1\xd4+\xc1\x16\x1fqL\xb0}y\xe1F\xde\xed\x0cs\xd9v\x13\xaa\xdf\xf0\x04\xbar\x1a\x96\xb2|h\xbe\xb4m\xe4\xb9q\x07\xe6\x08!\xf3\x85b\x92\xb7\x86\xb0\xbd\x11`DE\xaa\x18'\xff\x9e\x15\x93\x86\x10\x12\xa8\xc4\xdd:\xa9\xa3 \x9db|\xc6\xed\xf6\xaf\xce\xed\x1d+\x163\xe8\xae:;\xdcz\x01p\x7f$\x00\xc3\x1dl\xc4|\xdfu|\x8f\xd5C\x96\xac\x96,Yu,\x11P\xf7\x1d\xad\x1f:Z/\x1d\xad\x07G\xfe\xcf\xe0-]+\xff\xaf\xfa&:\xca\xc9\xd2\x85\xffLq\x82E\xa7\x03]q\xb2\x0d\x17\xe1\x82,\x0fy\xeb|\xae\x88hE\xbft\xd4-\xb5K\xf5\xaf\xe6Lmr\xd3e%w\xae\xdc\xd7\xec\n\x95;\xfdY\xd7\xae\xf4\xe5\x17\xba/\xae\x05\x9c\xb1\x9e\x9eh\xd6\xf9\xa5\xd0\xfa\xdf\x0b\xad\x9f\x0bm\xe9~\xca\xbd:\xdc\x93\xd6\xd9HU\xe1 4\xd1\x8d\xf4\xe6G\xa3\xf2J\xffh.\xda\xfe\x90;\xcd\xc2\xccOQ\xdc\xfb\x89'\xd3\x14\xa5\xc0\x85t\xe7\xaa\xf4,\x8az9\xa4\xcf\x15\x12\xd2\x95\x08`]%p\xf8\x08?\x8a\x8f\x9b\xa6,\x89\x93\xcf\xdc&y\xa7\xc2fT\xe1-	-\x1f\xaf|O\xeb\x04\xb6\x13\x13\xd2\xdf\xf6\xe5e\xba\xcb\x9b\xa0\x8c\x05^\xb1F\x0c+\xad\xde5J\xb3\xf7\x88\xe9}\x19\xbctw\x9b\x0f|.F\xe3\xccr\xe1+\xea^%\x87\x03\xfc)D\x8b\xa2\xee\xfcQ\xa7\xec\xd3\x05y\x81\xabY\xd9\x89\x95\x03\xa9V	<>.M\xc7R\xfb\xa9\xd4\xd4\x91\x85Q\xe6\xfb\xb2\xafB\xd4\x11\xb0\xfd\xe6a\x1f\xb5\xd0\xc8\x1eT\xac\x15(\x96h\x96\x0b\xecrj\xf6\xf33T\xd7\xa1+J\xafOq\x98\xe0\xcaX\x1d\x07!\x1bf\xc2x\x1e\x82\xc1\xaa\x9bJ\xbfO\xa3\x1bR\xf3\x9b\x1b\x11\xcc>\x98Bm\xcf4\"^\xb6%j\xd2}\x81\xe8\xf4\xd6\x85x\xa3\xc17\x96\xe7m*\xcfeq\xccc\xf6\xb6\xf9\xe3\x93R\xf1.\x94[\xd3_\xf9\x1e\x9e\xfa\x12\xe4{ku~$\xa0\x85\x9d\x0d\x9f-\xf4\x80\\\x84#\x05\xe8jIO\xdf@\xb1\x0cQ\xf0\x1dQ1<\xd1\xb7\x8a\x92\xa7\xfc<\xdf4\xc7\xbd\xa4\x04\xc3G\x1cn\x9b0w\xdb\xf7s\xd7\xf7\xebt\x0f\xed\xe7.YO\x9f\x12<\xf9\xdf\x00PK\x07\x08\x9d\x9d	\x9f\xeb\x05\x00\x001\x11\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x11\x00	\x00public/gologo.pngUT\x05\x00\x01\x80Cm8\xec\xdb\xe9;\xd4\x8d\xff\xff\xff\xe7\x8c)\xf3BY\xc6V\x06S\x93!\xa7e\xd4\x14\"[bb0\x8aD\x8b\xc1)\x12\xca.[\xd3\xd8\x97\xa1\xce\x94I\x92\xa5d)KB\x94}\xeb$\xb2$\xfb\x9e5E\x8bl\xe1w\xbc?\x7f\xc2\xef8\xbe\xd7\xce\x0b\xcfK\xb7\xe3x\xfe\x05\x8f{4\xdd\xd4p\x17\xcf^\x1e\x00\xd8u\x8a\xaa\x7f\x1a\x00C\x02\xe0\xca\xc5\xee\x04\x00\x9d\x10\x0b!\x00@\xaeS\xad\xbd\x00\x94\x88\xff;\x94\xffSm_\x00\x02\x9c\xd2\xd7\xb5\xf0G-\x0c_<}\xc1\xf0\x93\xd2\x98g\xe4\xa2\xe5(6\x8c\xd8^\xb9\x84\x0d3$\x04`\xea\x88\xe4\xaa\xe3\xaa\x06D\xf2\xde[\x0c\x8c\xe2p\xca\xf9z,\x1f\xd1\x99s\xcb\x05s\xcc\xfc\xab\xe5\xe3<\xc1\xa8\xf5\xfe\xc0\x82?7R\xb1z/\xdf\x10iSJ\xff<W\xb5\xca\xff\xf3\xad5I\xf90\x000\xf9\xb6\xc9\xf3\x00\xa0#Z\x8b\x01\x80\xdb\x06d\x14\x00<\x11\xa4\x01\x00\\\xc1\xe0\x00@g\xef\x7f\xf4\x1f\xfdG\xff\xd1\x7f\xf4\x1f\xfdG\xff\xd1\xff3\xaaR\xfd\x05\xf4\xca\xd0U\x9bc)m\x97\x7f\x1ani\xc5n\x7fh=~ia9\xf4fU\xc0\xe7E\xbf\xef;\xa0\xf0\xcebU\xd0\x9a\xfd\x13Hs\xfd\x89\xc5\x9fE5\x8c\x0fT\x07\x9a\xcf\xfa\x85H\xa3\x1a\xdb\\\x8fn\xe0k\xc3;\xd0\xb0\x1a\xc3@CK\x11\x8fT\xfc\xa7\xad\xad\x8a\xda\x19\xafJQ\x9b!\xcd\xec\xb7\x00\xc7\x88\x1c`\xe2#\xf9\xabl\xf8\x08\xa5\x1e\x12\xa8\xc66\x1b\x9f\x1d\x1d\xf9\xcbD\xe7b\xec\xdf\xd2\x01\xab\xdb7\xdas\xf5\xae\x0e\xafWo\xe0\xb7\xf9\x84\x0d\xc8(\x98|\xd8\x9c\xb1A\xdeZ8\x1e\xd6\xd7\x10\x8c\x11\xb6b\xf1d\xb7\xccs\xfa]\x15]\xcfIj\x9f\x0f\xa9\x94\xff\xdc8d@\xf7Xi\x10#\xef\xab\xc5\x00\xdf5\x1f\xbc\xc3r\x89Y\xfd\xa9\xad\x97\xb9\xd9\xab\xb3\xb3\xdcO\x02s\x17M\x8c\xd3\xbf,\xdb\x84\x87K\xa9\x93\x0f\xd5b`\xe1\x9a\xa5\xa0\x87B\x14\xa7a?:\xa6G\x8c\x9c\x9aY\xb24^\xb8\x94\xf5\xc6\xe1\xfc\xf3\x1d\"y\xda\xd2R\x92(_\x02\x07\x98\xc3x\x03\x01\x8f\x0b\xf4+\x9e	a\xc2\xe4\x94Ln\xdd\xb0\xf2\xaf7\xe2\x1f\xd5\x02\x06\x07\x85\xe5\x93%\x05\x97\xfc\xf8\x8cK\xf9\x0e*M\x18\x87j\xac\x96\xd6\x97\x8c\xa8\xeaE\x8d\x0fi\xab\xf9fe\xa0\x8f\x128\xc0|\xd6\xd6\xcb\x8a\"\xda\xe9[\xba\xf7\xea\xf1H\xf3\x8dUo\x8c\xfc\n\xc2;\xb8\xe4\x95\xcff\xba\xf0\xf8'3\xd0P\xe5+d\xd7 \xe1\xd0\x1d\xd6\xda\xef\xea\x9b\x1f\xc3j\x7f\xce-\xe8\x8e\x96\xaf\x0f\xc6\x08\xe7V\x8c\xa1j1\xf0g\xcaY\xf1v\xe1\xdd\xbe\xac/%\xf2<\xcem\xb56>I<\x05?\x86\x0b\xda\x0c\xe5\x0f\xce\xa62\xd0\xccTI}q\x8f	\x12;_A[ \xa5\xb1\xfd\xa9\x83\xd4\xc7\x05\xff\xaf\x06\x04\xafs\x95\xee\x99\xb9\xad)\xfae\x18\x1c\xfcr\x161\x90\xbb\xf6mp\xbf\x16_\xca\x06YGf\x9a\xbf\x0ek)\xe8f=|\xe8\xf3\xec\x9eaA\x1at\xc4Z\xfa4\xe44j\xf2c\xe5\xe4\xfa\x03p\x97\x82\xaf\xad\x17\xe5f\\\xff\x15\xa7\x84%\x81\xf3\xb0y\x98\xa0\xe8/MC\x1d\xddS\x94q\xc7\xd2\xa3\xcas'u\xbb18x\xe5b)9\xee\x1eRZ2\xafk\xf7\xec\x9f\x9c+u\xade\x17\x9a\x86\x0e\xa4\xa5\xa9\xb3\x10\xb09\x94@\xd6;l\x1cq]z\xf1\xe9_\x99\xffFQ\xc6\x1d\xcf\xa3y\x92\x89\x1cH\xdb\x91\xa7\xa3\xb7\x8dm\xba.)\xbd7\x18\xcb\xa06\x7f\xaf\xf2\xb4\x08\xbd\x88\xc89G0\xd0\xccd\xe4\x00\xa7KcS\xb6\xefn\xaeR\x13!--\x80\x85\x80\xcb\x81\xdb\x84\xd6\xe0\xb7\x8bZ\x01h\xd7q\xf2\x88\xea\xb5]{\x89\x15q+\x0f\x96\x12\xe7\xb1$\xc8\x8e\xd6a\x9d\x0e\x8aYI\x974\xaa\x9aR\x1b\x91-`\x0b\xe1\xac\x87'<.\xd7\xe4D3\xd0L\x9b\x94\x06{c\x82\xeeA\xd1\xa2U\xed\x15}\xff\xb1\x03#\x12\xf1Wo\x9f\xf4\x11\xb3\x14\xa4\x01\xbdbru\xcc\xe9\x96Y\x0e\xa5\xf9\xef\x93\x04\xdd+\xef\xcd,\xc6\xa5\xa5p\x10~,\xee\xea\xdem\xc7;f\xe3\xf7\x1d\xd7U\x8bV\x9f\x99\xe5\xf1\x87H\xbf\xb7\x12\xbe\xac\xae\xbd\xa9\x81..\x8f<\x91cA\x03z\xf2\x0d\x0b\xaa\xb3\x90![\xab1\xd2\xa2mf=M\x8f\x95<\xd4\x1c\xf8\xbb\xe5L&E\x9e\x04r\xff\xe6>H\xa3\xb3l*\xa3\xe7\xa3\x89f\x85W\x9a\xe5\x0fdw\xfc\xa8\\7\x0c\xdb\xce\x95\x95\x8b\x89A\xc0\xa8P\xb3\xd4C\xe5a\xdb\xa5\x1b{)\x9f\x1f\xb5\xaf\xb6\xa9\xc78\xcd\xdf\xdf\xe5\xf2,\x80\x95\x81%\xc1;J\xc2\xc9-\xe5T4\xbb\"~\xd8\xc7Pc\xdeW\xe0\xcd\xfbk\x01\xd2\xd8\xe4\x9fZ!\xfc\x0f3\xa92\x1c \xb4\x9a[\x9c\x92\x0b\xcd\xf2Y\x114\x1d\xf2\xef\xeay\xd0,X|\xc6J\xa4\x9b\xec%<\xf7\x8b\x03\x0c\x97\x9f\xd8\x03[\x02F\x1d\xd1I\xe6J_?\xc5W<\xd7\xca\xde$%\xa6y\xb4\xaa\xcf\x9b\x19~x\xf7\xc4\x1e\xcd<\xa8\x98w@\xcb\xe6sw\xd1J\xd1\x86i\xf9\xee\xfd\x9a=\x86,\xa9\x8b\x05\x17o\xf2\xde5WA\xd5\x08\x9f\xb5bn\xedKL\xf3\xaan\x88\xbc$\xf9\xbd\xd5[\xd4RH\x0f	X>\x16\xb23\xbe\x92\x8b\xf3O\x0c\x02M\xbe\xe2\x96B\xf1n5\xa3\xee\x99,j}\xf9\xa1\xd7{\xb0U\xd3\x97\xf7\x912\xed\xd1L\x95G\x8d\xe5\x96\xe9\x98?eV\x9d~\x13\x1d\xaf\x9f\x9co;\xa3\x87\xac^\xabn\xd4|Z\x17\xdb\x99\xc3\x01\x06_\xbbs\xfdeQs\xf5t\x89\xad_\x16\xa7j.\x0f\x0d\xd4\xceZ\xd0\x80\xfc\xca\xc1\xe9\x95\x9d\x83\xb2\x85\xe9\x13\xba\x0c\xea\xa1W\xb1@&\x8e\x06\xe4'\x8e\xa1\xad7\xaf\\G\xce[\x87\xe3\x0c#\xdc\xbc\xb9U>U\x1f\xd9:f\xe7\xdczc\xfe\xc4\x9f\xd4\xc8Z\xf5\x16T\x8d\xc0t\xe7\xd8\xe9{\x98\x85\x99\x19\xb5K5K\xf1\x0e\xae;\x9e\x15>63?\x9e~C9T\x90\x06\x85\x05\xf5}\xb7^\xf5E\xdaae#\xfc\xa4\xacJ\xd0\xf5\xd7\x8b\x05B=\x13\xb9\x98\xc1)\x0d\xean\xe9\x18\xbe\x99\xd0\xe2\xa1P\xf5\xa8TU=D\xfcS\xdej\x7f\x97t\xa4n6\x07\x18V\xad\x92\xc3e\x06\xfc\x06\xe3\xa5\xf1\x9b\xea\x15\xa8\xc9\xc3V*X\x11\x1c\xb8^\x952T\x0d\x13\"K\x95\xf9,\xcc\xd0\x88\x978\x0d\xbc\xaa\xbed\x8c\x08\x0e\\\xeb+\xb7\xc9\x8ao\xee\x0c>h\xc6/ge]\xdb\xbb7]{\xb7\x06\x8e\x06\x85\x07M\x94\xf65\xef\x0f\x90q>\xb4\xe2\xc6:\xf3N6\x85!\x1b\xef	\x85\x88\x89\xd2\xbe\x0f\xb9\x16\x01\xdf\xf6h\xce\xc95Q\xe5P\xe5\x94EGY\x16\x02\xa9^{yw\x11>\xfc\xe0Z1\x9c\xdc\xce\x9e\x8aL\xd3\xc6<\xa3\xf3\x8dc\xce\xe3h\xb0\xa4\xd8\xea\xf9~\x94- <^\"4W\x90\xc2k\x9aN\xadCJ3\x9a1`\xe6!)\x97\xf8\xef\x9eo\xd5\x9c\xcc\xb4\xa0\xe9\xbf\x8e\xee\xe7\xc0X\xfa\x8d\xc4\xb8\xea\xa3\x87\xe8\xcf\x1fg<\xd3KxoN\x17\x1b\xc7|\x10\xa2A\xa1{\xfe-\xbcEz\xef\xc3c\xef\xcd\xe9\xb2\xe3\x98\xd1\x03\x1c`4\xb2\xa4\xe7=%\xac\xfe\xfe]\x87\xdf\xb1l\xd3\xf0U]5u_B\xe7\x1f!\xe6\x83\x1d\xda\x16s\x96\x94\x9b\x0b\xb5\x87\x98\xe5\x87\x0duK\xce\xf6J\xad\x08g\xbd\xdc!\x82\x03\xacL\x9a\xfb\xb6\xeb\x1f\xf9\xcezN\xe6\x05n\x9e\xe57	\x8f\xe7F\xe2\xd3\xbf\xf3\xd5btF\xc3\x84\xe8\xd5\x8d6'u\xaf\x86	u\xa4\x0e\xaa}\xda\x972vS\xbd\x05\xd5\xa1\x9c\x8ey5~2\xd3d*L\xa8#+5>\xbdh_-F\xe7q\x98P\xc7\xfd\xa9\x9e\x07N\xbb*\xf7\xa7c^}us\xf0\x12\x0d\xf4L\xe4J\xd3\xd6C\x9c{\x13vyW\x8a\x19\xe9h\xdb,\xde\xa9\x8f\xc0\xe0 X\xd0Hg\xd0\xa5Z\xcfB\x0e\x15\xec\xc1\x96\xdb\x1e\x97\xe3\xd6\xb1\xd5\xe2\x91I\xbbR \xaf\x94\xa0%`\xa4\xe3w\xf6\xb8\xe7\xb4T\xf0(\x06\x07\x9b\xaf\xa3\xbcfu\x06C\xf2\n]\xec\xa5js\xe8\xe8\xf5\xcc=$\x16\xc24\xbd\xa0\x9fy0\xed\xf1\xe35\x1b5\xfb\x9d\n\xbdr\xb2\xc3	z\xcd\x18\x1c|\xd5\x101\xd2\xf1sQ\xef\xda\x97\x08g\xdb'\xa9b\x92]\x7f\x84\xd2\xdcZ\x87#\xf9SU\xbc\xaf\x08\x1a\x1e\xfb\xc6\x13\xf2=\xb7\x9c\xe9S\xa2\xba\xe4h(O\x82\x01\x9f\x03\x89\xcc\xfcJ\xb9?C\xa5t\xb4\x7f\xf6\xf4\xce\xaa\x05\xddF\x8c\xce\xc3\x0b\xe5\xdc\xcf\xff\x08\xff\xd4=u\xdf\xf0}\xc8J\x18S\xf6\xcf,Og\x15\x96\x04\x03\x87\x13l\xa7\x95\x8f\x9fg\x1c}8,\xe6\xeb>(xxL=\xde\xdb\xbb\xc9|7\xf3\xf7\x05D\x86yD9S/\x1d\x13\x99\xbf\x03st\x8cj\xe8dA\x83W\x8d\x0f\xdc\xc6Tb\xb3\x93WS\xd4\x8eq\x9b\xb7ME\xed)<hx\xecX\xba=:M2\x7f\xc7\x8e\xd4\x1f\xfb\xcb]\xeco4,d\xd2\xd1}\xff\xf0\xe4\x99fs\xc0\xb9\xfe\xc7\xcexmM\xe3\xb1=\xda\x07.JW\xf0\xc9\x8c\xf8\xab\xd5\xec\x0e\x11\xa1\xbf2k\xc4\xe8l\xee\xb6\xef\xe4_J\x89;<\xe8\xa4\x870\xf8\x9d\xcc}N\xd6[\xd0\xe0\xa3\x9bT\x9b\x92\xed\x8d\xf8\x9a\x88\xac\xe7\xc9j\xa9m\x97\xb7\x7f0\x98\xc7/6\xb1\xb5(F\x8d\x18\x9d\xe1\xf6p\xa1\x9a\xfbY\xb9\xc9jq\x11oa#\xe0\xcbzGi\x0e\x07\xb2{_\xde\x19^L\x95.\x0c-\xca#Yx\xa1N\xbc\x89O\xb5\xa1P\x1b1:\x8fv\x87\x0b\xd5H5\xe5'\xbf\x160\x02\xae@E\x8esR\x0c\xc2\\\x7f?i\xba\x98\x94*Y\x18Y\x94K\xb2\xf0B9\x94'd\x8dR\x0c\x1a1:\xc1JaB5\xd0\x94\x9dLo\xdb\x117\xf7\x94\xc4q;\xad\x82\xa2\x8bi\xdc\xa9\xb9\x1d\xf1<\x99\xde\xb6\x83\xa6\xdb\xa3\x1f\x8a\xc1\xc1\xf2\xb9o\xfa[s7\xbe\x87}\xd9\xb5\xf3\xf2\xb7!m\xfe\xb7	5^Xi\xa7\xe2~	.>\xda\x98S\xd9\xd6\xab\x93d\xdd\xbf\xa6\xf3\x05\xec\xdb\xec\xde\xcaF\\\x17\x8b\xfd\xfa\xe1_\xae\xe7\xf5\xc7W\x8a$\x82q\x8b\xadoV7\xf2\x17\xdb\xeaK\xca\x7f\x12\xb6.\x84T\xce\x86<\xd9x\x195S\xbd\x88\x7f\x96\x1c\xdb<\xd4\x14\xf8E~\xab\xac\xe7\xb1\xdf\xf9\xb3\xf3\xcf\xf7JIi\xae\x1erZ6\xee\xd1w\xc0\xe0>\xba\x97gk%\xbca\xdb)<\xbe\\\xdc\xef\xf1cVit\xa6\xfa\xc0M~\xda\x97c\xa2\xdf\xad\x02w\n\x0c\xda(\xfeN\x980\x16\xffTe\xeb\x10\xdf\xdfc\xf7\xd6,Xga\xf0\xeb@P\xc9zr]@a\x80\xb0\xaaj\x07\xb7%n\x97 \x8d~\x98w_\xd6?\xc1\xd7~\xff\xa8\xde\x8c|6\xfcv#\xbb}\xedui\xde9\xd6\x0d\x8c\x97\xe1\xf7g\xeae\xc77\xbei\x8e`\x0b+^I\xa5\xde\xa78\xb5}b\xf58,\xb6e\xe5$;G1X6W\n\x1b\x13C\x8c.x\xb7e\x0e\xf5D\xd6\x1e\x1dnr\xff\xd0|'\xdf\x0d_\xff} i\xc5Q#d\xc8#\xf2\xfc\x11+\xedo\xbd\xac\xee<t\xbc\x9a\xfb<A/\x06\xd7#l\xd2r!S\x85P\x8b)\xa9\xf8\xd1\x1a\x1e\xfd\xb7\xf4d\x07-\xffx,\x96\xa8DR\xf0\x91j=\xf5'\xc8?\xe1\xd3\xb5\xa4\xc6J\x97\xfc\xdf/\xea\xba\xf3vN\xe7Y\x87	7w\xe2\x1a\xa7\xcfJ\xbc\xcc\xb3\xd9\x13\xc5`\xed\xed\x7fd'\x7f\xe2H\xf8`\x8f\xe7\xf7_\xcafo\xf6\x94\xa9\xdc,\xf1\x97\xfaM\x10]\x97\xcd\x95\xcb-\xf9Z\x92./6\xe8#\xedeir\xef\xc2\x1b\xb5\x98\x1b\xc6\x856\xd5\xfb\n\x8e\x93\xa2\x19\xac\x81\xd5\xb4\xa6\xc4C\x0f\xe5\xd5\xeb:\xe3[K$\x9b?\xbdX7OY\xab\xb1\xb9wQ\xbe\xdeU\xe8\xd5\xd3\xa7F\xf9n\x92\x0d)O\xef\x04T*\x11\xc5\x9f\xd9\xb3\x10\x97\x8c~\x993-J\xbb\xbc\xc32C\x92\x8a\xfa\xbe\xfa\x88\xcd\xe8\xeb\x98\x87\xaf|O)\xcd\xe1\xf3\n\xcb\x94\xec\xbdzK\x7f\x11#\xbc\xdc\x92\x7f\xdd\x11X\x88\x8bJ\x02]\xcf\xc91\xab0\x8a\xa0\xf0\x85\xebiKZ\xc5\xb2\x14U\xf3@\x9a\xdc\x87\xe0?\xa6\xc3S\xc1\xf3XC	\xa5\xcc\xf9\xa7e\xf3Q&X\xb9\xf9\xcf\xf3\x1d-\x08)\xbb1\xdcV\x80\xe6<\xaa\xb1\xb9b\xd7i[\xf0E\xad\xc05}\xfbu\xf7\xb9\x85\xbc\x80\x87#\x13\xb5%\xf1\x1bo\x0d%\xc7\xb3{\xed_|`\x8f\xfd\xbaa\xa8r\xe9h\xe3\xfcu\x89\x87F\x16Fd\xdd]\xf7c\xfbG\x8f\x1f$\xfd\xb3\x19\xdb\x96Q\xad\xb5b.2V\xf1r\xa6m\xf2\xb8\x92\xb3T\xefm\xdd\x86c\x86j\xf3\xafx\x0e\xb4\xdf\xabX\xd4\xcf\xb6I\xb8Xp<\x0bX\x88\x91\x93`\xc3\xc7C7s\x14\xb9\xeeh\x1f\xff\xee\xf2\\t\xc3\xa9\x92\xbfaw\xbc}\xefY\xc7\x0er\x13\xcf~\xa9\x8a\x9f\xefs\x7f\x9a\x12T\xa2B9\xc5\x17,mKW\x04\x0f\x98\xef\xab\xc5\xc8x\xfd\x85:\x13\x98\xf4g\xdfO\x83\x7f\x9d\x8fh5N\x18\xaa\xff\xfa6]\xd4\xd1\xfb\xd9G\xf51	\xbb\xff\x93\xe2L\xd3(iu\x91\xccW\xe9\xde\x94\x1eS\xe6\x1a\xbb:\xac\x17\x8e\xc1%\xbe\x8f\xa4dyKk\xec\xb7V\xf0\x9d/\x8cr\x90\x90\x0e\x94\x8eB\xddX\xbc6\xbd\xaek\xd9\x90x\xdfk\xb2\x9aWZz\xcbS\xf2VX\xa6\xe3\xa4z\x1c\xbdgo	m\xfb\x97F\x85)\x0b1rt\x8a8\xa8\x98\xf1J\xf3\x0b6r>\xedXP\x0c]\xef\xd4\xaf\xa5\x0b\xdc\x9b%\x83\xdc\xba\xfa\xd3\x1b\xdf\x8c\x90\xc0\xef\xa2E\xbe-.CC\x12\"\xb6S\x15q\xa3=\x16\x06d\xdd\x1c\xad\xe4\x85\x07TD\xf5\xe0k\x9f,\xd7\xdc\x03Y\x1e\xe2\x0eCW\xf9C7J\x04&\x1ctQ\x9c/\xb1\xad\xb2\x946\x07\xe2\x0b\xcdx/\x0b#\xb2n\xce\xf4w\xe3O\x0du\xe7\xbe\xed\x8f{\xf7m\xe4\xf9\xb8c\x7f\xbe\x83\x92\xa7i\xe0\xde\x9f\xab\x8c\x91\x13\xd1\xbf&t\x05\xcc'\x02\xdf/7:\xe2\x92\xef\x8f\x1f\x90\xb7\xb4\x08\xbaW\xef\x11\xcf\x83\x90\xc0\xf3\xe0\x1d%+\xdf\xc3\x1f/\x95\xe0M\xff\xfe\x1d\xdd\x17><\xe6\x99\xf3\x8f\\\xec\xa4\xb3\xa3GJ\xa6\x1e\x0baj\x1a=i}\x15\xe5\x94y\xe3B\xea\xd9\xba\xe8\x8d\xbe\x92\xbf\xd2\xcd\x1aJwg\xd8\xd4q\xbf\xf8\xf1'7I\xffN\x18n\xeazJ\xa6\x1e\x0baj\xfa\x19=\x89\xf5\x92`\xd3\xbf\xeaM\xe7\xa9\xa4\x9c\xd3\xc4\xeew\xd1B?Y1\xb9\xf1\x8dHi\xd3b\xbd\xeb\x0e\xc3M\xad\xfe\xbb\xf3\xc4\xc5\xad\xce\x0d\x80\x03\xea\"F\xc23\x87D\xef\xe9E\x1f\n\x8a?=tr|t\xc6g\x81\xb1\xfd\xfa\x93\xf4\\\xc6\x0f\xfa\x04U\xce<\xe5\x0b\xe7q\x98\x90\xe3\x9b\xe8\xc7\x8f\xbbf^H\xe1W\xb4\xa0\xc4\\\x05E>\xde\x1bz\xdf\xf0\x8aw\xb9\xd1\xe0\xad\xb7\x17\xaf\x9e\xeb\x9fwO\x19Qu\xbc!mwt\x99\xae~g\xf1h*\xd7=\x01\x1a\xd0\xccN\x94{\xce\xba\xd8F\xbe\x0fQX\xe1gE}\xfa\xcb\xe5\xfe\x9b\xca\x9b\xc2K\xc1KI\x8f3\xd1\x91\xebX\xd9\x1f\x9b	\xf2\xef\x02\xb0$\xc8zj\x93\x81\x8f]\x15\xc8\xa2T\xecn\x85\xb9z\xcc\xe8\x15\xe3\xe0\xfd\xbe\xe9+&{\xc2\xb2\xdc8.#\x13\x1e\xac\xca\x98\xa0\xd1\x0d\x00\xda\x82\x85\x9c9\xc6\xe4\xf3\xbc\x97\xa0\xc6\xedj\xbe?\x8f\x94\xa5.\x8c\xf7\xff\x0c\x9c\xd0\x973\xc7\xa4_\xd1P\x9a\x88\x90\xb0\xfcG=\x1e\x83[\xa6\xab\xa0\xc8\x92=B\x8e\xd7\x7f\x1a\x0cG\xbcV\x9cX\xd7\x15\xaf\xb5\xb50\xfe\x8c3zu\xc5z\xedG\xd6\x8a.\xf5\xbat\xad\x80\x08]\x05E\x96\xec\x11Z\x0b\x13Z\xd6\x88\xb6*4Q\xdb\x17\xe5\x9f\xea\x12\x1d\xa4\x17\xed\xfb\xc8\xc2\x7f\xd3\x83\xf7\x92\x01\xb9s\xf0\xce[\xf9\x1c\x0ep\"\\\xa2\x83\xf4\xa2KS\x0c\x88\xfd\xcd!Y\x18\xc1\xc13\x8aw\x8c\xe5:\xb5\xda\xc8^\x7f\xce\x14\xdc?\x96\xcd\x01N\x84K\xf4\x05\xbd\xe8\xdf)\xcdE\xf1\x93}\x82\x1bx\xe9y\x8c\xe0\xe0\x19\xc5;\xc6r\x9d\x91\x0f\xb1gn.\x06\x13H\xbe\x078\xd9\xa11\x08\xf3{\xce\xfdw>\xd2\x1a\x96z\x88\x7f\x8aK\xb4\xa6^\xf4\x96B}wo%\x7f]X\xfe\xa4S\\\xddf=\x17Z\xfc\x91K\xb4fl\x9f\xb9\x98Q\xcd\xa4\x85\xf1\xe7c\x82F\xde3\x07BTUv\xed\xa3\xcb`p0\xa9\xa1\xd2tpA\xbf\xee\xa7)\xdb<\xf9\xf1=\x8e\xdf\x9cR\xbc7\xf9\xd1	\x87\x8bZ\xb5\x98\x9a\xd7\x0b\x06\xd3E8d\xd4\xf7\xf3}&%\xa9\x93\x18\xae\xf5\xc8p\x91\x98\xb5\x8f\x93\xcdd\xa0\x19\x17|\x89^\x7f\\d\x85R\x1d{\x8f6\x97\x18\x1d{\xceO\x92\xe1d3\x19h\x86\xa6/\xd1\xebB\x93\xbb\xf0\xd2+.\xa7\x823C\x86\xa3\x12\xafcF\xad\xe2\xcc\xc8\xe6\xa8ZL\x8d\xffB\x04m\xf1\xd3\xebz\x03\x0b\xe3\xcf\x1dqI>!\xbb\x9f\xed\xe3d3\x19h\x06\xbeu\xf8\x9dj\x93\xefi\xf71f\xdb\xfb\xaf\x03\xcfV-G%\xa4\xce\x1d\xad\x0b\xbaF7X\xd9?}\x9c\x87\xc4\x01\xf1\xc8\x9f\xf5\xdd\x1a\xd1'\xa5\x96\xdcd\xf3\xc9\xa9C%\xc4\xcb\x9a\xe3\xef\x97\xd3\xef\xd2\x8f\x98\xbf^\x0c\x94\x06\xc2\xb0n\xd0\xae\xf1\xdc\xb4\xe6\x83\x1c\xce\xa7\xc06%;\x87x\x0d\x93\xab\xe9z\xe1\xd7V\xf1\xd5\xd5#{\x9f\x98K\x8a\x96\x9a~F\xd5\xeak\xec\xde\xefq\xbc\x94Wo&Di\x8c\xa7\xd4\xc1*\xcct\xfe\x93\xfa\xc5\xbe\xbe;kW<e\xe39\xdf\xe3\x0e\xd76\xcc\xe2\xa7\xe4R2\xf1n\xe7\xb6\xea6\xfch\xcezH\x80\x95\xdeS\x96K\xf4\x15\xeb_\xf5\xd3\xef\xcd\xba\xd7\x9f$\xc7\xee\x1a*Ow\xf0\x8e\xf7\x146%\x9bWO\xa1'O,\x86\xc2\x8e\xce_\x9e6\x8b\x94\xbb\xa1\xec\xb6\xaf-\xa6.\xe3}|\xe7\xdf\xe5\xffv}\x14\x13]\x87\x90\xe6}\xb3\xef\x08\x05\xc9\x04:\xb5\xa8O\xcc\x0d=\xe4\xb2\xfb+W&\xcb'k\x9f\xf2\xf9\xd71\x99\xd1q\x93%\x1e\x8a\x83~\xb6UAs\xc1|.G\x12.\xea\xcd\xe8\x8fWo\xde~+\xf0\xf6\xa7\x97\xfa\x83\xc7\x94\x97\xac\x17\n\xbd\xbb\n\xf8\xfa\x0f\xb8\xdf\x8b/\xe6\xba\xd71\xb9\x9e?%f\xa5!\x82[^\x9f\xad\xe7\x0f\x9a:\xaa\x9e\xa8\xf6\xcbK\xfd\x81\x99\x1e\xd2\xd7\x92N\xb7?\x1d\x7f\xbd\x90w\x93\xda$\xa3\xa0\xc0ad\x98:\xb5\xdc\x193\xef\x9f9|\xef\x94Y}\xb9[_\xea\xda\x95c\xac3\xbe\xcb\xea#\xdaq'\x1eG\xb3\x10\xd2|\xb1f\xf5\xd6\xf9\xc5P0\xeb\xb0\xcd\xe0\x9fiw\xab\xff\xf4\xde\xac[0\xe5%\xbf	\xb9\xd9\xabc\x9e\xf7\xea-F\x86R$\xe9/\xff\x7f\x1c\xf0\xc1\xe7\x9c\xfd\x91\xf3\x87\xb5MfN_oI\xc5\xcf\x88'02\x18\xac\xe8\x80\xf7\xc4D\xff\xce\x0d\x9d\x1a\x1c~r\xb6\xde:\xb1\x02\xfd\xf0\xb0\xb6I\xbd\xca\xe1\x88	5\xeeaA\xda\xc7\xc9\x0b\xdd\xf2\xab\xa4f\xb5\x81\x99\x00z\x1f{\xed\xca\xde\x19\xd6\x99\x9d\x19.\xf6xC\xc5\xaa\xec\xb3A\xa3\x1b`a\xff\xa0\xaa\xfak\xb0\xc0\x98\x04~r\xb6\xdez\x91k\x89\x8fWI\xea\xe7\xcd\xf7fw\xaf\xbf\x8f\xdbw%\xd5\xc8\x0f\x83\x9b:\x1f2\xb6V\xb9\xa2\xcbt\xfa[z\xb2PB\xbd*\xf0\xb8o\x95\xa7\x80]\xdb\x1d\xfe\xd4\xdb\xdf2]\x90\x9d\xdeb\x0f\x95r\x9f\xceEd\xe2\x1d\x1f\x1a\xd3\xc6\xady\x9b\xd5*\x08\x89\xab\x83\x9a\xbe\x97\xb7%{\xbc\xf5\x90w\xcf2b\xd3\xd7\xf8\xc8o\xeb?]\xa2\x1bcp\x8e\x1a\x1f\x17\x84\xaa\xae\xf48+\xfc\x14o\xbe%\xc9\xee<xgkP\xbep\xebi\xe25\xecu\x1cw\xa6\x00\x8d\xef\xc3L.K\xbd\xc5\xbc\xf4\xe1l\x8b\xc6b0aL\xd0\xaa(6\xb5R\xdc;\xfff\x90\xd1\x93\xed\x97<2\x18;\xba\xa8\xc8\xc5\xb8\xf1\xa6\xec\xae\xbb\x8bUAk\x13\xb7\xb8\x16\xdc\xd7\xd2\xd7\xd8\xdf%z\xf8}\x89\xdb\x0e{\x8c\xde*\x8dm\xc0\x96o\x9ef\x8a`pi\xab\xf6\xeb\xc5\xbd\x81\xc7\xdb\xae'r1\xb4\xe5IM>\x8a\x89\xab	\xb8t\x8c\xf0\xbf*\x87\x9b\x9e\xb8\xd8\xfb\x7fr\x0b\x17\xa2\xc1\x82\xa94\xe7\xfb\xc1;[o\xfae\xe8\xf7\x85\x92\x95\xbb\x8f\x06\x93f#\x18h\x86\xdf\xe9\x15\x0f\xeb_\xdbS\x16\xb0\xf3d\xd7vDQ\x8e\xf8\x13	\x16\xc2\x1c.'y_\x10\xdd\xbcii\x9c\"\xba\x14\x0c\xb6g\xfem\xe8\xc3\xe0\xbcO\x90Q\x85\x1a\xbb>4=\xb5\xb1?\xaaqF\x11u\xb2\xc2o\xeb1\xe2\x9f\xd0\xb9\xab\x16S\xe3\xfe+I<\xc9\x01_\xdc_\xebzt\xe3)s\x91k5\xd9\x05\xc9\xea\x08\xec!H\n\xd2`\xc1\xe0f\xb4\xc7\xb2\x94\xd1\xfb\x0c\x1b\xb4\xdc]u\x1e\x86\x0e\x0ba\x0ecIM=\x1b\x197\xad\x15\xbf\x972\x17\xb9V\x07^\x14o\xd3f?1\xf8[\xd6_\xd0Y\x08sxVY%\x7f\xa4\x80zF\x115\xe5\xc9V9\xe3\x99\x12\xaa\x84%Ai\xd9\xdb\x83\xe3O=$\xda|fxUz\x84\xe8\xa5#\xa1g\x98\xb5\x08	J\x9f\xd9\xd8_\xa8T^\xb74\xd6)\x1fjB\x91\xd1\xb5\x98\x9a\xdf)fx\xab\x0f\xb5a.\x88\xdc\xf9\xc5\xcdk\x9cTB\x9c\x12\x96\x04\xa5\xcf\xec\xed/\x1c\xe7\xc6\xe5\xca\xa4\xb9VZy\xab\x1c?N\x9ae1\xd0\x8c@\xee&WJ/\xbb*\xdf$\xfb>\xf3G\x0e\x87Y\x91\xa3s0\x91\x81f\x04\x16\xc4\xf5;\x07\x8c\xb05\x0f\x8e\x05B\xea'\xaf\xeb</\xe8\xb7N\xcb\xd5bj|\x7f%\x89[\x15h\xeci}x\xc9\x94wU\x8b\xf9L\xbdIw\xa7\xf4\x939\x16\x03\xcd\xd0\xf0\x9a<\x92{)4\xdaQ\xee{\x06s\x91\xcb?\x99\xc1\xe2\xfb$\xe9\xadKF\x15J\xb2\x95\x9b\x8b9m\x97\xfd^T\xbd\xbeFWD\xf9\x08\xd0 N\x9f\x8c*\x94\xacm\xf0\xb70\x86\xa7]\xb6,\x85\xbd\xee\xdd;j15\xbe\x89\x16\xf1\xcff\x18h\xb9\x87.\xc8K,i\x96\xc5@34\n\xd8\xbd\xce\x01\xaeK>\x196\xe8\xdc\xb1\x18]\xe7\xd0\xdbE\xf4\xd1\xe0\xb5\x1fOWT\x98\xfb$\x1b\xbe=\xf3\xdc\x1e\x1fn\xec\xbb|\xd3\xff\xc8\x95\xf8\x99g\xbb\xc5\xf8ZW\x96[\xd1U\xfcc\x83!\xb3\xaf\xb8w\xaf=\xd7\x88X\xbeh\xac\x9c%3\xba\xa2\x05\xe4\xb4\xa9\xf6\x99\xf2\xe1\x0f\x9do\xe3\xc7d\x7fV\x98Z\x85\x1fz\x82K\xc7X\x9c\x90C\x91\xf7\xd7b\xf8\x1c\xd6n-\x87\x16\xe1\x1f9\x08\xbb}ya3\xf1\x9c\xb9\x88\xf2OmJ\xfa\xbbh\xdf\xa1\xe7'\x8d\x95\xb3\x8e\x04\xd0j\xc8A5\xb3\xa1\xc4c|S\xd4\x12\x8cEf\xf3\x96j\xa2n\xfdW\xbb9\x913\xe4Z\xcc\xc2b\x83i\x88\xaa\x82@\x93\xd7\xe0<o \x8b\x8c\x9b\x1f\xaf\xe7\xb3v\x8d\xea\xd8U\x8bY\xf8\xc0\x1d7\xf7wR7\x0f\xa9\xa9\xbb;\xfe\xf3\x1d2.7\xc3\x9e\xa5\xc0\x8a\xb6[\xb3V\xe20\xd0\xbf\xbd\xb2\xf0o\xfe\x12\xc5\xf2\x8d7\xc8\xe9YZ\xd0nO\xd5\xbc\xe3o\xf9\xe0Y\x8b\xc1\x15\xbe\xb9q\xc1\xf6_o\xef\x93\xd7\xac\x94\xd9\xcaI\xb1\xef\xcbv\x04IC\xe2\xe2='}e\x0c\xaeP\xc3*\xd4TY\x83s=14X}}\xc7\xb2\xf9\x96\xc9NUO\xbe\x82\xb2\xc1\xea\xdc\xfd\x1c\xe6p\xdf\xf2\xc2\xddG\xbc\xbf\x8b\xf8J\xe3\xd9\x04\x0b\xc35\xd5=x\xe9\xa7\x7f\xb1\x90\xbe\xb6p\x0fc;\x0fj\x9b\xa9\xa55\xaap_m\xc3\xd2s\"/\x89\xc3\xfc:\x97\xfd\xd8P]\xf1H\xf7\xdd\x9dL\x13\x84\x14\xe1$iM\xae\xc5\xb8.\x0eq\x9e~\xe7}z\xf1\x85\xf6 \xaeG\x08\\\x9d\xbf\xad\xce\x8e\xbd,H\x99\xd9\xc3B\xfa\xda\xa3m\x85s\x0e\x19/-\\\x88\xcb[\xfead\xa2\x8c\xeaKlJ\xaa/.\xb7T\xab\xc5\xb86\x07w\xdd\x18\x91\xdd^\x19~W\x9b\x7f\x9f\xf7}\x92\x01?s\x17B\x8axV\xad?\x8e\xc1\x15>J(\xeew\xb5{O*\xc1/\xfa\xb5\xcf\x9cQ\xe9\x11\x02\xd7\xfa]\xa6\xf5\xadU\x9e\xa9NA\xd7\x98\x8c\xbfT}\xdb\xed\x84_l]\x90t\xbe\xcf[#\xba\xdb\xa2\x9f\xadG6W\x13\xc1AbZ\x8f\x94T\xaeLZH\xbc\xc7!\x99\x1e\xa1\xc9\xd7.\xdfO\x96\xe8\x93Q0y\xee\xec\xad\xb7GJ\xe9\xc7k1g\xbal\xed\xfdW^T\xa6\x89\"$`\xe0\xd73\xa0\x90\xed\x98\xb6\xd9\x14\xb5\xcbt\xb2\x95\x07K\x02\x06^\xb6I$9\xcd\x9e\x95\x13/\xa3\xd9\x02\xb0'\xf0\x94\xbe\xae\x85Z\x93\xca\xeeZ\xfd\x1d\x18\x1c$\xa6\x95H\xe1d\x93\x93t\x0fcp\xb4\x93d\x95\xdd\x9b2\xf2\x04\x0e\xa4a^\x0c\xb7\xa4\xdb\xa0\x07\xf6q\x18<\xdc\xf1\x1eq\x06\x8d\xfa;08HL{\xca\x98\xe8\x10I\xef\x10\xae\xc5\x9c\xa1+\xaa\xc4;N\xb6~P\xac*\xfc\x84%\x81\\m^D*++\xa9\xe3\xfe\xd9\x82\xc3Y\n!~q'J\xf4e08H\xcc\x08\xbfx\xca*L/\xf0\x12\xc5\x7f(\xee%~\xce\x98\xb2wSF~\x1f\x07\xd2\x84\x151\xb8\x00c\x9d\x87\x16&dR\xb5F\x13\x91\x93\x1d\xc6@3\x0e\x87\x14\xb1\x17\xb3o\xf52<\x107\x17\xbb\x90\x0c\x1a\xb7\xfcW\x83)9C\x19\x0c\x0e\x123~\xc7D\x876\x058\xc2\xcd3*\xb5\xe70\x03\x93\xe5\xee\xcb\xe1%T2J\xe7\xa0\x8b\xed\xea\xa0y\xb8\xef\xda\xdae>/\x13e\xc3\xa7\x03%\xfbY\x08\x18}\xcf\xfd\xb1\xd3+_8\xcc\xb7\x92~\xa4\x16k:Y\xd9\xa8\xa6\xb5\xb77\x00K\x82\xa3~\xd7\x9e\x1b8\x8d\xf3'\x1a\xf5`N\x9cVT\xc9\x0f\xdf\x12\xf2\x11\xa4\x81\xeb\xb7\xf6s\xec\xf6:i\xb9{k\xd1\xfbr\xee\x8b'9\xe0\xef	\xd2\x80\xfe\xcfd\xcc\xe3\xa6\x19t\x157C\xf3\x9a\xbc\xb6\xbb\x81\xba\x85q\xdc\x07\x95\xf8\x16,	\x8e\xce\x84\xce\xac\xba|;l\xe7\xb7s\xe0\x99\x0bk_\xf6}\xf1\xc7\xa3\xff\xde\x13\xa4\x81k\xbbu\xe3\x95\x8f6G<&]\x0e9_j\xb3\xbc\x98h\xd7/\xb7\xa6\xfa\xc1\xde\xa2,~\xf4\x93!\x19\xa5s\xc5\xe5\xd2\xaat3\x0b\xeb\xdc]4\x89_\x98\x1b\x82\xf8v\x95x\x1e\x84\x04Ge\x83\x1f\x1b\xb4\xcd\xbc\xd66\xccYqD\x8d\xf2\x92\xdf\x8a\xe5\x92\xfa\xea\xcd\xff\xc4;\\`E\xf3 $8*\xcb\xe1\x98~5!\x9b].\xee\xe7/\xee_\xe8\xedw\xfd}0\xde\xff\xb5\xbb\xe8\xc0\xf5	\xf5\x97\x99\xbc\\\xaa\x15\x81\x86\xd7\xbe\x1c\xe0@_\xcatR\xf7\x15;\xc3\x1fJF\xbe	\x0eI\xae7\xf2W5l5nn&8\x1c\x12\x9e\xf0\xf9\xa3Y\x7f\xb4\xfdrq\xbf1\xf1\xe8\xb1\x1e\xa1{\xeb\xdfZ\x93\x94+\x13P5Gi\xc9\xddk\xba\xf7x\xae\xde\xdf\xf7Ayg\x98\xde[\xbfa\xe2y\xb5\x94\xb6\xcb7\xf7\x16\x9e\xfa\x93m\x9fa*O\x82\xd2b\xf2\xa5\xbb\xe6Jm-EtV]\x8a3\xeb_Q\xfc8'\xc2\x01/\x82\xa3\x81k\xb3\xf2\xdb9,\x89\xd3\x18\xa9\x1d\x1b`\xf4S\xaf\x11#as6\xb0\xf2\xd0\xb9\xbb\xd7\xe32\xedY\x08\xf3\xab\x9a\x0d\xf9QR\x1db\x9f\xbeV\xa7C'\xd4b>\xa8\x9f\xfez\xe4\xa0U\xd1Y\x1a\x19U(Y\xdb\xf0J\xe1\xcf\x86\xef^\xf6\xcet}\xe5\xb0gA\xf5C\xbb\xb7\x9e\xb8\xbf\xe8\x9c'q\xa0/>\x13\xdf\x1d \xa2a\xfa\xcb\xa9_\xe1\x9b\xdf\x87Gu\x8f\xbe\x1c\xa9\xc5Y\x04\x9a\x18\x97\xe8Oap0x\xa7\xc9l\xf1y\x99X\\\x95\x90\xc5\x89\x02D\xca+\x7f\xe0\xaag\xee\x19yY\x0e\xf4\xc5\xc7D\xcfU\x9e=\xd2\xf9\xe8p\xdbD\xf6\xbb\x9c\\\xe4S\xca\xec_*\xe5\xad\xfd\xae\xbf\xcb\x8b{\x87\xf0\xb5\x98\x9a\xdf\xee\xec\xfe\xcf\x0b\xa9+\xf5=i\x1f\xa7\xc3\x0fy\xd6\xfd\xc0\x0ep\x87\xaa\xa8_\x0d\xdb\x89%A\xe9\x8cZ\xf3\xafK\xf2I\xdf\xean\xed \xdd\x0b\xc8{\x99\x17\xd8J\x9f\xc8]\xd1\xa5^\xbf64H\xe0@_\xea$^\xbd=\xd1X\xd5\xacX\xcd%\xcb%\xc36\xafJm\xba`n\xeeD\x0f\xb6\x16S\xf3\xfb\xc0O\xf5D\xe3\xb2\xafz\xb6\x88\xfcJK\xe4\x13\xa56\x87\x8b\x8c\xad\xe4\x11\xbd\xeeV_,	J\x9f\xd9\xefO\xf0\x9c;\xfa\xa8\xa98|`STf\xf3\x97\xae\xdc\x9d/z\xdd\xad\xbeA+S\xadI#\xcd\xc0\x10\xf3\xeb\\a\xce\x0d7\xd7\x8d\xda?\xd9%\x9b\xdc\xd8\xc9\x19\xc4\xd2\xdb\xcc\xddpF\x9f7tG\x0b|\xb39\xd0\xc7\xce\x8d\xd6T\xbeg\xac:\xd9\xf1\x1d\xbf\xf7W\xcbF\xc5\x0d\xbd\xe8\xa0$\xbd\xe8R,	|3?\xe8\xe7\xdbN\xd8\x9c\x9a/\x99g\x14X\xe3\x15\x03$zt^\x19\xca\x99\xef\x1c\xb7u\xc0\xe0\xe0\x8d\xb9\xca\x9d\xc5\xfd\xf9IO\xb4\xf4\x90\x80\xeb\xf9\xa3\xfa^aB\x8e\xafKw_M\x90\xc2\xc1\x9b\xc3\xb7\xa6\xbd\xee\xbf\xd1\xd2C\x02^FA\x0f\xde\xb2\xba\xfe5J\xeffN\x90v\xce\x05\xb7\x84\x18\x849|Q\xe2\x8dw\xbbg\xefI9\xddk\x97\x1a\x02C\xbe\xfdk\xe5v\xc4\x0f\x91\xf9q\x91Wf\xe0\x00\x07\xfa\x1e\xd9\xc69\xfa\xe7\xed\xb9,\x12&\xf4k\xder\xe1\xd5\x99g\x8e\x05\xb7\xb5\xdb.6.mT\x99\xb3\x10\xe6\xb0\xb5\xf3\xfdIU\xf6t\x0c)1\xcd\xaf Sy\xb7\x8f\xba\xdf#\x0fs\x0bc\n-\x01an\x06\xfd\xb6\xcb\x93\xd3>\xffG\xccP\x0eu\xf3\x8dp\xc6Y;\xa9\x87\xfc\x05\x81\xd2Qu\xb3\x8a\x9dd\x11\x1cl|\xe7}\xa9P1\x96t\x12\xf1\xce\x1fL\xb5\x0dJ\x1f\xe11\x8b-s\xcd\x0e\x92M\x94\x8bw\x89nBH\xf0{R\xcd\xd0\xfa,{:\x0c\xb7fzO\xe7\xd9F\xf5\xa3\xea\xad\xd3\x88lK\xc9\x82\xedR$\xb9\x84\x1a`L1%\xa3\n\xa5\xe7\x82o\\jP\xaa\xf4\x9c\xd3l\xe5\xbfX\xbd\xb2>\xc1[\xa4yhi\xfbrq\x7f\xf0\"\x97y\xb6\xa3\x9a\xc7di\xbc=\xbea\xf1\xe7h\x80t\x94^\xf0\xcb\x11\x97\xc7\x0c4C\xdb\xaf\xe5i\xec\xb2\xae\x9aJy\xe8j\xe1riq\xef\xc5\xe4\xc6\x81o\x19\xb9\xf9\x9e\x951\xfc\xe7\xb9+\xf9\xf5_vs\xca\xf0\xc3r\xbdW\x16\xb9:\xb8j15[\x89\x9f\xef\x7f	\xfd\xf1\xe1_|\xe3\xc4\x96\xa5lA\xd9\xdf3\x82\xc9\xed\x0b\x87D\x17-\xff\x0c\xa9\x85\x1c\xad\xb4\xbc\xd7\xb0\x18\xef.\xfcus \xc8\xa0~\xa8\x05UX]\xafvL\xa7\\\xc9\xa1y\xaa\xd8\x89\x12sX\xa6\xa3\xf1\\\x03w\xfe\xf2J\xc5`\xe8\xfc\xd2\xd50\xa3\xb8E\xca\xb4\xa2\xb6\x98c\xa0\xabV\xa6\xb9<	~\xfbM\xe2\xfd\xbe\x8f\xb8\xc4\xa8\xc7\xf4t\xeeJ\xd7Wo\x97\xb2n\xd0h7\x8f\x1c\x9d\xb4\x9e\xd3PI\xad\xe7\x14[\x9c\xfa8^\xb4\x16g\x80\x90 \xc2n\xdc\xb6\xaa\xe7\x86{\xb0l\x84\xc7\xfc\xac\x81\xc7\xb7\xc7}\x1e~C\xcf\xa5\xaeM\xcc\xccg\xaf\xdc\x12\xef\xd3\x0c\xd9\xbdEq\xb2\x9c<\xbb\xc3\xdc\x16\x83\x83\xfa\xc2\xa5\x82\xfa\xd8Y\x0d;+O\xbf\xc8vG\xb1/9;=\xacr+r\x7f\x1c\xca\x7f\xae\xa9j\xb6kB!@\xd8\xb9\xe5\xbc\xd8.e\x0d\"\x07\xc4\x9b\xd8\x83\xea\xb9?H\xa2\xb9\xcd\x95\xfc\x0d\xa2\xd3E5\xdbgV\xd6\xf7_\xd4>S\xfe47O\xab\xbaaQ\xf4\xe7GwS\xfb\xd4m\x93(\x9bG\xc6\xec\xc0\x8d\xcc5\x99\x9a\xeb\xa6\x0eb\x0f*\x82b\x9c\x9e}Y\xe96\xdd\x9f\xe8o=\xaf\xfan!\xd2i\xa4\xbe5\x84\xb0}\x94\xd6Sqi#\xad\xadL\xf2dI4Q\xc2\xa7k\xe9\xe5\x19y\x12D8\x18\xbe4\xcd;<\xec\xd4\x92U\xfd\xb2\x02\xfd+\x94\x9e\xe1\xbcxt\xb5&\xe0Yn\x9eK\x01\xf1\\E\x14\xbf[\xd0W9\xdf\xfem\x01#\x0b\x93\xfaVS\x84\x04\x11^bo\x16?\xce\x9cL\xdc\x18Q\xef]\x97\x16]]\xf6\xcf\x1d\x9fr\xa0\xfc\x937z\x1e\xd5he\xba_g\xb3^\xa5x\xfe\xe2\xc3g\xebSr\xdc5\x85\xf7\x9dnm;\xd2\xf2\xca\xd6\x0b\xfd:\xfb\xdb\xdbN\x05Y\x0f\xf5N>>oq\xb5\x8d\x18\xe45R%\x99t\xb5M2\xf0PB\xf9\xbc\xc5\x11\xab\xc3\xa2\x9d\x17\xfa=\x9e\x9a\"$\x88\xf0sJ\xff\x18\xd7h\xa3\xd39o\x7fE*v\x03\xb788\x7fa\xf1\xfa\x8b\xcf7(\x96O*W\xeaNr\xab\xeaK6\x05\xf9(0\xae\xb6\x17\xe4\xca\xac\x938 >b\x8d\xe7MS|l\xdf9j\xa4|gr\xd53\xfc\xd2#\xdf\xe1\x8b\x1f1\xc5n\xb1%\xcc\x89\xac;V\x01\x02^=\xd1\x99\x8em\x032\x7f?Ua!\xccq\xeb\xd527\xc1\xf1\x86f\x9e\x131\x93%\xcao\xd8>\x83N~\xdf[<\x1e)\x0d\xe5\x87\xe7\x94X\x06\xbd\xa8\x8b\xcat\xa6\xd3\xde\xe0\xc7\xc7\xb418\x98|]\x88\x1b\x0e?\x19\xf4nC\xfc\xe6\xfd\xd2\xc2cQ\xe1\x9b.\xb7\x94\xe3\x9c\xeb\xdd\x14>\xfb\xf9\x08\xb3\xd1s_\x8e\x17kY\xdd\x9buv\xab:\xbd\xa7\x93\xdfPm\x95\xbf\x16S\xa3\xa6\xf0g\xc3[:v\xebaIAN\xb2S\xfbd\xfd\xbc\xdd\xdb\xed\xdd\xcbq\xce\x9f\x12\xb8\x12\xe7\xbe\x84w\x97}\xe1\xe4\x9d\xfd\xd9\xf3\xd1$d\xe6\xaaW\x8fK\x95P\xca\xeff\xc1\xdd[I\x0c4C\xb3\xe5\xe9\x83\x93\x96\x8d&A\x02\x17\xb6\x93\xbf.\xdd\x1a#\x8b\xfe\xfaYR-m\xe0\x18\xc6\xff \xd8_,\xd8\xf5w\x89f\xc1v\xfaJ\xed\xe7\x9b\xc3\xdaB\xd9\xbb\x05\xcfi\xa6\xe4\xed\x0e\xcf\n;\xf9\xe7\xdb\xfc\xdc\xf4_U\xbad\x14Y\x8a\xf7&\x83\xba!5\xeeO\xed\xc1<|}\xf3:\xedkQ\xcd\x04\xa1\xea\xd3v\x7f`\xec\xb5\xfe\xe9\\\x87c\x04\x0e\x88'\x85k\x93\xc3T\xf66 f\xb7\xf8\x16'\x07\xacVm\xb7\xc9\xc2\x90\xb97\xe3\xf3\xde1\xd7\xb0\xe9\\\xb1\"\x89\xdd\xe4{\xe7\xd31	\xe6*(\xb2\x96%\xaeFK\xab\xd1d\x87\x03\xfd\x08\x8a\xe6(\xd8\xa6\xb4\xe26b\x1c\x8a\xc1\xc1d\xf1\x12\xb7\xef\x91\x93\xa1j\xe3\x07\xc9;\x82e\xfc\xff\xc9\xc4\xc3\xe4\x19\xa5]\x0b\xf3\xfe\xac\xfc\xe5\xf8\x14\x94C\xa0r\xe2\xd8y\x0c\x0e\xba\x0b\x97\xb8K\x97\x95\xf2%\xa5\x06O\xa6l\xec\x14\x0f\xad\x12\xd2l5\xdf\xcd\x9cS\xf8`Quh\xa4fg\xfe\xa4\xc2o\x8b\xfb\xdb/\xb45\xc5\x19\x8c3x\xc3L\x94<	\xb2\x1c\xca\xa0\xaf\xc1\xbe\xe1r\x92Yy\x0c\x19\xc3\xa0\x1a\xea!G\xf7q\x80\x13\x1f\xae]\x18}p\xddHs\xba\xa1\x1b\xb5\xf3W\xb1\x84Z_\"\x03\xcd\xb0\x11\xdd\xaa)\xa3\xf5XM\xa8\xf8}\x1f\xe5\xee\xeb\xdd\x98\xb5\xe5\x92\xe8t@3\x02f\x8a\xfb\x17\xc4&wk.\x1d\x1c\xc76\x9f\xf8\xd97\xee\x1d,W\x98'4}H\x9e\xc8\x01\xce\x88\xa6/\xc9V<\x900$\xea-\x1a\xb8vk\xc2vG\x90\xb4N\xb0QrkvT&\x1e\xba7\x16\xa8\xdc\x85\x97\x05\xbd\xf4\x0e\xf2\xae%\xac\x8f\x83\xc69\xacL\xdf=\x06\x9a\x10\x19\xae]\xe8y\x9a\x11\xfa\xfa\xee\xeamT!\x92\x8e	\x14\xa4\xc1\xe7\xa2%\xee\x10v\xdaKJ\xeb\xe4e\xa7amI\xc6\x99f\xf71\x864\x0baj\x8anu\x94\xb5:G\x9c\xcf\xf5\xbaw\xb1fg\x88\xe9[\x93(\x03\xe7\x10\xe4\xf6\xb5\xf2\xfa\xa5\x16/f\xbe\x82D\x83\xe4\xd9%\x16\xbf}|\xd7s1\xff\xf7\x1f\xf2w8b\xe5I\xf0CR\xa3\x0bu\xd1\xda\xe7E\xc1\xad\x16\xa5\xb1\x83IzH\xc4~\x0e\xbcs\x1a\xe7\xdf\x94\xfb\xba3\xdeD!\xc1;H~{\x10C\xf2\x9e\x8bP\x89\xf8,\xc7\xad\xa3~\x7f\xe6\xb0m\xa0T\xc7s\xcb\xde\xf5\xbd\x12\x0dmD}\x9f'\\\x02Fd5\x11\x1cL]\x7f\xc25pv\xa2$\xed\xe1:\xdeK\xb8C5\x1d\x93)@\x83\xc4\xfa:\xe9\x81e\xaf\xf4^\x96\xbb\x83u\xcfr~\xea51q\x0e\x9d\xd5\xa9\xd6\x82\xa2\xa7N\xdd\xf8f\xab)\xe6\xdcT\x10n\xcb[\xf1\xc2\xb1\xe6GV\xfe\xb5=}\xdd\xb9W\xae\x99lzdG1\xd0i\xb4u\x13\x1d	\x9b!\xbb\x94\xe6;\x89I;\x82\x94kV\xc3\x84\xe2L\xc9(:\x89=\x9d\x16\xa2\x98t\\\xda\x80\xe9\x12\xcf*Ed8aR8p\\\xc7\xf3\xa2\xd2L_<n\xbct\x96\x96\"\xba\x14\x0c\x93\xe5q\x02\xa7\xe5jg18\x98\xaa\x88\x8e\xb8\xa1s1\xe0\x8a\x80_\xfa\x1e\x12\xc58\xd3\x984%\xc7\xad\xb3v?v\xbb,\x15g\x91\xde\xdc\xf8V\x9dg`\x7f\"\xc3\x93\x850\x8f\xcd\xb2\xf8\xa7\xbe\xf8\xa8h'\x84\xe0\nxV/17\xb12\xd4q9n\x1dI\xd3\x0c\x94\x08=+U\xecg^\xaa\xda\xb4\\\xf6\xa0F:\xc6\xda\x82\x06\x1f\xebM$\xe9$\x8d\xb8\x9b\xeaQ#j\xd3r\xd9\x1f>x(\xf0\x9e\xce\xe6@v\xb3\x8b\x95\xe7\xf8\x03]\x950\xa1\x9bo\x0e\x1b\xea\xfa\x88\xea!Fi\xf6\xe84\xadH\x8f\xd4\xc1\xfc\x03\x9f\x85\x8c4\xdb\xf1;|\xce\xe9s\xfc'o\xdc1i\xc4\xe8\x04\xdf\xe7\x8dr\xf8\xb0\xeb\xb4z\xec\xe6\xab\x87\xab\x85\\\x89_\xce\xca\xe9\x9e\xc2\xe0\xc0\xa7\"\xa6\xcb\x98\x11\xd0\xfa\xb8\xf2\xf0\xe4\x96\x89\xea\xb4\x9c\xdc}:k\x02K\x82y\x1f\xbbq\xfe\xbb^\xa2\x81^\xd3rr\x1f\x1c\xf1e\xeb\x0b\xfd\xf4\x7f\x16\x8f\xefb\xe6\xbe\xd0\"\x04/\x89\xdbY\xb4\xdb/6\xe1wL	<?\xad \x82\x83\xe5\xe2\xbf\x90\xc9\n1\xa3\x05Gu\xc3\xcdn7\xc9\xdb\x97\xd7\x8aW\xee\xd2Y\xff\x94\xdf\xe3J\x93\x94\xf5\x95\xb1\xbb\x9eW\xdc\xd7\x92\xba2S\xba\x9b3\xe2Fy_u\xf8w\"\xad\x11\xa3\xa3\xa1do\xd8\x90\x7f\x80\xaf\xdez\xc1\xee\x85\xfb\x17\xb2a-Ei\xd2\xc9TN\xf7;\x06\x07n\xe5/\x97\"u\xff\xcc\x1f\xeb0\x7f^\x17\xbd\xb1\xd3V\"\xd1^\xd0P\xd3\x0c\x93\xf7\xb1\xce|7\xf3\xe8\xc1\x07\x11\xc3\n\xf6*\xdb\x9d\xabl\x17)\xaf\x8fy\x11\xb6\xc4\xbf_\xb3\xd5if\xf8\xdc\x0c{t\x9aI\xeb\x8e\x7fu\x15\xe8\xcc\xb6\xe7\xeb\xa7\xb02M+\xeaM\xeb\x15\xcbb<\xb8\x8a\x8f\xd9I6\x08	\x06\x1c\xc7\xf9\x1f\x16\xa7=\x1eq\x89\xafX\xf8\x1a^_#\xea\xf1\x93\x9d)aaLFu\x1c\xe5\xbd\xe9?R \xfa+\xc7tb\x0f\x0c\xbe\x89\x9f|(_\x99\x9d\xcc@\xa7\xf1\x8bn-\x958r5I;\x15\xf7\x97\x17\x9d\xf2\x93\xd2\xa8\xda\xbbR7=R\xee\x13z\xd69\x04\xe9H\xeaY\xbba\xa227\xfc2f\xd6\xe2\x9e\xbe\xc7\xee\xc9y\x07\x13\x95\xf1+{/t\xe1Ep\x10\\\x1e\x13^\x9d\xc5\xf9\xd5r$\xc0\xf1\xfcE\xd9\xf5\x9c\x95[L\xd9\xb7\xb1\xa3\x93zH\xf2~\x0e\xcc~xT\x85\xf3\x9e\x0fp3L\xf5T\xe4\xcath\xfe0V=\xd4\xd4Y|\xb8^/\x16\x83\x83\xe0u\xbcF\x93v\xe0\x96\xf7\x84\x95SQ^\xf1\x82\xc6\xf7\xbbU8ow\xc1\x9fI\xf2HrV3\x06\xda\xbd\xbb\x0f9\x15}QMP\x9f7Y\xe4\xd2\xf5\xcbw\xd8\x12\xca\xee\xd2\x14\xc1\xc1\x90\xda\x80n\xa8h\xbb\xbd\xaa\xc3\xa6s\xe4<\x12p]\xba\xecc\xf6\x8f\x0c	y\x12\x9c\xf3\xc3k\xdcu\xff\x9av\xd9w\xcf\xe0\xd3\xba\xd8O\x95\xb1\x0e\xb4\xe4\x9f]\"\"8x\xbe!\xce\xbb}5S/\xfc\xac\xaa{\x9f\xa3k\xaf\xc9{\xf3\xc5\x00ilr\xf1\xdb\xe8\xe9Hg\xf7\x83\x89i\x1a,\x04\xa4l\x86\x07\xd2]\x93\x93\xdf>n\xbb\xcc\xed>4\xdbd=D\x1f\x9bwp\xf1\x930\xb9\x94\xb0#\xf7\x17\x8d\x8c\xaa9\xd3niF\xbf\xf5e\x95\x19\xe0\x92T\xa4\x91+\x9b\xdd\x1fl\xe1\xaf8Zo\x95z\xa0\x12G\x83%\xc5\\Y\xb9\x9e\xcd\x81\xa2]m\x8a\x1b\xbe\xa22Kz\x16.\x85.\xef\xf3\xeax?9\x87 5Y\x17\xfa\x0d\x11Q\xf3\xb6Lu|\x82z\xf4J\xc4\xce\xaa\xc4\xa6\xa1\x1d\xa3\x04\x0e0\xce\x1b\n\xd2\xdfR<i\x0b]wv\x1d%'\xc8\xc7\xd8\xf2\x89\xee\x10\xa0\xd6\xec\xc4\xe0`a^A\xeb\xc0\xc0\x83\xbf]\x93\xbe\x87t\x8b\xc7\xbb=7\x9a\xb2\x958\"\x84=\xc0\xe0e!\xf0[%\xe1d\xef\xf9\xccwX\x8c\x10\x9f\xee=\xb13B\xd4\x9a\xdd\x18\x1c\xf0\x8d\x93E\xed|I{X:,\x95\x94\x86\x0d#\x9dZ\xf1o\x93\x9b\xcb\xe6\x04\xd4 \x8e\x06\xe4'\x99\xe78\x88\\_\xb8\x0e\xeb VN\xbc\xcb\xc7\xe2\xf3k;\xae\xdf\x04\x0e0\xf8\x8c\x9e\xf5'\xbetz\x89\x95\xebkv|\x7f>\xff\xaf\x08\x14\x96\x04\xe2\xdd\xa7\x82r\xd2\xc6\xf69~H\xd4\xd3\xa9\xcd\xfaF\x8c{M\xbeV[\x88\xc1\x01\xadV\x90\xff\xacVc\xddVK\x14]\\W\xde\xac<N`9S\x90\xe5\x81%\x01\xa7\xcb\\\xe1\xcf\xf0Dg\xd2F\xc9\xe1\xbeuC\xfa\xb1\x9f\xc2\xc6eW\x7f\xde\x9f\xc7\x8a?`\xa0\x99|\xdcEK\xda\x8f\xda.+k\xde\xba\xdc\xef\xf8\xe42\xf7UK\xe1\xf8\x1b\xbf\xc6\xb5\xa6\xf3x+\x89\x0c\x1b\x16\x02/\x0f>vo\xcd=Q9\"\xf8\xea\xb3\xdat\x8ej\xaa\x8b\x97\xe8UT\xae\x80\xc8?X\x12\xc85\xeaZ\xd0S\xb23\xb2\xdb\xbf\xda\x88\x8fg\xefT\xd4\x88\xba=a!r\xb2'L\x90.^\x8b\x01\x99c\xf1\xcaNo[}*K\xb3\xc5\x0c:2\xeb\xb1\xa7\x13\xc2\x85-\xaf\xd3\xb8xH\x1cH\xfb\xfbg\xc9m=\xc4'\xbfg\xf7\xe4=\x87\xbdK\"\xfbN>\xd0z\xd1f\x8d\xb6\x90\xe30\xd0L\x9b\xf7\x99\xe7\xb7\xe4\xb3\xaf\x0f9\x04\x95]\xb5\x14\xf4\x15\xb7;\x1cyU5\xf0\xed\xa1\xcbw+\xd8\x84\x89\x16\xc1]\xd9\xb1\x0c4\xd3F2?\\Xt\xbb\xfe\x9c\xedG\xdb\x9c\x18f\xe5\x8b\xdc\xaa\xf8	\xe3#\x8d\x85\xef\xad;\xb0\xb5\x18P\xe4\x14(\xfeI\xc0\x88HX\xeb\x8b4\xacgH\x89\xf7\x8e\x87\x96\x1bM\xf5\xfa\xe6;\xe4W\x08\xea\xeb\x9c\xc7\xe0\xe0\x95\xb3\x88\x05>\x7f\xb3\xff'7sY`\x82T\x95R\x16\x11\xc4\xcd?-\x9f>?\x9a\xdf;\x9d'\xd1\xfco\x9a\x1b\x0b\x81\x00\x0f\x81\xd8\xed\x1enf\xe8\xce\xd6\\1~Vd\xcf\xe8Wy\xbd\xe3gu\x1b]\xda\x03\x86W\x8b\xdf\xc4\xab\xcf\x8f\x0f\xed\xf0\xd6'\xa3t\x16\xe33\xea\xec\x97u\xc7\xd2\x96:&\x8em\xbd\xb5>\xff\xfe\xa6\xaf0W\xb2o\xe1\xd9\x8bEZ\xb6\xf7\xa4\xf2\x95>\xe9E\xf4\xe0\x97\xaf\x7fC\x0b\xd0\xa0F\xf5\xb9\x16\xbc\xff\xa4%\xfe^\xea\xcfF\xff@\xf5`\x16\xdf\xc6\xfa\x9d\x9beU\xf4\xed\xb7\xa5i\xd5^\xbf\xd0\xfeu\xe3\x03)\x94\x9bdm\xb6O\x9c\xdb\x91\x9b\xc7\x8f\x98!F\xb7^`I\x00i\xc1yc\xf6S\xe3\xda\xda\x97\x8f\xfb\xd3\xc7!\xce\x80\x8c\x02\x80'k\x91\xe9\xdc\x00\xf0\x12K\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\xf9\xdf4\xb9\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x01\x00\xe8\xc2\x92\x00\x80Ia!\x00@`3\xd0\x00@%r\x00\x00\xba\xb0$\x00`RX\x08\x00\x10\xd8\x0c4\x00P\x89\x1c\x00\x80.,	\x00\x98\x14\x16\x02\x00\x046\x03\x0d\x00T\"\x07\x00\xa0\x0bK\x02\x00&\x85\x85\x00\x00\x81\xcd@\x03\x00\x95\xc8\x19\xdb.sh\xc6\x00\x006t\xe1\xf1\x80x a'\x00\xdc\x16\xc4\x01\x80\x8e\x01\x0d\x00`\x8f\n\n\x00\xfen\xc0\x00\xc0m\xc1\xff\x9bY\x1b\xfc_\xc8\xb5G\xe5\x7fU\xd7\x7f\xf0\x1f\xfc\x07\xff?\x80R*y\xb7\xe1\xcd\xfeG2\xbe\xea\xff{s\xea\xa4\xa9~\xbe\x1e\x83\xf5\xff\x0d\x00PK\x07\x08Y\xc6o\xd9(-\x00\x00\xd59\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00public/highlight.pack.jsUT\x05\x00\x01\x80Cm8\xacz\x7fs\xe38\x8e\xe8\xff\xefS(|\xb3\n\xd9bdgf\xdf\xd4\x8e\x1c\xc6\x95\xa4\xbd\xd3]\x1d;=v\xfa\xf5\xdcY\x9e,-\xd36'2\xa5\xa5\xa8\xfcX\xd3\xf7\xd9\xaf@I\xb6\xf2c\xef\xaf\xabJ$\x11\x04A\x00\x04\x01\x10t\xe7\xc3\x91\xb7\x96\xabu*Wk\x13\xfeYx\x0f\xbf\x84\xa7\xff/\xfc\xd9\xb3\xde\xe5\xe4\xe3O\xde\xb5L\x84*\x84g\xbd\x954\xa1\xcc:\xeb\xf4\xcf\"\xad\x81\x1f:\xff\xe7hY\xaa\xc4\xc8LaA\xb6\x0f\\{\x8a\xa1l\xfe\xa7H\x0cb\xcc<\xe7\"[z\x8fR-\xb2G\xdf\xaf\xde\xd6\xbeA(D\xba\xf4}x\xf6P\xa9\x16b)\x95X\xa0\xa3f\xbcx\xca3m\x8a\xbe\xc0\xf5\x17\x89\x94\xefc\x15\x02/L\xe0\xed\x8eP\xd4\xf0q\x98\xb6\xa2\xe3\xfb\xd5;\xe4\x9bE\xf3\x8d\xa73\xba\xe7\x9bl\xb50\xa5V^EoG\xc8\x0e\xef;y%\xd4\x80Mg\xb4d7N\xb0\xf0^<\x17t\xc4\xb6;\xba\x82\x87b\x9d?\xb0\xcaN\xfa{=\xda<\xe5RY#\x9e\x0c\xf9\xa1#\xe9\x98u\xe2y\xca\xd5\n\xf7\xa3\x92\xaf\x04\xe9\x9f\xe0i\xfcx2\x0bH<\xefHjX\x07\xe3?\xf0\xd9\xf4\x8f\xf3YpnccI`q?\x8a\x15!\xa4\xb3\xdaP\xcd\xb6	/\xc4\x9dT\x85P\x854\xf2AD(\xf9\x8ch*\x9e\xc4F\x14\x11J\x11M2e\xb8TE\x84\x12D\xef\xc5\xf3c\xa6\x17E\x84\xee\x11-\xca\xf95W\xab\x92\xafD\x84\x8akD\x93\x94\x17\xc5\x88oD\x84\x92\x11\xa2s\xb1\x92*BsD\xe7b%\xd5\x97\xfd\xd8\xf9\x17D\x85ZDH\xb8w\xf1]\x9a\xf5W\xae\x852\x11\x12\xdf\x11\x95i*V<\x8d\x90DT<%i\xb9\x10\x97\x15-q\xb9\x87\x0c\x1c\x81\x01\xa2\x95\x9ek\x04}\xd9\x00\\\xbfv\xfd\xa9x\xe0*\x11\x11\xd2\x88>p-\xb92E\x84\x1e\x10\xfd\xfcq0\xba\xbd\x1b\x0f\"\xf4y\x8c\xe8\xb7\xd1\xc7\xc1xru3\x1e\xdc\x1d:\xbeA\xcf\xe8\xdb\xf0r0v\x88\xa31\xa2Ww-\xc0\x15@.?\x8f.\xc6\xff\xd1\x06_\x02x<\xb8\x9b\xdc^\x8co\x07\xe3\x89\xc3\x1dO\x00\xf7\xe2\xea\xcb\xe4\xfab\xf2\xe9n0\xb9\xba\xf8\n\xb8\x03D/\xbe\xdeL\xee&\xb7\xe3\xcf\xa3_\xef\x867\x1f\x07\x11\xba\x98\x0c\x11\xfd\xed\xdb\xcd\xed\xe0%\xfc7\x80\x7f\xfd4\xbe\x98\\\\\xdf}\xbf\x19\x7f\x9c\xd4\x03\xbe~\x1f\x02o\xd7\x9fG\x83\xbb\xab\x9b\xe1\x10d\xabz\xae\xae\xaf\\\xd7\xe5\xf5\xcd\xd5\x97\xd7}\x97\xd0\xf7	\xf8y\xd9\xf1	\xe0\xb5D\x15\xeah\xd8\x92\xbd\xa6<\x1a\xbe\x96\xbe\xea\xb8\x84\x8e\xab\xc9\xe4%\xf4j2\x01\xf8x\xf0\xeb\xe0\xf7\xaf5\xe6x\x88\xe8\xed\xe7\xdb\xebA\xdd\xbe\x1d\xbeX\x8av\xd77\xe8\xab\xb9\x8c\xd0UmXc\x11\xa1\xf9\xd8\x99\x12|\x8a\xf1\xde\x82\xa0)\xc7{{\x86f:F\xd4\x08\xbd\x91\x8a\x9bL\x17\x112\xed\xf6\x9d\x00\xab1\x03\xb4\xa3s\x86\xce:E\xce\xd59\xa2k\xb6u\xb6\xfdU\x8b\xa5|\x8a\x10x\x88\x13D\x0d\x9f\x8fE\x9e\xf2DD\xaaLSZ\x16\xe2r\x1c\x1d\x9dR\xd8\x94\xb0#\x8b\xe8!\x93\x0b\xaf\xbb\xeb5[\xdf\xbb\xc3b\xef\x1aD\xa8\xab\xe1\xb8\xe3wV\x14\xf9|\x93\xf7\x109@\xcf\x1c45/\x80\xe7\x0e\xb8\x02\xe0nOv\xf1\x82\xac\xca\x16b\xc47\"4\xd9u\xf6(\xf4\x15/\x04na?`AU\xe5\x83\x0c\x13\xbe/B\xf1$\x12\xacH\xaf\xe6\xcc\xf8~\x971fB\xa9\x16\xe2\xe900oM\xa3B#\n\x83E\x8bn\xbaw\xd7\xd4\x80\x0f\xd3\xecBk\xfe\x1c\xe6:3\x19\xf8\xcf\xd09\xf90\xe1i\x8a\xb9^\x95\x1b\xa1LAOIo\x99i\xac<\xa9<A\xccT\xcd\x98\x98\xaaY\xc3\x8c\x0e\x97\x99\x1e\xf0d}p\xa0\x82l\xdf\x1b\xb1#\xd4\x1c\xb8\x196\xdcp6\xdd\x13\xdb\xf7\n\xac\xa8\xa9\xc8@\x80\xd1L\x85K\xa9\x0bs\xb5\x96\xe9\xa2\xa7{\x9a\xe9P\x89'3\x91\xf3T\xaa\x15\xf9\x891\x80d\x0bq\xfb\x9c\x8b\xbe	\xea\xd6\xff\xe7i)\xc2T\xa8\x95YG\xa7/\x90|\x1f\xf30/\x8b5\xde\x8a\x07\xe7\xdd\n\xc3\xb5A4[.\x0ba\"C\x81@\xa4\x81m&\xb0\xa6\x86\xd0\x05\xd6$\xdcp\x93\xacqg\xae\xedZ[\xb9YY\xa9\xf2\xd2t\x88\xb5o\xe8e\xf9;\xe4\x0e\xeb\xb8\xc3\x82v	\xe5\x07\xadH\xd0\x8a\\b\xed\xfbG\"\x04K\xbd\xc8\xe5X\x14&\xd3bq\xd0G\xb5\x18\xaf\xfb\xd9Q\x97j\x02\x8b\xe3\xfbXL\xf5T\xcd\xaa\xb5\"=,\xc2\xc4\xda\xe9\x8c\x84I\xa6\x12n\xb0\x08\x1f\xaav\xb3z\x92\xec\x0ellpB\xb6\xfbV\xd16_\xb0\xc7\"+u\"\xac\x15\x87\x11Ye\xb4\xb5dJ<zc\xb1\x1a<\xe5\x18\x06S\xb4A\x01N\xc2\xe4s\x1fI\x14!D\x02\xac\xfah\xe5>\xc9n\x9fOx\n\x1bZ)\xe0\xc8\x84I\xb6\xc9e\nb\xcb%>4\xd9Q\x97\x9a\xf0\x9e\x99\xf0\xdeZ\x13\xce\xbf@\xab\xda.\x1a\xcc\x9a\xb3\x86\\E\x0c\xa6\x05}\xb0W\x1b\x8eP\x11\x16y*\x0dF\x1e\"\xefZ\xb1S5\xdb\xa3YDzz\xaa\xa6\xdd\xd9\x8cM\x0dU\xd3\xd3Y\x7fTn\xe6Bc\xf8&\xd1\xe9lGv=T\x18-\xd5\xea\x90\x94\x98\xf0\xbe\xcf1\xaa\xc33r\xfcF%\x86\xd7\xbb\xd3r,\x00g*f\x04\x8c/\xbcgzg\xc2t\xcc2l\xc2\xd4\xdaN\xfc\x18t\xe8Q\x97P0bPA\xf5b(\x8e\xe7\x18\x05\x00ix\x06\xd1\xfe\xcc\xa4r\xdc\x07\x88\xc4\xf1\x1c\x01\xcd\xb9\xb50\x92u\xe2K\x1b\xcf;\x0eTM0\x87o\xa1\x16\x13\xbe\x11\x17\x85\x8b\xdc\x8e\xbc`M\x1f\xa8]|w\x04D\x9b@\xc5\x8d\xa8\xc8\x08\x02\xb8f\xc0\n\xd7\xb0\x16\x81\xe0\xe2;\xd8\x8f\x198L3\x08\x18t\xf6\x91u\x96\x10\x88\xd0\x0c\xdc0\xe9\xfaeEI\x12B\xc1\x83\x83\xc7\xd3\xaeC\xb3S\xc0J\x1c\x0b	\x9b\xce\\\xeb\x8d;\xabL=\xe4y\x9e>C\xfag\xc2$\xdc\xf0\xfc\x85\xae+\x83\xc5\x8a!HE\x11cL\xf4M$H\xf8\xe0\xfbG*Lx\xb2\x16\x8b\xbb&/q\x19\xe8+\x18S\xe1\xc3\xbf!\xeb\xa5X\xd1\xed\x83\x8b?;*\xc8\x0e$y=\xdeZ\xe5\xd42M\xb1\"3k\xc1\xb7:\xc3\x03l`\xf9=\x13Q`\"\x95u8\xafU\xf8\xbe\xc2\xcd7\x15\xc4Q\x90\xec\xdfK\xec\x89p\xfe\xa5\x8f\xe28\xec\xe3~\x84\x02\x11\xce\x9du\x84}\x14\x89p\xbe\xdb;\x8a)\xac\x135\xa1\x9c\x81\xe7\xcbqA\xc2\xa5L\x8d\xd0\xf82\xcbR\xc1\x15\xe9\x99\xd00Y;\xd9~\xd6R/U\x07\x97eX'\x9e\xe2~4\xfd#\x8e\xe3\xd9\xcc\xc6qH>\xc43\x1b\xe3\xb8\xdf\xb7q\x8c\xa7\xa7'\xbf\xcc\xa6\xdd\x93_f\x1f\x08\xf4v\xa8f]\xca\x19BT\xb2nO\x9e5~\xbc'\x83\xa0\xda\xef	\xd34c\x05\x16S9\xab\xa2T\xf7\x0cL\x87\x07L\x91^\xf7,kFT\xe8%3U(\xcdHO.1\xac\nc%\xd9\xf2\x80e\xbd\xb9\x16\xfc~\x07\x9faQ\xce\xab-\x8c\xbb\xb4\xac\x82,\xa1\xd9\x8b\x8e\x1a\x1c\x94\xd3\xee\xac\x9e\x84P\x14\xc7\x881\x00M\xbb3\xdf/\xc1?\xf0\x00v%\n&\x15\xc1\xda]@\x17	\x12\x12\x01\xab0\x80\"\\\x0f\xf5}\x1d\x04d\xb7\xab\x97\x89\xef\xb0\xa4\xb0wa\xbfG[`?\xda+x\xef\x95A\x94\xddn\xb7\xc3I+\xde_\xc1\x02\xd0\xcc\xc5\xd1z\x84W:\x98\xa1\xba\x89\xbd\xc7g\x90Ey.\x81b\xe88\xc0\xba\x8fP\xb4\x0e[\x19U\x13\xaf\x80W\x11\x1c\xa3\xf3c\x12\xa8\x00\x1b@\x9c\xb7\xe6+0\xd9\xae\x02\x06\xbc\x1c\xb1AX\\\xf7\x9b.\\\xcd&\xd8\x1b\xd7\x08h\xb0\x16\xc2\xf7\x8fFSh\xcdH-\xd3\x1d\x1eWf\xac\x98\xe8_a\xe8\xa3cz\xd4\xa5\xb2\xc6\x8bn\xf0\x98\xc2g\xbd\x00}\xf8\xaes\xba}\x8c\xed\x9e\x0d\x9c\xd3X\x04L\x85\xba\xf2\x985\x01\xa6B\x93\xe5\x84\x96X\x85MNHU\xf8\x00)\x03=:\x05\x85\xef0ik\x1b\xec\xb8\xd6\x1f\xe5T\xd2\x04X?\x1a\x84\xf7/x\x86\xdcG\x83\xd9*\xd6\xa5\x830\x1d\x87)/\xccg\xb0\x17\xd6\xa5\x869\x10,$ \x9b\x1e\xd1\x01\xbb\xc3\xe3\x96q)Z\xa7v\x84P\xce\x06T2C\x13\xb6\x84\xf0)\xc1\xde^\x84\xb1\x08@\x14\x0b\xc6\xc3\xfbp\xcd\x8b\x9bG\xf5Ug\xb9\xd0\xe6\x19'\xc4\xf7yx?Mf\xa4\x0f\x1a\x10\xd3\xd3\x19\xd5\x01+\xb1\x80Aw\xd8L\xbb3BH\xe4Xp\x0d\xaa\xd8K\x96_3\\K\xaa\x83\x03\xcfX\xc1\xf9\x99\xd01C\xe8`\x0e.\xdd\\\x05L\x84\xc9\xa8_B\x022\xa2\x08\x81V#\x84\xe8\xa09X'Zp#\xb0\xa0\xdb\xbc:dn\xdd\x02D\x83\xdd\xaee[\x1a\xec\xd6%\x06\xe3\x80\x89:\x1c\xa8F\xed\x05&\xb4\xebl\xc5\x1c\x82\xff!\x89\xa6\x9ar\xb7*\x86u)\xa4\x92Im1=s\xa6{&\x08\x88\\\xe2\x07\xac\xc2djf\xe1|L\x05i(\xd7\xb07\xe1\xb0\x81\x8f\x19\xe6\xacn\xcck%	\x02\xcam\xa5@\xfcpf\x98\x9e\xc4\x9d8\xfe\xe3\x87\x0fA?\xc4\xc4N\xe3\xd9v7\x83#C\x1c\xff\xe0#\x97(\x91*HL\xcdl\x87\x15\x1d8We\x1a~LX\xdc\xcb\xbc?\x0e\x98\x8a t^\xfa>hD\x11\n:0\xa1\xbet\xc1\xf9\xd2Z<f\x8a\x10\x9ab\x03\xc1Q_\xf6\xbb\x91j\xc4\x06M9\x0bf\xaf\x13\xeeZ\x11bL\x0d\xa9\xd2\xcd\x9e\x02\xe9\x8b\xaa\x02\xe0\xfb*\xac\x96\xa9G\x14\xdb\x7f\xd7\xcc\xa9\x1dx\xd5P|o\xb8\x15\xb8A\xa1\x86\xec\xf0\x80*'\x8dl\xbc\xf7\xc0\xadJ\xd2\x96)	\xf5\xc0\xda$\x14\x03k[\x92\x01\x00D\x05\x99z\x830\x19\xf9>^\x05lN\xc0\x01\xdc\xcb\xdcZ\xd8\xfc\xd6\x82\x95\x0f`\x9f\xe3\x01\x1b\xd4s\x93#\xc6d\xfd\xdd\xdb\x1b\xb0\xac\x83%\xf8\x83\xb7\xeb\xdb\xf4\xc2\n\xcbP\x8cA\x91\x0d\x8c\x00;z\xd0R(\xc8\xad\x99r\x9b\xf5(\xf3\xfd\x07\xccC9\xa6\x9a\x10\xb3\xd6\xd9\xa3K\x87\x07Zg\x1a\x1f\x7f\xae\xce\xbe^U\xc4\xf1\xd0q\xa0\x82c\xe4-3\xedm\xb2\x85\x03`\x90\xcfZtV*\xc57bq\x8eHp\x8c\x8e\xf7\x9c\x83Vh\xb3\x96\xd6\x9e\xee`9\x97l\x82\x85\xd3\xee\xd1\xf2\xed\xac\xdf\xd4\xbd\xca\x1e\x95\xd7\xb8\xb9\x08\xe6\x01_~Lz\x1b\xbc\xac\xfc,\xa7\x03f\xac]R	i\xf4\x8a!\xe4\x96\x87\xb3A\x8f\x1f1\xb6\xecq\xc6\x1b\x95\xf2z	X\x89\xf9ac\x07\xab\x8a\x128\x02\xba`\xdd\x9e\xd1\xcf\xfb\x04 \xa19\x1d\xb2no\x10\x9a\x96C\x1c\xd2\x84\x01\xa49\xe2\x92\x9ci\xacZ\xcepH\x93&\x04'\xceA\x0dY\x0d\x08r\xc7\xdf\x01\x1d\x0f+\x87\xd9k\xd8|\x8fc0\x9aZ\x93[\x1d-h\xe5mV\xfb\xaa@$\xa8\xc9rp?\x89;\xe7U\xc7\x11\x11nDQ\xf0\x95\xf0\xfd\x93\xd3#\xc6\xf6\xed\x8a\x95\x9b%F\xf5\xca\xa2\xc6wlu\xd4\xad\xa9\xdfaEv\xbdjUD\xeb\x98uS\x9dP\x04\x13\xd6\xae\xf71\xa8\xb0\xb6\xc4\xa3J\x93\x9a\xbd c\xc8\x8er\xa6\x1bK\x10M*6\xd9'e\xc5\xfbG\n0\x11\xc5 #0\xf4\xe8\x94\xf4\x0e\x11\x0f\xbci\xa8\xcf\xb9\x8b\x92\x1c\xf6\x1b4u\xdd\xd4\xe0/!\xcf\xe4\xfb\x01\xbe\x8fuX\x88$S\x8b\xbb\xb9(\x0c\xe3\x84\xea\x83L\x97\xad\xc3\xe2:<\x14a@BW\x82\xe9\x1f\n+\xe6P\x91m\x9f\x1ekD\xdfG1\x94v\x99\xe8\xa3\xb3\xb9>GQ\x9b^_\xed\xc9tb\xd3Y\xd1\xf6d\x10bv$j\x9dO\x93\xfdy\xee\x10\xbdiFKZ\xd0\x94.[Q\xe3\x15\x16\x84/H\x85\xa0`\x13 \x0f\xc1\x0e\x93\x10\xd4\xaa\x8d0\xca\x16\xa2\xdfn\x1c\xb0!\xca\x19V\xc7\x04\xb9\x8f(\x13l \xf9\xeb\xc33B*;\xd9W\x90\xab\xed\x06I\x83fX2Y\x1f\xe1:q\x11t\x08\xa9\xf7{O\x9d\xe9\x9e\xaaBV\x8e9\x93p\xbc\xb7v\x82\xf9~\x06\xbe\x03O\x90\xe3%\xb1\x167J\xc7\x8a-\xb2\xc4\x95t\xea\x90;H\x05\xb4F\x13\x8c\xd6\xc6\xe4Q\xa7\xf3\xf8\xf8\x18>\xfe\x14fz\xd59\xfd\xe5\x97_:Ok\xb3I\x11E\x0b\xf9\x80\x08	\xa5RB\x7f\xba\x1d^3q\xf8n-\x82\x828\xd6\xae\x85\x9d\xcd\xf5\xd4\x8b;\xb3\x0f\xe7\xd0\x13+D\"\xc5\x04\x95\x90x\x89's\x95)#\x94\xa1\x9a-\xfbWxI%\xb8\x90\xe8\x06KB\xb1aC\xac\xf6B\xfb>\xc6\xfc\x7f\x91\x7f]'y\xf5\xbb\xb5\xfa\x90\xdc5\xb5\x84\xfd\xe1c:;T\x08\x93C\xd2\xdd\x9cG (\xd6\x19\xa8\x80\xf4\xac\xaa\x1f\x1d1\xa6\x0e\xadv\xcf\xd9\x0bx\xa4\x9a\xc2\x13\xabG\xb8j\x94\xebhf\x80\xc6\xc1\x9430R8W\x9c\xa1\x00*\x8b\xc1\x00\x0efU\xd9N\x84\xdc\x18-\xe7\xa5\x11Eks5<#\x0f\x05\x87\x02dp\x0c\x19\xff\x1d\xd4\x82@\x0d\x87\x95;F\xc7\x14\xf9\xff,3\xd3\xabb\xcf\xae)\"@\x0d\xe1\xbc\x95\xe4\x95{^:53/\xba\xa1\xf8\xb3\xc5\x07\xf9D-\\\x16\x95\x04W\x8c\x90\x1d8\xf1^#*\x9c\x86kK\xaf\xd6!e	vq\x8d\xbb\x1c\xb5\x15\x194M\x0f\x8a$\x84j\xd6j\xd3\x14\x8e\xf2U\n#C-\x1e\x84\x86ly\xef\x1cK\xd2+p\xea\xb6X\"p\x97\x9eB\xd2F(v\xd3\x11\x18\xeb\xfbi\xcd\x89\xef\xb7\x08C\x0d\xb1Gz\xef\xd1\xcc\xc8N\xa4\x858\x88\xeb\x86U\x12\xcb\xaa4\xe8 N\xecH\x86y\x96cB\xdfac\x7f\xf8\x0b\x0e\x12c\x0dI\xb6\xa1C\xcc	\x85\x92Hc\xbc\x97\xb8\xfe\x82\x82\xd6[\x1boy$\x86\x93\xb6;\xa3\x19[\xd2\x92\xe9\xbdg\xa7\x05\xcb\xfa\xabi6\x8bJ\x9a\xb2i\x12\x1a-7\x98\xcch\xd2\xd4<\xe39\x14\xd7\xa1^dmZI\x84\x00\x82\x08=\x81\xeaj\x1d\x99o\x96\xb8 \xa0?\x87Q\x10\x9a\xd6\xe6\xe3!R\x13\x05f\xb5(\xca\xd4\xb0m3\x7f\xd4bECK\xef\xe8\x8bP\x03e\xbcv\xfb\xc5\xd0\x16\xfc\x15\x99v\x8f\x86\xbb\xbe\xbd\xf9f\xd8E\xf7\xa3\xcc\xed\x1e(56_\xec\xa8:P\x88\x83\xdb\xf9g)\xf4\xf3D\xa4\"1\x99\xbeHS\x8cr-\xbc$[\x08\x049imY\xf5>\xa4I\xbb\x9e:i\x05E\xc1\xb0\x80j\x18yy\x8e\xa3\xa3\xa9\x98Y;\x9a\xae\xa6b6;\x0c-\xf6\x81\x8b\x01\x95&\xf4+W\x9eZ\xc8\x82\xcfSqQ\x9al!\x8cH\xcc\xder\xc2}XaW\xb4\xd5\x02TvCy\xb8\x94OC\xae\xef\xcb\x9c]\xb6\xfb/\xd3,\xb9g	\xe5P\xffY\xcaU\xa9\xdb\xee\x91l\xd7,\xc5k(eQ\x1eJ%\xcd\xa7f\x1a\xa9V,{\x07x\xa3\xae3\xbe8\xd0 [\xbeX\x0c`O\\\xcb\xc2\x08%4F\x1fo\x86u0\x00\\\xb1@4\x83,\x85\xbe\xc5L3\xde\xf4\x02\x07Z\xac\xa0K_\xd7\xeb}\x98F\xd1Zm\x86\x8d\xdc\x05\x04\xe6\xa4'\xab\xc3\x10O%/D\xe1\xfb\xfb\xcff\xf1^\x94\x06a!\x98\xda\xb9\x89RY\x98f\x92\xa2-L\xadoH\xd8\x00o%\xf6hlBy\xc8K\x93}t+#3\xc5\n\xa7\x9f\xb5\xd0\xd2\xb0\x94\xf2\xf0\xf3\x98\xf1\xb0\xb9\xcddh\xcaO\xfeuq\xf2\x9f\xb38~\xfc\x80(\x0f\xbf\xb9\xfew.>\xf7\xa8w{\xdc\x11\xa0\xd6\xb7y@+\x8e\xe7q\xbc\x08p\x1c\x87\xf0&} x\xe5\xb0\xf6\x97\x84\x80\x87O\xfa\x04\xc7\xf1\xbc;}\xfa}6\xe5'\xcb\x8b\x93\xbfC\x0d.\xb0\xf8%\x89\x0f\x04\xaat\x151<\x15\x83\xd9\xf4$\x98\xf5]\xb3O\x80\xf8\xa5#\xfe\xfa\xae\x15\xaa_s\xdc\x9dO\xbb\xa7\xb3\xc0\xe1\x8d'\x80\xf7\xf2\xf2\x95\xa1#{\xc4\xec\x11c\xf6/\xf6/\xcc\xfa\xd6\xf7\xad\xcfl\x1c\x7f\x80\x7f\xf8\x08\xe0\x9fYjO\xec	\xb3\x1df;6\xb2={vf\xcf\xce\x98\x85?\xcb\x18\xb3\xf0g\xcf\xcf\xcf\xe1\xc1,\xfc\x9d\x9f[\xf8\xb3q\x0c\x02Lm\x1co\xa1\xdch\xe3\xf8\x0f\xf8\x07\xda\x16\xfe\xddG\x1c[\xfb_\xc0\xe5\xe5\x80\xf1\xf0\xf5e0\xdb\xce#\x14\xc7q<\x8d\xe3\"\x8e'3Du\xd4\x85u\xbf\x98\x0c\x19\x0f__\x13\xb3m2\x8a\x9a\x9a\x17\x9dG\xe8\x18Q\xe1\x9e\x12\xe8(D\x93h\xca\xc3\xcb\xc1\x0ch\xfc\xe6h\xbc\xb9S~M\x04\x82\xb3p\xcf\xf7\x88|\xfd\x0eD\xde^@\x03\xeb\x9dx\x8e\xb9\xe5\xca\x9a\xb5\xb0\\\x0b\xfb\xf9xce\xa1\x8e\x8d]d\xd5S\xb8\xd6\xa3k\xcdKc\xff,\x0bc\x8buV\xa6\x0b\x9bka\xcc\xb3-\xe4&O\x9f\xadPY\xb9Z\xdbU\xa6\x14\xb7\xabL\xaa\x95}4K[d\xb6(\x93\xb5}\x94ij\x9f\xb3\xd2>g\xa5\x86	\x9fm*\xef\x85\xdddZ\x90x\xde\x01\x81\xaf\x18\x0f\xeb\xfb\xe2\x7f\x93\x82\xed\xb7\x0bvJH\xb2\x0d\xa4\xac\xa0\x05AE\xa4@}\xb3\x1d5\xd6nw{\xcf\xa8\xc3\xa4\x8a;N\x19\x84\xee\xdb\x8e\xc4\"K\x0c\xaf\x16\x03\xf7\xa3\xdb\x9b\x8f7\xf6\xef\x9f\x7f\x1f\x0e\xec\xe8\xe6v`/\xbf\xfdj\x7f\xff\xfdw\x12U\x0b\x0b\x07\x1b\xe0\xf3\xfa\n\x94\xfa\xce\xe5=@1\xeat\x10E? \x02\xa8\x975\xea\xdb\xcb\xfc\x1a7\x8e? \xa8\x14\x7f\xe8\xb8\x01\x9f\x1c\xfe\x9b\x0b\xfe\n\xf9\xff\xee\xe9\x8e\x86\x87\xed}0\n\xe5\xaa\xc9 \x0c\x0fG\xe3\xc6\x16\xafF\xc3\xf6.\x7f\x1f\xfd\xea\x80\x7f9\x1a\xbe\xd9\xb8\xef\x0f\xbalM\x02\xbf\x15\x00._\xfe\x88\xe0\xed\x98\xd18@\xf8/Vl\xacx\xb2\xc9\xdaj\xb1\xb1\x0f\x8f\xf6am\x1f6R\xd9\x87\x0d\x7f\xb2\xc9\xc6n6V*\x9b\x1b\x9b'6\x7f\xb2\x0b\xb1\xb2+\xcd\x17\x16\xfe!\xa2\xd9\xc2n\n\xfb\xe9_\xf6\xfe\xd3\xbf\xec\"\x97v\x91'\x1b\xbb\xc8\xf3'pm\xb5\xe4c\xe0\xa8\xf5\xf3\x85\x8a\x1b-V\xe2)\x07n:q\xa7C\x05\xbc\xa6+\xb9)\x9fg\x1f:TFpri6\"u\xdbdZa\xcd:@\xf8\xb0G\xdd\x06\xbb\x859\x0e?{\xa8\xa60\xd2\xa4\x02f\x00\x9f\xdep\xf3\xedv\xf8\xd2}\xff\x0f\xa3\xbe\x1d\x86\x0d\x07\xb7\x9fn>\xde\xfd\xfa\xedb\xfc\xb1v8a\x1c\x17\x1fP\xd0F\xdb\x91\x1e$^o\" F\xab\x0c\xbd\xc8\xfc\xab \xb8\xado\x1f#\xe4.:\xe0\x87R\xbcL\x8d\xbbq\xf7\xa42B/y\"\xe0WY\"1\x1e\xfc\xf0\xc8\xdb\xf0\xdc+\x8c.\xa1\xbd\xe6\xca\x83\xdc\xd6[e&\xf3r\x9e\xdc\xf3\x95\xf0\x8aGi\x92\xb5\x97d\xaa0\xde\x92\xa7)\x948\xca\xd5\xda\x93KOs\xb5\x12\x1e\xdc\xc3A\xbf\x91\xaa\x14\xae\xca%7y\xa6\x8dWoV`n\x95\x017B{\xf3,K\xbd\xf9\xb3\x81\x11\x9b<\x15O?\xff\xb5\xf9:\xfd\xf1o\xde2\xcd\xb8\xf9\xe9\xc7\xea\xfd\xf3_\x81\xeb\xbf\xc1\xe3\xf4gx\xfe\xf4#<\x7f\xfe+\xb0,\xd5\xca+]7<O\x7fv\xaf\x9f~t\xafj\xa0\xfbt\x8f\xdchO\x97J \x9aJ#4\xfc\xb0\xc9h\xe0\x95\x83\xb823\xdcS2Et^\xca\xd4\xdc\xc1\xaf\x98x\x9e\x0b\xb5\xf0\x12\x9e{I\x9a\x15{n\xbd$\xcb\x9f=\xb9\xe1+/\x15\xca\xdb\xf0{\xe1jp9W2\xf1r\x0d\xd3\xbag\xaa<-x\xeai\x91d\x0fB{\x0b\x91\n#\xd0\xae\xf6`\xdb:)\x89\xa6h\x95A&\x8bf\xf4>2\xe0\xee\xcf:\xce\xdb\x0b\xe7\x92\xa8p\xee\x86\xbe\x88\x0f\x0f\xd0\xfb\xdbdH\xb7\xfbp\xe3n\xdc\xe2\xd91\xda9\xe0?\\\x0c\xfa\x07\x02s~\xb1[\x1f\xa2\xe9v\x1e\x89\xf0\n\xb6\xect\xb1L\x0b\xe9\x02\xdc\xe9\x0ef\x1a\x0d\x01\x7f\x1eu\"\xd6\xa9\x076V\x86\xe8\xfcK\xd5\x02\xd2\x9d\xb8\xf8\x10o;T\x0c\xa2#\xb7yDx[3\x99s\xcd7\x05X|'\xc6\xd5\x1e#\x9dZ\xb6\xce\x14\x1d\xcf:\xbb\xd9n\xb6\xdb\x91\xde\x7f\x0f\x00PK\x07\x08\xa2\xf3\xc50\xa1\x13\x00\x00\xa3(\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0f\x00	\x00public/main.cssUT\x05\x00\x01\x80Cm8\xbcZyo\xdb8\x16\xff\xdf\x9f\x82\xdb @[\x98\xaa,\xc7N\xaa\x00\x0b\xccN\xe7\x02\xa6\xbb\x83\x16\xd8\xfd\x9b\x92\x9e-\xae)R \xa9\xd8i\xd0\xef\xbe\xa0$\xea\xa0\x0e;M\xb7\x13d\xec\x90|\xf7\xf1{$zE\xf2\x1c=-\x10Bh'\xb8\xc6;\x92Q\xf6\x18\xa2W?=\x00\xa7\xf2\xd5\x12\xfd\x0e\xec\x014\x8d\xc9\x12\xfd$)aK\xa4\x08WX\x81\xa4\xbb\xfb\x92\x10\x1f!:P\x8dK\x06*\x13B\xa7\x94\xefCD\xb8\xa6\x84Q\xa2 \xa9\x0ff\xe2\x0b\x16\xea48\xb9\x97\xe4Q\xc5\x84Au.\x16L\xc8\x10]\x05\xf1\x1a6\xfe\xfd\xe2\xebbQ\xea\x99\xae\x96\x8b4X.\xd2\xf5r\x91\xde,\x17\xe9fT\xf5\xff\x08y@\x9f	W\xaf\xfa\xba~],\xbc\xdf\x81$ k\xb2H\x9c\xb0JI\"\x8e!\xf2\xd1:?\xa1\x9b\xfc\x84|$\xf7\x11y\xed/\xcd\x8f\x17\xbcY\xd6{\xe6\x17\x07\xf9\xa9\xb7\xbd\xba)\xf7W\xf9	\xdd\x0dhW\xc1\x9b\xca\xa0\x88\xc4\x87\xbd\x14\x05O\xb0\xb5\xcd\xf7I\x92\xdc\x0d\xb6iF\xf6\x10\"F9\x10\x89\xf7\x92$\x14\xb8~m\x8e\x07\xef\xe3\xa5\xa5\xab\xf9\xa6@\xf7\xa9\x0e\xd1\x9d\x9f\x9f\xaa\x95\x84\xaa\x9c\x91\xc7\x10\xed\x18\xd4K\xff-\x94\xa6\xbbG\x1c\x0b\xae\x81\xeb\x10)M\xa4\xee\xb9\xc3cb/\xca\x03\x84\xf2\xc6=G\x9a\xe84D+\xff\xbab\x94Q\x8e\xed\xda\xe6Y\x12c\xe0\x1ad_d\xba\x9a\x12x\xe7__n\x8ce\xed(\xb8m\x15|\xf7\x16\x89\x07\x90;f\xc2\xacb)\x18\xbbGo\xdfu\x95IW\xb5\xc9ut\x8e)\xd5p?R\x15S\xa9\xf5|\xe9\x86B\xc3I\xe3v\x17\x18\xa3\xb9\xa2j\x18\x1a' \xadm6\x01H\xa1E\xa5o.\x14\xd5T\xf0\x10\x91H	VX;\xb4\xc8C\x84\x83[\xa3Wi\xb9\xc8\x00y\n\x88\x8c\xd3A \xcc\x7f\x19\x91{\xcaqI\xb7\n6V\xe2D\\F\x13\xad\x1b\x9bV\xa6\x04U0\xad\xa6\xa2\xbf\x19I\xb7m\x9b\xe0\x95Z!2\x1au\xcc\xbe$\xef\xad:\x8d*\x94\xe7\x85\xae}\x1b	\x99\x80\x0c\x11\x17\xbc\xf6X\xb5\x82M	\x16*D\x8d\x07\xecIS\xf3J0\x9a\xa0\xab8\x8e\xef\xbb\x11\xba\xf3\x1b}s\x92$e\x8f\xbb\xb5+U\xf3\xa3_ D\xebmo\xf1l\x9a\xf55\x0fw\".\xd4ra\xd2\x88H \xb5%\xa2\xd0\xa6}T\xa6\xa0\xbf\xd1,\x17R\x13\xae\xbbV\xf5\xb4\xafzK\xe5\x97\xbfH| {@O]s\x9e\x1f\x92\xaf\x8b\x05	\x19\xe5\x87~]]\xf9\xfe\xed\xe6\xaeF\x83\xae\xbc_)\x03\x85\xac\x05u\xea\xc9\xaa\xb9m\xea\x94\xb5\xbb\xc6\\\x9c@,$\xa9\x12\xbd\nY)25\xd54u\xae\xe0	H\xe3\x9aZ\xbf\x07\xaa\xa8\x86\xa4_\xfa\x8d\x8aF\xbb\x9dQk\x90\xa8#\xb9f\xbe\xe1\xaa\xc6\xa58\xa2\xa3$y\xcf\x9f\x1fD\x8c\xc6q\xf6\x93\x88\x84\x16C\xa4\xca%\x8c\x9e\xff,\n\x19\x03\xfaY$\x80\xfe\x92\x860\x13\\\xa8\x9c\xc4\xe0bM\x88\xae\x00\xc0I\xc3\x95\x7fI\x1e;\xb9\xdf\x12\x0dzY\xd7\xca_\x0b\x1e\xa3V\xf1\xb2\x8b\xe2R\xb5\xd0,\xe3\xca-\xe5\x96\x90	\x8e$\x90C\x88\xca\x0fL\x18\xabK\xc8l\x99\x93v\xc7,\xf4\xc4\xfc\xc1\x138!\x8f\x9a\x0fL5d\xfd\xb4\x89\x84\xd6\"\xab\x0bv@\xd6K\xcbwom\xc7\xb7E`[s\xbb\xdcfCm\xa5\x8b\x19g\xc4\xd6\xc7\xbd\xaa\x0e\xb1\xd2DC\x06\\\x8ff\xc3lt;L\xffI\x1e\xd0S\xbf\xbft\x02\xdbL\x1b!\xbaJ\"H\xec\xfc\xe5\xd8z\xf9\xc0`B\x88#\xd0G\x00~\x7fi\x12\x8f\xe4\x91\x1b\x12c\xc6D\x9fh5,\xfb\xe5\xb1\x06\xbaH\xb0~2\x18\x16\x1e'\x0fXA\x0e\x92h!]\xc7\xf8\xc8\x1f\x11\xeb\xe5\xd5w\xccI\xd6\xab\xb4yI]\xaa^\xb7\x89\x0b\xa9\x8cssA[\x94\xa9\xe5}.\xa2\x84J\x88\xb5\x90\x14\x14J\x83\xf1\xccq\xd5t\xc9\xd6\xe3d\xab3t\xde^\xd2d\xba\x89\x99\xdd*\xa6\xe6\x1b\xd6\x90\xe5\x8ch0\x83j\x91q\x15\xa2\xb5\x7f\x8dn\xfd\xeb\xb13R\x1cU5|\xd4P|\xb9\x16\x7fG*'|\xdc\xa2\x06j\xeb\xe4nJ:\xf0\x1c\x14nv\\0\x9eQ$\xa1\xd2\xc9\xb6\xedv;Kq$\x92S\xbeGO.~\xaf\x82\xfc\xd4/\xadh\xbdy\xef\xfb\xb3\xdc\"\x92\xeca\x8eWS\xd2\xbe\xb9\x8f\xd4\xc3P\xd5d\x18\xect\xc7?c-\x1c\xd6\xc16\x18\xad\xbeu~\xea\xf7\x01{\xd68\xeb\xdf \x15\x15\xfc\x83\x14\xf9\x07q\xe4\xc8\x13\xbb\x9d\x9b*\x0d\xd0\x0eNG\x85\xd6\x82O\x97Q\xaf\xd3Pn@\x18GL\xc4\x07\x07\x9d\xdc\xf0\x0e\x86/;\xfe\xf9\xf7\xe3U7>\xc4\xf7:b\xd7C\xddXn\xf3\xd3\xb8q\x1e\xa3J\x0f\n\xa8\x89R[\x7f\xe3^\xa9\xc6\xb4\xb3\xc3\x99a\xf0Q$\x05\x83?\xa9\xaa\xd0\xa1\xbd\xde\xd5\xbd}\x1c};T^V~oQ\xb1c`P'\xd3\x10\x05\x1aS\xdc\xfe\xfc\xcb\x89d9\xb3\xc9\xda?\xd6\xc9\xca\xa6\x08\x1bO8\xf4\xce\xdf5$N4\xce\x89N\xdc\xc9\x8a\x91\xa2\xb78=\xd65\x9c\xe4y\xb6\xba\xff\x10\xc9#z\x1a$\x92\xdcG\xaf\x83\xf5f\x89\x82\x9b\xb5\xf9\xdf\xdd\x9b3\xb3S\xd7\x7f\xe5kA\xfd9\xa6C)\xb3\x1d\xa5&\x1d]\xb7\xf1\x7f\xcd\x14\xeb\xc2\xfbM|\x14\xc9\xcfN\xfe\xb6\xb9\xd4\xde\xa2zu\xb2#\xe6\xa7R\xce\xe1\xd0i\xdd6\x9b\xc86\xd8\x92\x9b\xd1\xd3\xd49\xda\xceT\xd6\xe8\xfe\x8c\x04	\x8e\x1e\xcf\x19\xfeGuT\xaa\xefpU\xe90{)X\xde\xfa\xd7\x060/\x06\xcbV\xf2\x8f\x82\xc3J\xe23\xc6	K\xf0\xa3]\xe3\x88\xff\xbf\xfb\xe7\xa3H~\x93$O\xbfGB5\xbc^\xea\xb4\xad\x7f\x8d\x82\xea\xf7b\xc7M\n\xffq.\xf4\x8a\xdc\xbc]\x0e.\xd7\xb1\xbf~\x1fD\xf7s\xd3v\xab\xbd\x84\x9c\x91\xb8\xe1\xd1\x812\xdf\xbb\xdb@\xd6C\xfb\xab\xdb\xdb\xdb^#\xb5=%/\"FU:P\xa5<\xdf\x86p\xa4\xc5\xb8p\xee=T\x0bX\xd3\x0c\x9c\xf6\xd7p\x9b\xd0r8\xbf\x8d\xa8\x1a\xa7\x10\x1fT1q\x9fm/x\xf3\xf7\x14\x97\x9bQ\x9b\xee\xe8\xc0\x01\x16\xffg\x14\xf12\xaa2\xa2\xe3\xd4i\xf66\x8cs\xa4\x05\x9f\x90;\x15(\xab/N\x89\xb2\x02\x9fq;\x1e8\x86\x0b\x99\x11\xf6\x0dQ\xf9EJ!\xbf\xc3\x0bX\xcd\xc8K@\x13\xca\x94\x03\x98Sn`4\x06\xae&\x80\xdf\x1d\xd0>\x01I\xaa7\xd5\xf2\xd3\x99\xd3|\xb4\xda|\xc3kOCcG\x04|\xb2C\xc2\xb4h\x9a\xd9\xfbQFN\xd6E+\xdf\xbf\x9e#\xd2$j\xa6\xcb\xba\xbd\xc4\x821\x92+\x08\x91\xfd6'U\xa7\xcb)\x85\xb4\xcd\xf7\xba\xa5\x85\xe62\xd5)\xa2)\x87t\x84\xfdY\x85B\xa1~\x04\xcf\x01fC\xa6\x8a,#\xd2\x8e2s/\x04\x0d\x89\x0d?\xce\x89\xfe\x86\"\x98e\xa9\x1fs\xeb\xec\xd1;\xec\x8b\xee\x9d\xedm\xe2\xfc\xbds\xa4\xf34\xda\x9e}>4&\xd6\xa7?\x81\x19\x0dj\x93^T\xa9}\x86^?p\x96\xc6\x04\x1a\xf9(hfP\x87\xcaA\xdb\xe7\xbe\xb3l6\xe5|\x84V\x9b\xcb\xa1~^\x83\x1f\xf0\xc6\xe2(`!{9p)t\x9a\xea\x04F\x0e:\xa3\xc3\x820&\x8e\xb30\xe6\x10$\xc0\x87\xf0s\xc1\x08\xe2\xb0)\xf8\x81\x1b\xfc\x7f\x9a|\xe9y(\x18\xc7\x19\x91\x87	Y#8iH,$\x8c6\x94^En\xce\xb6\xae\x8eUc\xe57\xa5\x01\xd6T7-xd\xae\xe8ZleLZS\xb0\xe5\xc2K \x07\x9e\x00\x8f\x1f\xb1]\xed\x99Y\x82\x98y\xbf1l\xcc	\\\xfe\x1b\x81e\xfd\x87\xd0)H\xc7\xd5MB\x94\xc7w\xf4T&X\xf9\x87z\xcc\"\xc1F\xa9G{\xdc\xb0\x9f\xf5\xf9:A\xee&W)\xef\xec\xe3\xdd\xe4\x08\xf2\xa2\xeez.\xbc\xe3a\xfa\xdf\x00PK\x07\x08\x0f@\xcf\xc5\xc6\x07\x00\x00\xdd!\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00public/normalize.cssUT\x05\x00\x01\x80Cm8\xc4X\xdd\x8f\xdc\xb6\x11\x7f\xd7_15\xe0\x97\xcbj?\xce\xb88\x90\x91\x876\xbd\x14\x074p\x91\xcb[``)j\xb4b\x8f\"\x05\x92\xda\x0f;\xfe\xdf\x8b\xa1\xa8\x15\xa5\xd5\xde\xb5\x80\xaf\xf1\x8bo\xc5\x11\xe7\xfb7\xbf\xd1\xea\xe6/\xa0\xb4\xa9\x99\x14\x9fq\xc9\xad\x85\xfd\x0f\xcb\xf5r\x03\x7f\xc0/\x0f\xbf\xc1?\x05Ge\x11\xfe\x80\x9dpU\x9b/\xb9\xaeW\n\xb9\x96\xcc\xae\xc6\xef\xdd\xac\x92du\x03\x7f\xd7\xbc\xadQ\xb9\x04\x00~\xfcf\xff\xc2\xed7	\xdc\xc0f	?ic\x90;p\x15\x82\x14\n\xa1B\xb1\xab\x1c\x08\x05LJ\xc8\x8d>X4vI\xe2\xb7K\xf8\x97\xc1=*\x07\xac\xf8wk\x1dYgA\x97Pj\xe5\xc0\x8a\xcf\x08\xacth@\x1b\x81\xca1'\xb4\x02^1\xb5CK7\x8a\x8f\x8ft\xd1*I*WK\xf8\x92\x80\xd7\x99v:3\xd8,7w\x1f`u\x03\x1b\xb2\x12 =`\xfe$\\\xea\xf0\xe8R\xba>\xed\xf4f\xb0Y\xaf\xdfz\xc9[\x92\xfcJ\x1e\xc1#rRh_/^\xbfb\xad\xf7\xe8cU3\xb3\x13j.L\xab$\xc9uq\xf2\xdeuR\x19\xac?t6\xfa\xa8\xff\x8a\xaa@\xe3o\xd9\xd6L\xa8-\xa0D\n%p\xad\xac\xb0\x0e\x95\x93'\x8a\xd7\xc3}\x08\x17\x89\xf9\x0b\x0ba\x1b\xc9N\x19\xe4R\xf3\xa7\xf8\xd68\x91Q:T\xd1\x9b\xaa\x15l\xab\xcdY\x99\x85\x83p\x95P\xb0\xb5]\xdc\xb6$L\xf6m\x99q\x82K\xdc\x92=\x14z\x9f\xbb\x9f*\xa3k\\\xc0\xcf\xc2`\xa9\x8f\x0b\x92\x86GV2#\xfa\x9cn\xbc\x89\xa4\xdc'+\x83[\xac?\xc4QX~\xff\x1e\xebs0\xe0\x1fF\xb7\x8dP\xbbN\xcf+\x17\xfa_\x8b\xc2\x87\x9c\x878\xe5\xfaH\x15K\xea\x85\xea\xbd\xea\xcb\xfc\xb1\xd2\x07/\xad\xf7hJ\xa9\x0f$s_\xec\xd0{}NKe\xbc\xc7\xb9>\x92\xc3B\xed\xb2\xde\x954\xd7\xc7\xb8\x92\xfb\n_\xc7\x0f\xfb\xcb3\xd8\x0b+r\x89\x93\x8a\x9e\xebQ\xa1*4\xc21\xc5;[,g\x92|\x185\xe1\x95\xe6\x8d\xef\xd1E\x01[\xac\xb7\xe7\xb7B \xa6M\xbfJ\x92\xc6\xe0\x90\xd8\x92\xd5B\x9e2\xa8\xb5\xd2\xb6a\x1c\x17\xc3\x9f\xb1oQ\x15l\xb0\x9e\xb6\xeao\xd4\xd0\x12\xf7(\xc1b\xcd\x94\x13\xfc\xff\xd3\xb6;\xc3N\x903\xfe\xb43\xbaU\x055\x05\xe3N\xec=\xf8=\xf9B\x7f\xb8\x87\xcd:$\x98y\xc7\x07\xf9\x94k\xa9M\x06\xce0e\x1bfP\xb9\xb8\x077\xcb\x18\"r\xed\x9c\xae!\xd7\x86\xda\xfd\xdcBp\xf7>\x0de6\xadIj6(\x90k\xd3Ag\xd4vT|\x0bx\xb8_\xc0\xc7\x06\x0d\x9bi?\x96\xe7\xe6w'\x9c\xc4O\xa1*Io\xdaY\x91\x81\xd2j\x94 \xd2\x95\x0e\xba2h	\x95\x08\x8e\x87d='\x05\x85v\x0e\x8bIf}\xc9N\xdd\xa2Z\x80\xc3y\xae\xf4H\xd2\xb9t\xe1G\xbeH\xac3Z\xed\x86\xa2;\x84\xee\xc9\xb5,\xd0L\"\xfe'w\x07\xd7\x05.\x92\xa7\xbcX$\x96\xd5\xcd7\xee\x94\xeb\xf1\xbc\xe6\xca*IlMv\x9e\x0d!\xc9\x0c~X\xbf\x8d\x03\xd7\x0f\xf2\xadms\x8f\xfc\xf4W\x13M\x87\xd2\xe8\x1aXY\xd2pP\xbb\x19~@\x96\xcd)o)\x81m\x14\x88N\xff\xfb\xbb\xb7\x1f\xa6\xf3~MO\x1amEW\x81\x06%\xa3^\xa4\xa7{\xa4!\xc4d\xca\xa4\xd8\xa9\x0crf\x91\xde\xf5>\xd86\x0f%N\x1d\x96A\xba^\xde\xde\xd1\xac\xf9\x9a\x9cU;\xdd\xf8\x83\xfe\xf9\xea\x06\xee\xeb\x1c\x8b\x02\x8b\xd7\x9f7#\x14\xa06$\xa0\x115\xebx\x90\x15\xc5\x15\xbc\x11\xf5.8F/\xa5\xd6\x9d$\x86\xd6\x0d$\xe7gm\xeaW\x84J\xea'\xcf\xd7\"\x1eAF\xd8\xb9\xea'\x08\x8b<\x0d<c\x98\xa63\xbd\xdd:\xa7\xd5\"\x11\xaai\xdd\"\xd1\x8d#\x1cn\x16\x89E\x89\xdc-\x12B%f\x90]\x0e\x9c0\xf8\xaeuN\xcf\x07\xc3\xd1\xb3\xb4r`e3\xcd6;\xfa\xbb\x89O\xd1\xb9\xca\x0c\xe6<\x84/\xcfN\xfbHk\x14\xc6x\xc2\xeb\xd2#p7oJm\xea^\xdb\x84\x87\x0d\xf6\xfd/\x17E\xa4'\xcaM\x97\x8a\x91\xe9dCz\xb6aT\x90\x17\xdcS(\x96\x0b)\xdc	\x9c\xeej\x07\xb8\x14\xfc\x89\xe5\x12\xc1\x9d\x9a\xf3&p\xbd:~'\xb1\x1f\xdft?\xdf|:?0h\xd1E\xbfm\x9b\xd7\xc2\xbd\xe9\xc6]\xbf+\xb0\xa6Af\xc8\xe9\x0c\xba\x1b\xae\xc6Y\xa1\xe9\xa73\xd9\xd2\xb0\xa2\x088?\x17\x99,Kk\xfd9-5om\xea_\xbe\xb0\xf4\x19\x91`\xfb3\x12\xbd7\x97\"W!\x01z\x9b\xb33\xa9\x0e\x84\xc7:m\xfa\x1e\xe6\xad\xed\x9b\xb8U\x16\x1d\xe4'\x7f\xd2\x18\xdc\x0b\xddZ0\xad\x1c\x97od\x81\x11jw\xe9\xe7\x95\xf3\xde\xc9+\xc7g\x0f\xc7\xe7\xde=\xdd:\xea\xd9\x0c6\xcd1P\x0b\xf8\x9b7\x86\xb8\xe2\xb5b\x0b\xeeO\x18\xfc*IJ\x81\xb2 _\xbf\x8c\x82\xb4|wG\x0b\xc8\xf2}\xf7\xdf\xf7\xe7\xa91O\xb6\xa9\xee\xe1`X\xd3\x84\xba\x98\xac\x00S\xda\xe0\xd9\xe1\xa8\x81\xfd\x14\xdd\xf6\xd6D\xe3\xf5\xbc\xdd\xc1\xbbQ\xd3\xf6\x1eY\x0d\x05\xf1c\xdd\xa0\xb1\xc0\x0c\x82\xd2\x0e8ki9\xd7\xad\x83C\x85\x8a\xd2x\x82\xcfh4=\xa2\xcb\x00\xaei\x9b\x19\xd5\x12w\xa8\x8a\x8b\x15&T\xdad\x83	\xcc787 g\xb4\x93:\xd6\xef0\xe1\x9d\x9a\x1d\xd3\x83(\\\x15-\xec\xe1(\xf8\xd9oD\xef\xba\xa7\x87J8L=E\xca\xc2\xc7\x94\x01\xb8\xaf\x13\xa2\x9e,\x80'\x0b\xe4r\xcc3C3wH\xe9\xd9s(\x92\xc6\xe8\x9dAk}\x04\x9e%\x1c\xa1<\xa2<\x15X\xb2VF\xaa-7Z\xca\x9c\x99\xf3\x0e\xf1]P3\x9ai\xc3\x14`\xad\xd3q]\xbf\xb8\xa3\x9e\x89\xc2d\xee\xf6%3\xe1\x12\xa1gy\x85\xfc)\xd7\xc7\x18GY!\xf4\x9bO\xffe\xe2'\x99\x9ap\xfd\x18\xfayk\xac6\x01\xf4u	Bq\xd3}\xd8 x-\xb0\xff\xd5\xc1H\xf4Ual\xb1j\xeb\x1c\x8d\x07\xc2\x80\xe9\x1emS\xdb\x08\x95\xf6\x0c\xe2\xaa\xacn\xddX\xd6g\xb7\xa7\x023A\x8f= \xde?\x0c\x90\xc1\xc0\xc9\xac\xbaX\x17:\xf0\n\x9e\x0b5^\xcc\x82\xa9\x16\x99\xe1\xd53\xd3\x8a\xea\xc4#E\xccU\xc2\xd5\xa9.K\x8b.\x83\xf4\xb69\xce\xd1\x96\xa84}\xb4\xe2\xaa\xb8\xf0\x81\xd8h\xcd\xf8\xc7\xc7y\x0b\x87\xc0w6Gk\xe2U\xe3\xa7\x94`\xb3\xfc&\xac\xc0G\xba#\xa4\xc4\xf6\xa01\x04\x88N\xa0%z\xb1\x0dh\xb4\xbd\x88\xf9\xe0B)$\xa6m#5+B\xf1\xbcD\x17\xa2\xe8\x93\xce\x19\xcc\xf3n\xc2\x83rh\xba\xaf\x07\xafB\xc8\xe7V\xbf\x80\xb5\x03\x0d\xf4\x1d\xff]\x87mc\xd6R\xa0cB\xda\xeb\x9f\x0d_\xb8\x7fv\xb7\xabkfN\xe3+\xa5\xb0.\x15nX\xb3~\x11\x96\xbf\xde\x862]\x86#\x8b'\xa8[7\x929\x1c\x1b;-\xd3\x97n\xeb\x91\xa9\x12E\x81\xea\xd3\xece\xff\x19\x00PK\x07\x08\x8a\x0f\xc7\x0b\xe2\x06\x00\x00\xf9\x17\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00templates/Error.htmlUT\x05\x00\x01\x80Cm8L\xcc1\xae\xc20\x0c\xc6\xf1=\xa7\xf8\xd4\x03\xb4\xea\x9e\x97\xe91\xb2q\x81\x08\xbb\xd4R\x14\xaa\xa4\xeab\xf9\xee\x88&\x08V\xff\xbe\xbfU\x89\x17\xc9\x8c\xe1R\xca\xb3\x0cf\xce\x93\x1c\xb8\xa7X\xeb_?\x06\x07\x00~\x9d\x83*\xc6\x9b\xec\x89a\xe6\xa7u\xee\xb2\x9dp\xe5Z\xe3\xa3\xd1\xd6D\x15\xb2`\xfc\xe7=J\xaa0\xeb\xfb\xcf\x7fj0\x9c\xfdw\xf5\xdbs\xa6w\xe7'\x92#8U\xced\xe6^\x03\x00PK\x07\x08\x82`\x9aoz\x00\x00\x00\xb8\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x15\x00	\x00templates/Header.htmlUT\x05\x00\x01\x80Cm8l\x8f\xcfJ\x041\x0c\xc6\xef\xfb\x14\x1f\xb9k\x99{gN\xc2zP\xf0\xe0\x0b\xd46N\x83\xddd\x99?\x8bR\xfa\xee2\xea\x88\xa29\x85\xe4\xcb\xefGjM\xfc,\xca\xa0[\x0e\x89'j\xcd\xe7\x8f\x0e\xb1\x84y\xee\xf7\xf9p\x00\x00\x9f\xe4\xb2/\x8a\x8dv\x15M\x97 \xfa\x1d\xd8\xca\xcbi\xfc\x19\"\xccS\xec\xa9V\x14\xd1\x17\x90;\xafOE\xa2\x1bmC\\\x9fu$\xb4F\x08e\xe9\xe9h\xb8\xdbn\xbe|.\xc9\xe5\xaf:w\xff\x8bs7<f\xc6\xd1poi-<\xe3a\xb2\xd77\xdcX\\O\xacKX\xc4\xd4\xbb\xdc\xfd\xa2{\xf7\xf9\xf1p\xa8\x955\xb5\xf6>\x00PK\x07\x08}\xcaW|\xac\x00\x00\x00\x15\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x13\x00	\x00templates/Home.htmlUT\x05\x00\x01\x80Cm8\x94U_o\xdb6\x10\x7f\xf7\xa78\x10\x01*\x01	=\xec5\x967\xac\xc8\xd0\x0e\xe96$\xd9^\x8a\x02\xe1\xc4S\xccM\"5\x92\xd2\x92\xb0\xfc\xee\x03%J\xa2\\'[\xf9b\xfb\xeew\xff\xefwv\x8ec%$\x02y\xa7\x1a$\xdeo\x9c\x03Q\x01\xbd\xb5\xcc\x8a\x12\xbc\xdf\xec\xb8\xe8\xa1\xac\x991\xc5\x08\xdao\x00\x00R\xb1F\xd3\xd5\xd6\\\x94JZ&$\xea\x889\xc6}P\xbc\xab\xf1Z\x18\x9b\x00\xc2s\x0e4\x93\x0f\x08t\x84\x98\x10xQ\xaf\xbd4\x03\xe4BXl\xc8~\xc7\xe0\xa0\xb1*\x88s\xf0\x80\xf6w\xd4\xd7B\xfe5\xf9\x01z\xcd,\x1a\x0b\xde\x93\xbds\xb3\xd8\xfb\xdd\x96\xedw[.\xfa/\x12A\xc9\xd3\xe8	(~\x8d\x1f\xce\x01\xd6\x06\xffg\x8b\x0c2]\x1eNwH\xc8\xb6\xb3 xA\x84\xe4\xf8x\x11\xb1\x83\x98@[\xb3\x12\x0f\xaa\xe6\xa8\x0br;\xa8\xa0R\x1a\xc66\x18J)\x99b\x8f\x86\x04\xecS\x8b\x05\xb1\xf88\xf59\xa6<'\xb5\xc4\x8a\xa3\x9b]\xbc4\xca\xe8!~lv\xa6\xd4\xa2\xb5\xa3\xaeT\xd2X\x98-\xa0\x00\xae\xca\xaeAi\xe9\x03\xda\xab\x1a\xc3\xd7\x1f\x9e\xde\xf3,\x168\x05\xcd\x13\xf3Fq\x03\x05\xfct\xfb\xcb\xcf\xb4e\xda`F\x9c\xfb\xd3(9\x0d\xcdxOr\xf8\xfc\x19>~\xbaL\xec46\xcab\xecK\x01\xce\xd1\x9bD\xe2\xfd\x1a+9\xea\x9b1:\x14\x90i49\x14{p\xf3\x12\x18\xb4w\xa2A\xd5\xd9,;R-~\xc22&E\x96\x1a\x99\xc5XgF\xb8\xe8I~9{\x0c\x8f\x8b\x9e\x0e3\n\xbbO\x19\xe7Y\xca\x85#\xb0FC+\xa5\xafXy\xc8\xb2F\xf1\x13i,\xa9\x04\x16|].\xe1\x05\xab\xe3\x84RZ\xe5\x97/\x84c\xaf\xc4b\xa7\xcc\x18\x0d\xf4\x84\x02\xee\xcf\xdc\x1f\xcc\xe0\xaf\xcc\x1e\xfc\xf6\xcc5\x8a\xd31\xa2\xdf~\xdfGA=\x90\xd5\xdf\x9fr#\xa4D}\x87\x8f\x16\nX\x8c\xbfD\x0e\xa5\xb1\xb6E\xc9\xdf\x1eD\xcd3v\"\xab0\x8f\x14\x13\x8c\x8e`\xfe\xe8\xf7\xbc\xde\xabL\x08y\x15\xf5\xee\xee\xc3\xf5\xab\xa84	.\xfa$\xa6?\x87o\xbf\x89\xbf\xe3\x12\xaf\xd67l\x86\x89\xfa\xff\xa0\xdb\xea\x9e\xe4a\xfd\xaez\x946\x8c\x1e%\xea\xc0\xca\xa09\x87\xaa\x93\xa5\x15JB\x86y\xc2\x89\x91<=\xab;\x84\x02\x90Z\xa6\x1f\xd0\xd2A\xb0$,\xaa\xc0\xa7\x85z\xa9\x87\xf0*\xb4\xe5![\xad\xc1\x98\xd8w\x7f\x17g\x0ee\xa98\xfev\xf3\xfe\xadjZ%Q\xdal\xf0\x9f\xfb\xfb|\x0e1=j\x0f(\xb3\x99\xbd\x810\xe1Rd\xf9K\xd0U\xe7b\xd3\xa6\xa7\xd1vZ.\xb2\xe5\xf4\x8fuW\xdd\xf33\x14 \xf1\x1f\xf8\xb1{~~\x1aO\xcd\xd0\xfes\xf8\xf8f\\\xe27\x9f\x12\xaf\xd3a\x9a\xceL\xf0@\xc7ZcQ\x0bv=\xd3x\x18\xa3\xde\xe7\x9b\xddv:\xb4\xcb?\x93s(\xb9\xf7\xff\x0e\x00PK\x07\x08W\x95\xaf\x16\xe3\x02\x00\x00\xbb\x07\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x18\x00	\x00templates/Importers.htmlUT\x05\x00\x01\x80Cm8\xbc\x911o\xc20\x10\x85\xf7\xfc\x8a\xa7L\xed@R`5\x19\xbaUj%\xd4\x81\xdd\xe0Kl\x01N\xea\x04$t\xf2\x7f\xaf\x92\x18\xea\xa0\x0em\x87\xdex\xf7\xee\xdd\xf3gfE\xa5\xb1\x84\xf4\xe5\xd8\xd4\xae#\xd7\xa6\xde'B\x993v\x07\xd9\xb6\xabhP$\x00 \xf4\xbc\xb8\xf5P\x97`F66\xd6\xb2\xd3\xf0^\xe4z>j\x99aJT\x1d\x1e\x0ed\x91\xbd\xd3\xc7\xc98R\xcf\x97G<\xc1\xfb\xe0\xb7\x80Q\xab\xb4\xd9W3\x17\x04\xb3\xed%-\xaejl/\xbd\xd1\x9d\x03\xbc\xc7\xb1V\xa7\x03\xb5\"\xd7\x8b\x90-\xca]9\xa3f\xbb\xdav\xd2Xr!|_B/\x8b\xb7aS\xe4z9\xedo\xc8\xb5\xa6\xb6\xd3\x013\x9c\xb4\x15\xc5\x0f\xb8\xa6\xefK\xb4\x8d\xb4_>}		\xed\xa8\\\xa5\xcc\xa8\xa8\xdb\x90{5v\x8fl\xbc\x8b,\xdc\x81\xf7i\xd1\xf3\xbb\x91\x93Q\x9e|\xea;\x9ea\x8e\xb7\xef5\xcc \xabnhse\xceE\xf2\xcd bn\x86\xaf\x0b\xcc\xc3\xc7N\x98_{\xc3\xa3\xd1\xc8\xdd^V\x7f\x82\xbe\x1eW\x7fM}\x12\xe0\x0e\xc7\x8f\xa8\x0ft\xff\x97y@\xcfLVy\x9f|\x0e\x00PK\x07\x08\x84\xcd\x02\x8e,\x01\x00\x00h\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00templates/LicenseReport.htmlUT\x05\x00\x01\x80Cm8\x8c\x94Mo\xdb<\x0c\xc7\xef\xf9\x14\x84\x91\xc3\xf3\x00\x9d\x83\xa2\xb7\xc2\xf6vXw\x18\xba\xadh\xb7\xde\x05\x8b\x8e\xb5:\x94!\xc9\xed\x02\x81\xdf}\x90\xe5\xb78\x0d\xb0\\b\xf3\xe5'\x8a\xfc\xd3\xdeK\xac\x14!$\xf7\xaaD\xb2\xf8\x88\xad6.a\xdedR\xbdB\xd9\x08k\xf3\x95\xb3\xd8\x00\x00x\x0f\xdb\x83P\x04\xb79\xa41-\xfd\x16\xde\x99{\x7fV_\x17C\x9e\x05]A&\xa06X\xe5\x89\xf7\xb0G\xf7\x8c\xe6^\xd1Kd\xa4\x0f\xc2\xd5\xc3\xe33\x1a\xab4\x01sR\x8cgD?\xf3'\xef\xcf\xa2\xb2\x9d(\xb2]}\x1d\xabZV\x8d\x7f\xc2]\xecPo\xf8\xdd\xf5\x96\xdb\xb9\x96\x8f\x956\x07\xe1\xf2\xdfVSR|}\xfa\xf1=\xe0\"i'\xd5\xeb9\xd4v\x87\x830\xc7\x05\xd4{h\x90\xe6\x1eh\xd95h\x81\x19$\xb6H\x12\xa9Th\xaf\xa6\xf8\xcc\xb6\x82\xc6\xceJ$\x85\xb2\xbf\xe9\x08\xf8\xdc\x9bb~x\xcav!\xa1\xb8\x00\xe8\xe8\x85\xf4\x1b\x9d\x10~E[@\x0c\xee\x811!\xbc\x07U\x01i\x07\xe9\x83nTy\x04\xe6\xffHC\x13\x07\x06m4*\x0b\xa5\xa6J\xed;\x83\xf2\x7f\xef\x01IN\xf3}\xbf?{\xa3\xe4\x87R\x93\x13\x8a\xd0,\xda\x94\xd57E\xecM\xb6\xaboN\xed\xa3N\xce=ON\xb8ne\xf7\x1e\x1c\x1e\xdaF\xb8\xb5n\x1f\xf5[2\xa8\x92y\x19o\x04\xed\xf1\x9d\x11\xfd+3]\xf1\xce\xfb0\xfcy\x8f$\x997\x9b\x0b\x9b\x15*\x0c\xcb5\x8f\xe3\xc2^D\xc9\xaf\xb7a\\\x84\xa0\xd2\xd0\x87\x85\x7f\\JU\xf5\xf7lD\x89\xc0\xbc\xdc\x07\x13\xad2)\xf2\x98<\x84\x8d\xd0\x13\xdb\x0c\x8e7;\x1d\xfep\xce\x9d1\xda\xacN\xc1`\x8br\x9c\xdc+\xc2\xa8\xc6\xbe\x0b\xe0\x94k0O\xe6)}Qq\x7fB9CY\xf1\xe8\x9e\x1a\x83\xb6\xea\n\xb6\xae\xff\xf6\xfc<\xb6c\xb8\xaa`\xab\x80\xf9j\x9eQ\xf8b\xb8\x08\xc3\xc6\x86\x96\x90&\x84Jw$\xa7\xa0\xd3\x82\x86\x8b\xd8^z\x81\x94F\x15NC\x98^\xc7<\xef\x91$\xf3\xe6\xef\x00PK\x07\x08\xf0v\xd5\xb1\x0f\x02\x00\x00O\x05\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x17\x00	\x00templates/ModGraph.htmlUT\x05\x00\x01\x80Cm8\xacS\xcfo\xd30\x18\xbd\xf7\xaf\xf8dq]\xa3m\xb7\xc91H\x14MB\x94\xa1m\xecn\xe2\xaf\x89Yb\x1b\xdb\xad\x8a\x8c\xffw\xe4$N\x9a\x168\x91S\xbe\x1f~\xef\xe5\xf9%\x04\x81;\xa9\x10\xc8V\x8b{\xcbMCb\\Q!\x0fP\xb5\xdc\xb9r\xee\xb3\x15\x00@\x08\xf0\xa6\xe3R\xc1]	\xeb\xfe\xc0z\x9b\xca\x18\xfb1m\xae\xd9\x06\x0d*\x81\xaa\x92\xe8@\xef\x80rh,\xeeJ\x12\x02\xd4\xe8_\xd0~\x92\xeau\x80Y\x7f\xe1\xbe\x19__\xd0:\xa9\x15\xc4HX\xa6\x19\xe61\xbe\x0b\xe1b\x8b\x16\x9c\xd1\xa2\xb9\x1e\x84\x9dj\xc6\xa3\xd1\xd6\xbbQrz>\xf4\x9d\xbb\xa9\x9e4\xbd\xddi\xdbq_\n\xed	\xdb<<'\xd0y\xcb\x19\xae\xb2\x11\x8a\x1f\xae\x1c\x1a\xb4\xdckK\xd8/Z\xa4)\xfb;\xe4w\xa7\x15a\x1f\x9f\x1e>\xffGP\x8fGOX\xad\xa1\xd3\x02\xea\xe4\xff\x04N\x0b!\x0f\xa3\x19\xcd\x0dHQ\x12\xf3Z_uZ\xec[t\x84=a\x8b\x95G\x01\x87\xc1hG\x8b\xe6\xe6\xd2\xbc\xdaJqUi\xe5\xb9ThO<\xa4\xcd-\xdb\xf6`\xb4hn\x97\xfd\x8c}9y\xc4\x1f{i\xcf'!\x80\xe5\xaa\xc6)C=\xae\xcb1\x9a\xcc\x9fY\x16\x16/\x934\x84\xe4<?9:\x0b\xf3\xc7\x08\xcb\x1d\xac\x1f\xd1\xb4\xbcB\x88\xf14:v\xe8\n\xc2J\x966\xf3ZF[\xf4f\xc6\xc1\xfb\x10\x00\x95X|\xc4yHR\x990\x92\x82\xaf\xa6\xb6\\`:0\xb2\xef\xc7\x0e\x01/}\x8bs\x03\xbe\xfd\x84N*\xd9\xf16_\x1f\xb8\xdes\xa9\x15\x99x\x93\x82\x85\xaa?\xb0\xb3\x91}#-V\x1ebLg\xf2-\x0d%\xb6.\xf9\"\x95\xe8w&\xf8s\xbci\xf0\xaf\xfc\xf5!%9\x07\x1d*\x9fs;\xa5\xcfX\xcc\x7f\xd9\xbd\xdej\xf1~N_\x12\xf7\x8c\xc7\xa4\x93\x16\xc6\"[\x8d4!\xa0\x121\xae~\x0f\x00PK\x07\x08\xbc\xba\xba\xc9\xe5\x01\x00\x00\xc2\x04\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x16\x00	\x00templates/Package.htmlUT\x05\x00\x01\x80Cm8\xa4U\xcbn\xeb6\x10\xdd\xfb+\x06\\\xd9\x0bK\x17\xc9.\x91\x85\xb6q\x92\x06H\x8a N\xbd\xa7\xc5\xb1\xc4\x9a\"U\x8a2b\xa8\xfa\xf7\x82zP\xf2CN\xd1\xcb\x8dh\xce\x99\xc33\x0f\x8e\xcb\x92\xe1\x96K\x04\xf2N\xa3\x1d\x8d\x91T\xd5$`|\x0f\x91\xa0y\xbep\xc7\xe1\x04\x00\xa0,\x0d\xa6\x99\xa0\xa6w\xf8\x83\xee	xU5f\xff\x1d)C}\x15\xb2T\x11\x01\xaf\xc5/U\xe4\xc8\x80o\xc1\xfb@\xcaR\x84\xf1\x1b\x1a\x00\xe9\x90\xbd7J\xd6\xbb\xd5\\\x0f*M\xe9\xf0\xf4,\x9c'.0'\xe0\xd5\xdf\xde\x19E~M\xc2\xe3\x17M\xb3\xc6\xb1\xdb\x8e\x83_$\xc3\xaf\xab	9\x15\xd1\xe2l\x08\xb1\x81\xa9@iC\x91\xb9\xa1\xd2\xe43\xf8\xd1\xde\x15$7\xc0\xd9\x82d\xbbx\x1euf\x12:d\xe0'7]\x1dQ2\xa7\x104\x951\x0e(\xfbH\xe1,\xd45\xd5\xf9P|\x97\xe5K\x1a\xd7Ts\xba\x118\xa2q\xdf\x99I\xe8\x90\xdfit\xc0\x9f\xd1\xd8\xc6\xfbT\xc8h\xc0sFc\xedC\x9aF\xcf\x89\xa0\xcfC\x86\xd7H\xac\xfd\x9cd4wJ\xc3\xd4\xe5\xef%\xcd\x946\xb9\xb72L\xf0\xcd\x0c~\xcc.\x18\xdf\x14+\x04\x8e\x18?\x13\xae\xd9;\xd5\xe6P\x03\xc6e\xb6l\xc4\xf1\x8e%\xcf>\xa3g\xf5\xa6\xd8\x85\x82\xc6\xcaK\x15#\xe1s\xfd\x1d\x96\xf2\xc8\xa5}\x8cK\xccP2\x94\xd1a]\x08\xe9r8\x9c>\xccA\xe6{\x8bi\xc7\x90]Ar\x1bZ?\xd4\xb6\x85\xc0!y\xddC\xb7\x03`!\xfa\x1f\xc7\xb5\x1bQ\xd0\xad@\xf0cO\xbb\x02\n\x89\xc6\xed\x82\x94%\xc4h\xd6\xa8_\xb9\xdc\xd9\xf1e\x12\xf0\xd6\xa8s\xae$T\x15	\xcb\xb2=\xad\xaa_\xcarh\x0b|z\x81\xf8Tg\xb7\xfa^;\xca\xd3\xe9\xb2j\x87\xe2\xbc??^\x9d\x8e\x97e{\xed\x9d\xa5\xf3VE\x9aR}\x80\xaajk\xf1\xc4\xbf\xd0V\x19\x82<\xa3\xb2\x1b\xfe6\xe7\xf3\xad5\x91\xb0ApY\xfbw\xf0\xc0\xb7p\x1bi\xf3\xc2\x02\xffR\xca\x8e\x9b\xbd?k\xb3\xec\x9f\x06~\xce\xe2.\x98\x9c{\x05>\xe3\xfbpr\x0e\x1b\xabT\xf3`>\x942\xdd\xbe\xaf\x8c\x1fk\x9a%$\xec;\x03\xea\x13W\xb1\xa3\xfcH\xba\x9f\xe7\x98\xa1\xa6Fi\x12\xfe\xd3\xa6\xe3\xff\xdf.x\x842\xb7\x03\xf1\xb5\xd9\x81F;\x02\xdc\xf5\xa7Ch8mW\xc5\x86qmg-\x8c\xff\x1d7 \x8c\x8c\xd2\x1c\xff\xf3 o\xd5|\xc3\xdd\xa1H\xef\xe0\xc0]\xfd\xdaj\x05y\xa4yf\x9a\xa0\x98\x8a\x8a\x14\xa5\xf1\xfe.P\x1fV(jy\xbf\n1%\x99\xc6;\xa9\xcc\xb4\x99\x1f\x0fJ\x1a\xca%\xea\x19\x99y[\xa5\x1fi\x94L7BE;X\x84P\xba\xe6H\xc4_\xb9\x97\xf08\x11<N\xcco\x16\xd0\xc0f\xf75\xa6\x9a\xddO\x02\xbf\x93P\x96(YU\xfd;\x00PK\x07\x08c\x92\xd2\x18\xd6\x02\x00\x00\x0b	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00templates/PackageDoc.htmlUT\x05\x00\x01\x80Cm8\x00r\x00\x8d\xff{{define \"PackageDoc\"}}\n<div class=\"PackageDoc\">\n    <div class=\"alldocs\">\n        {{.}}\n    </div>\n</div>\n{{end}}\x03\x00PK\x07\x08\xb6\xcd\xea\x0by\x00\x00\x00r\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00templates/PackageExample.htmlUT\x05\x00\x01\x80Cm8\x8cQ\xddj\x830\x14\xbe\xf7)\x0e\xb9R\xd8\xd2\xfbU\xbd\xe8ZXa\xac{\x85,\xe7\xe8Bc\"\x1a\xcb$\xe4\xddG\xd4\xb5]\xe9`\xde\x18\xf2\xfd\x9c\xef|\xf1\x1e\xa9R\x86\x80\xbd\x0by\x145\xed\xbeD\xd3jb!$9\xaa\x13H-\xfa\xbe\xb8E\xcb\x04\x00`\"(,\x98\xf7|\xbf\x0d\x81\xddg\xbf\x90@\xeaX\xb9\x88!\xf5\x1e\xf8\x9bh\x08B\xc8\xf2\x15\xaa\xd3}\xbb\xc7\x0f\x8b\xe3\x1f\x9e\x1b\x8b#\xa0\xea[-\xc6CU-\x81\xe2\xe7\xbd\xa3\xa6\xd5\xc2]v\xdaZ\xc9\x80o\xad\x0c\xe1L\xcb\xdb\x8e\xca\x18\xe4\xd9b\x0c\x92\xaf\xe2\xc5\x05\x8e\xa9\x0e\x83k\x07\xf7t\x15\xf1J\xc9g\xf4\x97ra.\xbf$\xefe\xa7Z7\xbb\xa6\xd5`\xa4S\xd6@\x9a\x81?\x0f\x92\xd6\xf4\x0e>\xa7\x8a\xa0\x00\xb4rh\xc88^\x93\xdbi\x8a\xc7\xcd\xb8\xc7\xf4\\qv\xa3\x8c\x1d\xfdC7wy\x11\xcf\x03\xb9@\xdc\x9d\xc8\xb8W\xd5;2\xd4\xa5Lj%\x8f\xec!\x86,\xca\xc9\x9dO\x8f\x1a\x19\xdc\xd9\xba\xd6\x94\xb2\xab\xe2\xb3l=\x99\x86,\xcd\xd6I\xbe\xfaY\xd9{2\x18\xc2\xf7\x00PK\x07\x08b\x9a\x02\xad%\x01\x00\x00`\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00templates/PackageExamples.htmlUT\x05\x00\x01\x80Cm8\xaa\xaeNIM\xcb\xccKUP\nHL\xceNLOu\xadH\xcc-\xc8I-V\xaa\xad\xe5\xb2I\xc9,SH\xceI,.\xb6U\xd2C\x97\xb7\xe3RPPP\xa8\xae.J\xccKOU\xd0\xab\xad\x85\xf2KRs\x0br\x12K0LTBR\x93\x9a\x97\x022^?%\xb3\xcc\x8e\xab\xba:5/\xa5\xb6\x160\x00PK\x07\x08BW\xfc\xd0`\x00\x00\x00\x8a\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00templates/PackageFiles.htmlUT\x05\x00\x01\x80Cm8T\x8c1\x8e\x83@\x0cE{N\xf15= Q\x0f\x94[\xadV{\x05\x8b1`\xc1z#\x88h,\xdf=\x82L\xa2\xe0\xc6\xf2\xf7\xfb\xcf,\xf1 \xca\x08\xbf\xd4\xcf4\xf2\x97,\xbc\x05\xf7\"&\xd9\xd1/\xb4m\xed\xf5\xd7\x15\x00\x10\xa7\x06\x92\xdap\x9b\xc7rx\xe6\x99\xc2\xa9\x88\xf5\xd4d\xf2Ct\x92e\xff\xafw\x12\xe55\xbb\x8e1\xc3J:2*\xb8\xbf\xd3H\x98V\x1e\xda`\x86\xea[t\x86{\xe8\x8e\xe3\x87\xfe\x18\xee\xb1\xa6\x8b\x835\xbd\xfa\xb1N\xb2wE^f`Mp\x7f\x0c\x00PK\x07\x08\xb8\x8a\xf6\x82\x94\x00\x00\x00\xf2\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageFunc.htmlUT\x05\x00\x01\x80Cm8tP\xb1j\xec0\x10\xec\xfd\x15\x8b^\xfdd\xb8Zv\xe5\x04R$\x84\x1c\xa4\xdfHk[\xc4^\x1b\xd92\x81e\xff=\xe8\xb8K\xb8\"\xdd\xec\xcc,3\x8cH\xa0>2\x81yE\xff\x89\x03=f\xf6F\xb5r!\x1e\xe0'\xdc\xb6\xe6Nj+\x00\x007\x9e \x86\xc6\x88\xd8\xa7N\xd5\xb4}f\x0f\"0\xd3>.\xe1\x8d<\xc5\x83\x12\xd8\xe7\xbb\xfb\xbc\xa7\xc8\x03\xa8\x16\xab}\xc1\x99@U\x04\x12\xf2@`\xdf\xf3\xc4[Q\x1d\xde\xa2\x8f<\xf1\xff\x0f\x0c\x03\x19\x18\x13\xf5\x8d\xf9w\xa1\xca?\x94\xe0+p5\x16H\x1c@\xd5\xd5\xe3\xe9\xdasMTx{\x8e\x03\xe3\x9e\x13\xfdTpu\xd1..\x91\x9d\xe6u\xc2\xfdw\x86n\xf1\x06l\xb7x\xd5\xbf,\x0f_8\xaf\x13m\x06\xec\x0d\x96\xdd\xea\x10\x8f\xb6\x12!\x0e\xaa\xdf\x03\x00PK\x07\x08,\\\x98\x07\xd9\x00\x00\x00_\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00templates/PackageHeader.htmlUT\x05\x00\x01\x80Cm8\x8cVMo\xdb8\x10\xbd\xf7W\x0c\x98\xa0h\x81J\x96\x9b\xed\"\xeb*\xc2f\x1b\x14\x1b )\x82\xa4\x9bCo\xb45\xb2\x08S\xa4V\xa4\x935\x04\xfe\xf7\x05?\xf4a[i\xa3\x93\xc3\x19\xce\xbcy\xf3f\x98\xb6\xcd\xb1`\x02\x81\xdc\xd1\xd5\x86\xae\xf1o\xa496\xc4\x987i\xce\x9e`\xc5\xa9R\x17\x07\xc6\xec\x0d\x00@\xdb\x02+ \xfe\"\xab\x8a\x8a\x1c\x8cq\xa7i9\xcfV\xe1\xa8m\xc7\xe6tV\xce\xb3\xe0s\xd6\x05fU-\x1b\x1d)M5V(40\xa14\xe5|8!\xd9Zv\xa7.\xe2\xb5\xbbrGu	\xc6\xfciOne\xbe\xe5\xf8\x88\x8dbR\xf8Lg=F\xe4\n\xc7\xe0j_\xa7\x0b\x15\xca\xfaF+|-@\x92\xf9\x13 GX\xc8~\xde\x81\x93\xb6\x85g\xa6K\x88o\xd8\n\x85\xc2\xef\xbb\x1aU\x8fiD3\xf7v\x92\x05\xc7\x05\xa4\x14\xca\x06\x8b\x0brRo\xd6Q\xb0+\x92\xb5-4T\xac\x11N\xd9\x078\xd5\xb0\xb8\x80\x18\x8c\xf1=9e`\xcc\x07\x9b\xd6\x83h[\xeb\xe2\xac\xfe \x9d\xd1,\x9d\xe5\xec\xe9\x05\xb4\xac\x00!5\xc4w\xdb%g\xaa\xc4<\xbeV?\xb0\x91S\x98\xeb\xce\x87\x80f\x9a\xe3\x85#f\xb8\xf9U6\x15\xd5@>&\xc9\xefQ2\x8f\x92\x8f0\xff\xb4H~[$\x9f\xe0\xf6\xe1;\x01cH\xd6\xbb[\xd0\x9aUx\xb9\x96\xa3\xf4\x0e\xf2\xcbp=\xb9_J\\m\xd4\xb6\x9a\x02\xb9\xealA\xb4\x8f\xd8\xb0\x82\xb9\xc0O\xe1\xa7\x8dj\xa5b\xcd\xb7LUT\xaf\xac\xc0\xaa\xf0\xb33\x1b\xb3\x15\xe3+\x8e\xcf\xbd\xd2oQ)+0[\x97\x03\x120\x1f\xe4}{2O\x92\xe4\xec3tX^\x00\xf0\xf6\xe4\x8f\xf3\xf3\xf3\xcf\xd0\x97w\x8c\xe8\x9b\xd4p\x84\xa9O\x9d\xaa\x9a\x8aC\"\xa2\x92\xaa\xd2\xc9(\xfe\xc1j\xc7\xafu\x0b\xf3\xf9si\xc8\x06\xde\xad5\xbc\xe3(\xba	\xc0\xfc\xaf\xdd{H\xde\x8f\x0c\xf7\xf8\xef\x965\xbda\xa2)~\x900\x8f\x96\xbb\x11S\xbd\xe2m2\xef\xd1\xa8\x1b&6\x87\xd36\\\xb1_\x87\x03\x96;\x0b\xfa\x00\x1b\x18\x03a\xf0\x95\x8d[\xc04N77M@>\x0e5TcCUn\xe1\xa8	\xaeg\xf4U\x14\xc6\x8f[.&W\xc0\x935\x8c\xe9\x18\x11\xe6l\x91\x9b2\x92u\xba\x18\x16\xe0\xbd\x94\xfa\xa5\x95\x08L\x01-\n\\\x1dP\xd4\xe1\x80\x8d\x90\xcf\x02l\x06l\xe8\x92q\xa6\x19\xaa\x91\x10\xec\x97n\xf9\xf0G\xd0\x83\xdfB\xfb\xf5t_\xca\x19\xb0\xdc\xe3\x8e,\xce\xeb\xab\xfd\xa9\x98\xeay\xfc\xcf\xfd\x8d\xf3\xea/XR\xfb}\x17_rF\x95[\x9f\xfb\xca\xb6\xd0#j\x8d\xfe\xea\xa0\xe9\xbe\x01\x0b\x0b8~\xd8V\x15mv\x1d\xf9\xe3/l\x87\xaf\xec?\xb7\x1a\x8e\xe3\x17\xd6B2\xef\xc0\x84\xbd0x\x1fd;\xaar\xbcX\xc3#4\x95C\xea\xd2\xbe\xb1W\x12\x95\xdb\xc2\xbem\xa0K\xa6:\x0d\x87TS\x19\xfa\x1d\xf6\xb0\xab\x96\x92\xab\xc9\x14\xca\xdbHv\xe9b+\xe8\xe9u\xcf\x89r\xcf\xc9\x10`\xfaU\xe9\x9bvb\xdf\x17\xd5\xf5\xecT\x0d-C\xf1Kb\xd2\x19gG\xa2:\xf0Kg\x9d\xf0~\xb2\x994V5\xa7\x1a\x81\x04\xd5_5\xb2\xbe\x92\xcf\x82@l\xff\x9b\xf17\xdb\x16En\xcc\xff\x03\x00PK\x07\x08\x0d\n\xca\xc9=\x03\x00\x00\xf7\x08\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x00	\x00templates/PackageImports.htmlUT\x05\x00\x01\x80Cm8\x94\x92Mk\xc2@\x10\x86\xef\xf9\x15CN\xedAS\xf4\xba\xe6^\xb0 Xz\x1f\x9d1\x19L6aw+\xc8\xb0\xff\xbd\xe4\xc3FR\x84\x9a\xdb\xf0\xbe\xf3<	\x13U\xe2\x93X\x86t\x87\xc73\x16\xfc^\xb7\x8d\x0b>\x8d11$\x178V\xe8\xfdf\x9e\xe6	\x00\x80)W \xb4I\xdbs\xb1\x90q/\x1f+&+WCK\x15\xe4\x04E\x80\x97\x8a-,\xf7\x81*9\xbc\xc2\x1b\xc48R\xd6\xf9>\xa0%t\x04\x95\x1c\x1c\xba\xab\xc9\xca\xf5\xefv\xe0\xba\xad0\xcc\xdfq+>\xa47\xde\x0d\xa6\nl\xe9n\xbaW\x7f4\xf4]\xf1\\\x8d5C\xdd'OX\x07\xd4\xbf\xac\x9f\xa58\xda\xa1\x0b\xd7\x99\xb9\x0f\xa0\xed\x92'\xcc\x13\xee\xaf\xddd$\x97<QeK1&\xc9\x83\xeb\xf6\xa4\xd9\x81\x0b'\xb4866\xa0Xv\xe3\x81U\xc1\xa1-\x18\x967\x97\xf1-\xda!\xec\x1e\x83P:>mRUXn\xc5\x9e!\xc64\xef\x86\x1d\x86\x12b4\x19\x8e\xffJ6m\x0e\x90\xae\xf5\xc5\xceKc\xfb\xe2\x94?\xfc\xa0\x9f\x01\x00PK\x07\x08!\x9f\xec\x1a\x14\x01\x00\x00\xae\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1b\x00	\x00templates/PackageIndex.htmlUT\x05\x00\x01\x80Cm8\xe4\x94\xcf\x8e\xda0\x10\xc6\xef<\xc5(\xed\x01\x0e$\x15\xc7\xca\xe1\xd2?\x12\x87V\x95\xa8zw\xf1\x90X\x04\x13%\x06\x81,\xbf\xfb*\xc1\x0e\x8eI\xb2\xd9\xd5^\x96\x9dS\x14\x8f=\xdf\xfc\xc6\xfe\x94b\xb8\xe5\x02!\xf8C7;\x9a\xe0J0<\x07ZO\x08\xe3'\xd8d\xb4,\xe3\xf6\xdar\x02\x00@\xd2\x05p\x16\x07\xf9.\x99\xf3\xeb\xffz+\x89\xd2\xc55C)\xe0[H$L3\x14\x10~;\x88RR!\xcb\x19|\x01\xad\xeb\x14\xb7F}\xc8\x9cK\xdc\x9b\nU\x10\ni\x81\xdb8\xf8\xd4l\x0f\xee7\xcc3.v\xc1\xb2I!\x115\"#\xc6OV\x0d\n\xa6u\x97\xb2\x7f\xb4\xe0\xf4\x7f\x86\xafT\xd6l\xefW\xd6\xa4t+\x03\x14\xcc\"Q\n\n*\x12\x84\xf0\xe7QlJ\x97\x94S\xfc9nm\x85JA\xf8\x9b\xee\x11\xb4\xee\xd7X%\xady\"\xa8<\x16\xb8\x96\x05\x17	h\xdd\x08\xf6D\x8f\xd0\xff\xf7\x92\xe3\xa0~\x9a\xf5\xce\xdd\xd7_\xcb\x1fR//9\x82\xd3g\x9f\xee\x8e\xf1\xd7\x9c\x9d\xd1WA\x8e\xd9\x8d\xf6\xc0Xl\x90\x8c\xb7\xf3_\xdc\xc0\x08\xfcU\x90\xc8\xaf\xe4^\xec*H\xe4jo\x0f\xa7\xe3\xf2\xffB\x99\x1e\x98\xdf\xbfR\xf0\xb9BZa\x87\xafq\x83u\x0c s\xa2\x9d\xfchDMA\xad\xc3\x11\xc0ZXltS\xbcK\x1d\x85\xd5\x07\xe7\x83\xbda\x1fg3?\xcet\x9f{.so\xa26\xcb\xf5Q\x03\xd6.\xbd\x95%\xac\xbe\x0f\x1a\x82)\x07S\xe7Y\xcd\xfa\xdeU/\x02\xa3\xbd~d\xfe\xcfw\xd9PGs\xb5\xd3=Ds\x1faZ\xc6\x9f\x1e\xee2\xba\xdf\xd6\xbb\xcc\x19J\xa1`Z?\x0d\x00PK\x07\x08\xf0+\x90\xdc\xab\x01\x00\x00\xed	\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00templates/PackageLicenses.htmlUT\x05\x00\x01\x80Cm8lOK\x8e\xc20\x0c\xdd\xf7\x14V\xf7\x10\x89\xb5\xe9	f\xc1\x82\x0bX\x8d\xa7\x8d(QTg\xd0T\x96\xef>JK\x06\xa1\xf2v\xf1\xfbF\xd5\xf3w\x88\x0c\xed\x85\xfa\x1b\x0d\xfc\x15z\x8e\xc2\xd2\x9a5\xe8\xc3\x03\xfa\x89D\xce;\xbak\x00\x00p<A\xf0\xe76\xdd\x86\xc3T\x9d]\x15\xa1\x1bO\x9bNu\xa680\x1c\xcd\xd67z\xce\x14&\xd9\xd8\x02\x94\x9f\xfb\x9d\xe6\xe5u)@I\x14\xeb\x84g\xc1!Q\x1e\xdbN\x15\x8e\x17\xca#\x98\xa1+\xb2w\xe7\x7f\xe3uI,f\x1f\x93\xf2\x92xKz\xa5\xa8r\xf4\xcf\x99\x05\xe8v\xcb0\xcd\xbc\xba\xae\xfc\x9bWg9\xac\xf5\xe8\xde~V\xc3\xd0\xf9\xf0\xe8\x1aU\x8e\xde\xac\xf9\x1b\x00PK\x07\x08\xb1a\xe6\x8b\xbd\x00\x00\x00v\x01\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x19\x00	\x00templates/PackageNav.htmlUT\x05\x00\x01\x80Cm8lP\xc1n\xea0\x10\xbc\xf3\x15\xab<\x8e/\xe1\x8eLn\xef\xf0$J9q\xdf\xe2Mb\xe1l\"\xdb\xb8\x95\\\xff{epB\xda\xe2\x9bwgfg&\x04I\x8db\x82\xe2\x88\xe7\x0b\xb6t@_@\x8c+\xc1\xe8\xe1\xac\xd1\xda\xddrU\xaf\x00\x00\x84T\xf3RS\xe3\xf28=\x81\xd3b\xbc\x0b\x96\x8c=\x15\xd0\x19jvE\x08\xd0\x92;\x91\xd9+\xbe@\xf5\xbf\x1f\x07\xe3\x8e\xe8:\xa8^\x06y\xd5t\"c\xd5\xc0\x10cQ\x87\x00\xd5|\xba'\x88Ql\xb0\xde>N\xd9\x11y\xba\xa6nR\xe5\x88\xae\xbb3\x17\xda\x89\x98\xb0\xd9\xfcF*\xff;\x87Qm\xb7\x0c\x12\x02\xac\x19\xfd\x9e\x18\xb6;\xd0\xc4P\x1d\xd0'\xdf6\xf5\x93Q7\x98F\xeb\xfe\xe9\x04\xb3\xd7\xb7W\xa6\x99\xf8\x1dg\x90[\x82\xb5bI\x1f\x7faM\x9azb\x97hO\x95\xc5\xc3\xf2\xf4\x04\xe6\x1e\xff\x8c\x97\xb6\x0c\xc1\x0d\xfb\xe1\x9d\xcc\xac\x95[\x9b\xbe\xb9\xb1Yb\xd9B\x8e\xa8\x1a\xd0.{\x9a\x83\xc4\xf8\xbccF_Z\x1a\xc9\xa0\x1bLQ\x7f>\xd1#\x96?R/&\xb9y\xb1a\xf4\xf5*\x04b\x19\xe3\xd7\x00PK\x07\x08\x97\x11\xc5\xe3'\x01\x00\x00\x82\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1c\x00	\x00templates/PackageReadme.htmlUT\x05\x00\x01\x80Cm8\xaa\xaeNIM\xcb\xccKUP\nHL\xceNLO\x0dJML\xc9MU\xaa\xad\xe5\xb2I\xc9,SH\xceI,.\xb6E\x93\xb4\xe3RPPP\xb0\xc90R\xc8L\xb1U*\xc8N\xd7-\x82J\x04\xb9:\xba\xf8\xba\xda\xe8g\x18A\xd5 \x99\x01S\x03\x96\x00\x81\xeaj\xbd\xdaZ0\xcfF?%\xb3\xcc\x8e\x0bJUW\xa7\xe6\xa5\xd4\xd6r\x01\x06\x00PK\x07\x08\xab\x1ac\x0ck\x00\x00\x00\x9c\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00$\x00	\x00templates/PackageSubdirectories.htmlUT\x05\x00\x01\x80Cm8\x8cQ\xc1n\xdb0\x0c\xbd\xe7+\x08\xdf\x93\x00\xed\xadP|\xd8z\xdc\x86\x02=\xec\xcc\x98\xb4MD\xa6\x0cI\xe9P\x08\xfa\xf7A\xb5\x86\xd4\xe9\x02D\x17\x83\xef=>?\x92)\x11\xf7\xa2\x0c\xcd\x0bv'\x1c\xf8\xf5|$\xf1\xdcE\xe7\x85C\x93\xf3\xc6\x90\xbcAg1\x84\xc3\x0dQ\xbb\x01\x000\xe3\x03\x08\x1d\x9a\xf94lW\xec\xf3\xa50\xfb\xf1\xa1\xaa?\xb9\x0e^h\xdb9\x8d(\xca\xbe\xda\x95g\xc6\xc7\xf6\x05\xe3h\xf6\xe3\xe3\x1a}}W7\x07	k&%\x8f:0\xec\x96)B\xce\x97\xa60\xa3B\x88\xef\x96\x0f\xcd\x8cD\xa2\xc3\xd6r\x1f\x9f %\xd8=\xf3\x1cG\xc8\x99\xa7\x06\xa2\xc4\"*\xf0/\x9c\x18r\xfe\x14\xa9\xbc\x94@z\xd8\xfd\x10=A\xce\x06a\xf4\xdc\x1f\x9a\x94>\xa0\"/\xbd\xdf0\x94^\xb3\xc7R\xb2]\xaa\x8f\x18u\x9b$\xfeJZ\xd8\x82\xb0\x12\xe4\xfc\xbf\x9f\xfett\xb6_\x9c\x8eH\x037\xedB\xdec\xf3\xddM\x13*]'\xaa>\x95\xbdiT\x89\xf5r\xbf\xech\xf7\xefH7F\xf9\x8d^E\x87\xebY\xfe,\xf0\xea\x0e\x17i\xd3\x8avn\x9a-G~\x82\xe0&\x86^,\x07\xe8\xdc\xd9\x12\xa8\x8bpd\x98\xd1\x07\xbe?\x7fJ\xacTC\x9a=\xc9[\xbb\xa9\x9f\x94X)\xe7\xcd\xdf\x01\x00PK\x07\x08\xcf\x1c\x0e3O\x01\x00\x00(\x03\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageType.htmlUT\x05\x00\x01\x80Cm8\x9c\x8f?k\xf30\x10\xc6w\x7f\x8aC\xef\xfc:\x90Y\xf1\xd2\xd0\xad\xa5\x90\xe2\xfdb]lQ\xe7l$\xd9\x10\x8e\xfb\xeeE\xc6n\xe8\xd0\x96v;\x9e??=\x12qt\xf1L`^\xb0y\xc3\x96^o#\x19\xd5\xc2:?C\xd3c\x8c\x87OVU\x00\x00\xd8n\x0f\xde\x1d\x8cH\xf9\x8cWR5U\xba\x8d\x04\"\xb0\x08\xa0*\x02\x01\xb9%(\xeb\xa9\xe7\x08\xaa`q#\xceS\xcf\xff\xcf\xe8Z2\xd0\x05\xba\x1c\xcc\xbfE\xca}\xc8\xb4\xf5\xb0;\xcc'\xb1\x03U\xbb\xeb\xf6\xeb\xf3c\xa0\xac\x97'\xdf2\xa6)\xd0)\x05\xcf\xed\x12\xca\xde\x92\x12It\x1d{L\xf7\xdf\x1d\x87\xc6@y\x1c\x1a\xd5b\xcdl+\x1f\x06\x8e	9\xe5\xa5_\xd5k\x0c\xd1@\xf9\x11 vw\xd0\xca\xa91x<\xf7\x14\xff\x88\xd9\xf6<N\xdc|\xb7%\xfb?B\x9e(u\x83\xfb=\xc6\xee\x9c\x9f\xabB\x84\xd8\xa9\xbe\x0f\x00PK\x07\x08\xa76\x08\x8b\xef\x00\x00\x00%\x02\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1a\x00	\x00templates/PackageVars.htmlUT\x05\x00\x01\x80Cm8T\xcd1\xaa\xc30\x10\x84\xe1^\xa7\x18t\x00\xfb\x02z\xaa|\x80\x07\x86\xf4\x8b\xb4\x11K\x1c\xd9H\x8a\x9be\xef\x1e\x9c&\xa4\xfe\x86\xf9U3\xdf\xa52\xfc?\xa5\x07\x15\xbeQ\xeb\xde\xcc\x85,'\xd2F\xbd\xff\xfdPt\x00\x10\x8e\xc6Q\x15\xd3*\xa5\xd2x5^G\x93Z`\x16\xe6\xcb>+U\x0c~\x1e\x1b\x8d\xef\xff\xb2'\x8fi\xd9\xd3\xd5\x98\xb3\x9c\xd1\xa9r\xcdf\xef\x01\x00PK\x07\x08	\xbfb<r\x00\x00\x00\x8b\x00\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1e\x00	\x00templates/VersionDropDown.htmlUT\x05\x00\x01\x80Cm8\xbcT\xb1n\xdb0\x10\xdd\xfd\x15W6(d\xb4\x92\x97\x0ei#	M\x9b\x0c\x01R\xb4C\x91]\x11O6a\x8a\x14H\xcai@\xf0\xdf\x0bJ\x94M\xcbH\xe2\xa9\xb7\xd8\x12\xdf\xbb{\xf7\xee(k)6L \x90\x07T\x9aIq\xa3dw#\x9f\x04qn\x91S\xb6\x83\x9aWZ\x17'\xc7\xe5\x02\x00 \x7f\x97\xa6\x90o>\xc3\xb7\x9a\xb3z[\x10\xb3a:3r\xbd\xe6HJH\xd3\x00{\xec\x8d\x91\x02\x18-\xc8n\xac\xa3\xd3	5 |X\x0b\xd9OI{\x8e\xa1\x188\x17\x1f\xb2\x06\xb2\x87\x9e\x0b\x0d\xce\xe5\xba\xab\xc4\xa4m\xd7s\x91\xb6\x95\xda\x120\xccp,\xc8u\xd3`m\x90\xc2\xe33l\x85|\x12\xe01\xa8\xaaG\xc6\x99a\xa8I\xf9\xe1\xfd\x97\xcb\xcb\xcb\xab|\xe53\x95\xd6\x02\n\x1a\x17\x1c+D\x8a\xd3\x8e\xf7\x9aL5+\xa5\xe4\x13)?\x06\xfe+\xb4\x96\x899\x0fd\xd3\x902\x8d\xb9\xf9j\xf4(\x18\xe6\x9d\x8fks\xa6MZKa*&P\xed\xb3\x1d\xbf\x1e\xd3\xc6\x96]\xb0\xb6\x83\xaf\x05dwm'\x95\xf9]\x99\xcd\xcc\xd4\x0b\xc3Z\xd4\x03&\xb8\xfegx1CM\xf6q\x1c\xa1\xfb\xc7@\x9a\x13T%\xd6\xb8O\xa9\xa3C\xdf\xdaA\xa3\x8f\xbc\x82\x8d\xc2\xa6 \xd6\xae\xd1<\xa0\xbagb;J\xcf\x9c#~6\x99\x1f\xf9\xaa:\xe6\x8d+\xc1\x04\xc5\xbfG\x02\xb3\xff\xb2\x1f\x87a\x87Y\x84\xc5N\xbd\xa1\x83h\xff\xe7z-!	\x12\xfd\xb3\x86l\xe9\xe5E\x93\xf7\x91\xaf\x8e\\\xb1\x16\x05\x0d\x9e\x85\xa3\xf0\xb3\xc8u\xadXgFl\xd2\xf4\xa26L\nH\x96`\xf7\xd2j)\xb4\x01\xe4P\x00\x95u\xdf\xa20\xd9\x1a\xcd-G\xff\xf7\xfb\xf3\x1dMN\xae\xe1\xf2jF\xf7\xdb~F\x82\xf1V\x9c\xb0\x87\xa5?\x87>\x00O\xf9~\xb1\x7fL\xeb~N\x9e\xd9\x05\x89\x12r4\xc0\xf4\xaf\x0e\x05\x14\xd0T\\\xe3\xe1\x0cyVQz\xbbCa\xee\x996(P%d\xf8\x94\x91O\xde\xd3\xa2\x8cl\xf5\xc1\x1aH\xc6d\xb1\xe1Sx/\xb2a\x1d|\xb6La+w\x98\x10\x7f-#AS\x0c\xadG\xf0\x8a\xd2\x17\xb1G~\x9c\xc9y\xa9i\x1f\x0e\x90k\x04{v\xa17z\x99u\xfe\x9a\xacy\xdbod\xdewaT\x1fM\xce\xc7\xe1\xa3\xe2B\xfbn\x99,\x17\xf9j\xba#\xd6\xa2\xa0\xce\xfd\x1b\x00PK\x07\x08b\xf4f\xc0W\x02\x00\x00\xe5\x06\x00\x00PK\x03\x04\x14\x00\x08\x00\x08\x00\x00\x00!(\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x14\x00	\x00templates/index.htmlUT\x05\x00\x01\x80Cm8\xacT\xddo\xd30\x10\x7f\xef_q\xf8e\xa0\x91\x04\xde\x10K\x8a\xd0\x98\xd8\xd0\xd0\xaa\x0d4xt\xedK\xe2\xd5\xf1\x05\xfb\xd2\xae\xcb\xfa\xbf\xa34mi\xbb\x0f\xf10\xbfT\xf7\xf1\xfb\xf0\xe5\xdc\xf4\xd5\x97\x8b\xe3\x1f\xbfG'Pre\x87\x83\xb4\xfb\x01+]\x91	tb8\x18\xa4%J=\x1c\x00\x00\xa4\x15\xb2\x04UJ\x1f\x903\xd1p\x1e}\x10\xdb\xa5\x92\xb9\x8e\xf0Oc\xa6\x99\xf8\x15\xfd\xfc\x1c\x1dSUK6c\x8b\x02\x149F\xc7\x998;\xc9P\x17\xb8\x83t\xb2\xc2LL\x0d\xcej\xf2\xbc\xd5<3\x9a\xcbL\xe3\xd4(\x8c\x96\xc1[\xe3\x0c\x1bi\xa3\xa0\xa4\xc5\xec}\xfcn\xcdd\x8d\x9b\x80G\x9b	\xa3\xc8	(=\xe6\x99h[X\x16DR7ckT\x92\xcbiW\x8f\x8d\"\x01\x8b\xc5Ct\xe0\xb9\xc5P\"\xb2\x00\x9e\xd7\x98	\xc6[NT\x08Or:\xf2\x95\xb4\xe6\x0e\xe3e\xd7\x0b\xb1V\xd2\xb8\x17%\x94LUD\x0e#k\x8a\x92\xf7\xa9\x83\xf2\xa6f\x08^=2\xb5\xd2\x14e\x8f\xaa\xa5\x9a\xc47\xab[\xa6I\x8f\xda\x1eb/\xde-C\xf8\x98$99\x0eqATX\x94\xb5	\xb1\xa2\xaa3\xf9)\x97\x95\xb1\xf3\xec\x92\xc6\xc4t\x7fE\x8dWxxL\x1a\x0fG\x9e\xee\xaf\xc9O\x0e\xaf\xa4\x0b\xe2\xc1m{\xad\xb659\xc4\xc6i\xbc],\xfe\xe3\x02ysw\xf7\x84\xed\xb6E\xa7\xd7$l\xd8\xe20\xf7\xcb\x0d\xd4i\xd2\xc7\x834\xe9\x1f\xc2 \x1d\x93\x9e\xafq\xb0\xf1\x00k\xbc\xa3m\xea\xee\xa4\x81=\xb9bx\x8d\x07\x1e!\x90\xf7s\x187\x0ck\x0d\xd0\x84\xc1\x1d0\xcc\xc8O\xa0\xf6T\xa3\xb7s\x98\x19.\xa9a\xf8&\xa7\xf2jI	\xe8\xe4\xd8\xa2\x8eadQ\x06\\\xc5`\x18\x986r\xdd\xe9\x1e\x9bq\x0d\xc6i\xb2\x12_\x96\xd3d\xd7]\xdb\x02:\xbd\xb1\xbeo\\\x91\x0b\x0cc\x19p$\xb9\x84\xac\xeb\x8f7\xe1bq\xb4\xd1,\xedM\x88\xbb\x87y\xba^\x13\xe3\x8a\x0bwNR\xbf~s\xb4\xd2\xde\xa6O\xb5\x99\x82\xd1\x99\x90u\xbd\xfa\xa0\xddi[\xc6\xaa\xb6\x92\x11\xc4)J\x8d^\xac\xcc=2\xef\x9df\xaaP@\xac%\xcb]\x00\xda\x80=\xaa\xea\xfeZ\xd0\x87=\xe4\xd9:\xff<\xbc\xf0\xb2.\xf7\xa0\xdfI\x7f\xed\xd2\xcf#\xadQ\xe8\x02\xee\xeb\x9e\xf7\xe9K\xec\xe4\x9fg@\xef\xc9\xef\xc1O\xba\xdc\xd3\xb0\xdd\xe6\x91T\x13Y\xfc\x1b\xd0\xdewO\xb4\x99v\x1b\xdeo\xf6 MJ\xae\xec\xf0\xef\x00PK\x07\x08\x04W}&{\x02\x00\x00\x1c\x06\x00\x00PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x01\xc9\x04\xe8;\x02\x00\x00\xf5\x04\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x00\x00\x00\x00public/atom-one-light.cssUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf7\x871\xc7<\x05\x00\x006\x16\x00\x00\x12\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x8b\x02\x00\x00public/favicon.icoUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x9d\x9d	\x9f\xeb\x05\x00\x001\x11\x00\x00\x0e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x10\x08\x00\x00public/fuzz.jsUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(Y\xc6o\xd9(-\x00\x00\xd59\x00\x00\x11\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81@\x0e\x00\x00public/gologo.pngUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa2\xf3\xc50\xa1\x13\x00\x00\xa3(\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xb0;\x00\x00public/highlight.pack.jsUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0f@\xcf\xc5\xc6\x07\x00\x00\xdd!\x00\x00\x0f\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xa0O\x00\x00public/main.cssUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x8a\x0f\xc7\x0b\xe2\x06\x00\x00\xf9\x17\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xacW\x00\x00public/normalize.cssUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x82`\x9aoz\x00\x00\x00\xb8\x00\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xd9^\x00\x00templates/Error.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(}\xcaW|\xac\x00\x00\x00\x15\x01\x00\x00\x15\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x9e_\x00\x00templates/Header.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(W\x95\xaf\x16\xe3\x02\x00\x00\xbb\x07\x00\x00\x13\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x96`\x00\x00templates/Home.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x84\xcd\x02\x8e,\x01\x00\x00h\x03\x00\x00\x18\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xc3c\x00\x00templates/Importers.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf0v\xd5\xb1\x0f\x02\x00\x00O\x05\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81>e\x00\x00templates/LicenseReport.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xbc\xba\xba\xc9\xe5\x01\x00\x00\xc2\x04\x00\x00\x17\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xa0g\x00\x00templates/ModGraph.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(c\x92\xd2\x18\xd6\x02\x00\x00\x0b	\x00\x00\x16\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd3i\x00\x00templates/Package.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb6\xcd\xea\x0by\x00\x00\x00r\x00\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xf6l\x00\x00templates/PackageDoc.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(b\x9a\x02\xad%\x01\x00\x00`\x02\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xbfm\x00\x00templates/PackageExample.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(BW\xfc\xd0`\x00\x00\x00\x8a\x00\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x818o\x00\x00templates/PackageExamples.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb8\x8a\xf6\x82\x94\x00\x00\x00\xf2\x00\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xedo\x00\x00templates/PackageFiles.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(,\\\x98\x07\xd9\x00\x00\x00_\x01\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xd3p\x00\x00templates/PackageFunc.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x0d\n\xca\xc9=\x03\x00\x00\xf7\x08\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xfdq\x00\x00templates/PackageHeader.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(!\x9f\xec\x1a\x14\x01\x00\x00\xae\x02\x00\x00\x1d\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\x8du\x00\x00templates/PackageImports.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xf0+\x90\xdc\xab\x01\x00\x00\xed	\x00\x00\x1b\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xf5v\x00\x00templates/PackageIndex.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xb1a\xe6\x8b\xbd\x00\x00\x00v\x01\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81\xf2x\x00\x00templates/PackageLicenses.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x97\x11\xc5\xe3'\x01\x00\x00\x82\x02\x00\x00\x19\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x04z\x00\x00templates/PackageNav.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xab\x1ac\x0ck\x00\x00\x00\x9c\x00\x00\x00\x1c\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa4\x81{{\x00\x00templates/PackageReadme.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xcf\x1c\x0e3O\x01\x00\x00(\x03\x00\x00$\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x819|\x00\x00templates/PackageSubdirectories.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\xa76\x08\x8b\xef\x00\x00\x00%\x02\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe3}\x00\x00templates/PackageType.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(	\xbfb<r\x00\x00\x00\x8b\x00\x00\x00\x1a\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81#\x7f\x00\x00templates/PackageVars.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(b\xf4f\xc0W\x02\x00\x00\xe5\x06\x00\x00\x1e\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\xe6\x7f\x00\x00templates/VersionDropDown.htmlUT\x05\x00\x01\x80Cm8PK\x01\x02\x14\x03\x14\x00\x08\x00\x08\x00\x00\x00!(\x04W}&{\x02\x00\x00\x1c\x06\x00\x00\x14\x00	\x00\x00\x00\x00\x00\x00\x00\x00\x00\xb4\x81\x92\x82\x00\x00templates/index.htmlUT\x05\x00\x01\x80Cm8PK\x05\x06\x00\x00\x00\x00\x1e\x00\x1e\x00N	\x00\x00X\x85\x00\x00\x00\x00"
 	fs.Register(data)
 }
@@ -0,0 +1,314 @@
+package sumdb
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"marwan.io/moddoc/fetch"
+	"marwan.io/moddoc/gocopy/module"
+	"marwan.io/moddoc/gocopy/sumdb/note"
+	"marwan.io/moddoc/gocopy/sumdb/tlog"
+)
+
+// ErrNotFound is returned by a DB that has no
+// checksums for a module version.
+var ErrNotFound = errors.New("no checksums for module version")
+
+// Sums are the h1: hashes of a module version's
+// zip and go.mod file.
+type Sums struct {
+	Zip   string
+	GoMod string
+}
+
+// DB returns the known checksums of module versions.
+type DB interface {
+	Lookup(ctx context.Context, mod, ver string) (*Sums, error)
+}
+
+// SumGolangOrgKey is the verifier key of https://sum.golang.org.
+const SumGolangOrgKey = "sum.golang.org+033de0ae+Ac4zctda0e5eza+HJyk9SxEdh+s3Ux18htTTAD8OuAn8"
+
+// NewClient returns a DB that looks checksums up in the checksum
+// database at url, such as https://sum.golang.org, using its /lookup
+// endpoint. A record is only trusted if the tree head that comes with
+// it is signed by vkey, if the tiles of the database prove that the
+// record is in that tree, and that the tree is consistent with the
+// ones of the previous lookups. An empty vkey is SumGolangOrgKey for
+// sum.golang.org and an error for any other database.
+func NewClient(rawurl, vkey string) (DB, error) {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return nil, err
+	}
+	if vkey == "" {
+		if u.Host != "sum.golang.org" {
+			return nil, fmt.Errorf("a verifier key is required for the checksum database %v", u.Host)
+		}
+		vkey = SumGolangOrgKey
+	}
+	verifier, err := note.NewVerifier(vkey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid verifier key: %v", err)
+	}
+	return &client{
+		url:       strings.TrimSuffix(rawurl, "/"),
+		verifiers: note.VerifierList(verifier),
+		tiles:     map[tlog.Tile][]byte{},
+	}, nil
+}
+
+type client struct {
+	url       string
+	verifiers note.Verifiers
+
+	mu     sync.Mutex
+	tiles  map[tlog.Tile][]byte // verified full tiles
+	latest tlog.Tree            // the largest verified tree head
+}
+
+func (c *client) Lookup(ctx context.Context, mod, ver string) (*Sums, error) {
+	encPath, err := module.EncodePath(mod)
+	if err != nil {
+		return nil, err
+	}
+	encVer, err := module.EncodeVersion(ver)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := fetch.Fetch(ctx, c.url+"/lookup/"+encPath+"@"+encVer)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case 200:
+	case 404, 410:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("checksum database returned %v", resp.Status)
+	}
+	bts, err := readAll(resp.Body, 1<<20)
+	if err != nil {
+		return nil, err
+	}
+	// A record is its id on the first line, followed by its go.sum lines
+	// and a blank line that separates it from the signed tree head.
+	id, text, treeMsg, err := tlog.ParseRecord(bts)
+	if err != nil {
+		return nil, fmt.Errorf("malformed checksum database record: %v", err)
+	}
+	n, err := note.Open(treeMsg, c.verifiers)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify the tree head of the checksum database: %v", err)
+	}
+	tree, err := tlog.ParseTree([]byte(n.Text))
+	if err != nil {
+		return nil, fmt.Errorf("malformed checksum database tree head: %v", err)
+	}
+	err = c.checkTree(ctx, tree)
+	if err != nil {
+		return nil, err
+	}
+	err = c.checkRecord(ctx, tree, id, text)
+	if err != nil {
+		return nil, err
+	}
+	sums := GoSum{}
+	err = sums.parse(bytes.NewReader(text), 1)
+	if err != nil {
+		return nil, fmt.Errorf("malformed checksum database record: %v", err)
+	}
+	return sums.Lookup(ctx, mod, ver)
+}
+
+// checkRecord checks that the record id of the
+// checksum database is text, in the given tree.
+func (c *client) checkRecord(ctx context.Context, tree tlog.Tree, id int64, text []byte) error {
+	if id < 0 || id >= tree.N {
+		return fmt.Errorf("checksum database record %d is not in its tree of %d records", id, tree.N)
+	}
+	hr := tlog.TileHashReader(tree, c.tileReader(ctx))
+	hashes, err := hr.ReadHashes([]int64{tlog.StoredHashIndex(0, id)})
+	if err != nil {
+		return fmt.Errorf("could not prove the checksum database record %d: %v", id, err)
+	}
+	if hashes[0] != tlog.RecordHash(text) {
+		return fmt.Errorf("checksum database record %d is not the one in its tree", id)
+	}
+	return nil
+}
+
+// checkTree checks that tree and the latest verified tree head are
+// consistent, one being a prefix of the other, so that the database
+// cannot show this client a fork of its log, and keeps the largest
+// of them as the latest.
+func (c *client) checkTree(ctx context.Context, tree tlog.Tree) error {
+	for {
+		c.mu.Lock()
+		latest := c.latest
+		c.mu.Unlock()
+		err := c.checkConsistent(ctx, latest, tree)
+		if err != nil {
+			return err
+		}
+		c.mu.Lock()
+		// another lookup may have verified a new tree in the meantime,
+		// in which case tree is checked against that one too.
+		if c.latest == latest {
+			if tree.N > latest.N {
+				c.latest = tree
+			}
+			c.mu.Unlock()
+			return nil
+		}
+		c.mu.Unlock()
+	}
+}
+
+// checkConsistent proves that the smaller of the two
+// trees is a prefix of the other with the tiles of the
+// larger one.
+func (c *client) checkConsistent(ctx context.Context, a, b tlog.Tree) error {
+	small, large := a, b
+	if small.N > large.N {
+		small, large = b, a
+	}
+	if small.N == 0 {
+		return nil
+	}
+	var err error
+	if small.N == large.N {
+		if small.Hash != large.Hash {
+			err = errors.New("the tree hashes differ")
+		}
+	} else {
+		hr := tlog.TileHashReader(large, c.tileReader(ctx))
+		var proof tlog.TreeProof
+		proof, err = tlog.ProveTree(large.N, small.N, hr)
+		if err == nil {
+			err = tlog.CheckTree(proof, large.N, large.Hash, small.N, small.Hash)
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("the checksum database tree of %d records is not consistent with the verified tree of %d records: %v", b.N, a.N, err)
+	}
+	return nil
+}
+
+func (c *client) tileReader(ctx context.Context) *tileReader {
+	return &tileReader{c: c, get: func(path string) ([]byte, error) {
+		return c.get(ctx, path)
+	}}
+}
+
+// get returns the content at path of the checksum database.
+func (c *client) get(ctx context.Context, path string) ([]byte, error) {
+	resp, err := fetch.Fetch(ctx, c.url+"/"+path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("checksum database returned %v for %v", resp.Status, path)
+	}
+	return readAll(resp.Body, 1<<20)
+}
+
+// GoSum is a DB backed by go.sum lines, mapping
+// module@version and module@version/go.mod to their hash.
+type GoSum map[string]string
+
+// LoadGoSum reads a go.sum style allowlist from the given file.
+func LoadGoSum(name string) (GoSum, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	sums := GoSum{}
+	err = sums.parse(f, 1)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", name, err)
+	}
+	return sums, nil
+}
+
+// parse adds the go.sum lines read from r, starting at the given line.
+func (gs GoSum) parse(r io.Reader, start int) error {
+	scnr := bufio.NewScanner(r)
+	for line := 1; scnr.Scan(); line++ {
+		if line < start {
+			continue
+		}
+		fields := strings.Fields(scnr.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 3 || !strings.HasPrefix(fields[2], "h1:") {
+			return fmt.Errorf("line %d: expected \"module version h1:hash\"", line)
+		}
+		gs[fields[0]+"@"+fields[1]] = fields[2]
+	}
+	return scnr.Err()
+}
+
+// Lookup returns the sums listed for the module version. A module
+// version with only one of its two lines gets an empty hash for the
+// other.
+func (gs GoSum) Lookup(ctx context.Context, mod, ver string) (*Sums, error) {
+	sums := &Sums{
+		Zip:   gs[mod+"@"+ver],
+		GoMod: gs[mod+"@"+ver+"/go.mod"],
+	}
+	if sums.Zip == "" && sums.GoMod == "" {
+		return nil, ErrNotFound
+	}
+	return sums, nil
+}
+
+// Chain returns a DB that asks each of dbs in order, moving on to
+// the next one when a database does not know a module version or
+// only knows one of its two hashes.
+func Chain(dbs ...DB) DB {
+	return chain(dbs)
+}
+
+type chain []DB
+
+func (c chain) Lookup(ctx context.Context, mod, ver string) (*Sums, error) {
+	var found *Sums
+	for _, db := range c {
+		sums, err := db.Lookup(ctx, mod, ver)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if found == nil {
+			found = &Sums{}
+		}
+		if found.Zip == "" {
+			found.Zip = sums.Zip
+		}
+		if found.GoMod == "" {
+			found.GoMod = sums.GoMod
+		}
+		if found.Zip != "" && found.GoMod != "" {
+			break
+		}
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
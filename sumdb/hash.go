@@ -0,0 +1,64 @@
+// Package sumdb verifies module zips and go.mod files against known
+// checksums, either from a checksum database speaking the protocol of
+// sum.golang.org or from a go.sum style allowlist.
+package sumdb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Hash1 computes the h1: hash used by go.sum and the checksum database
+// over the given files, mapped from their name to their content. The
+// names of the files of a module zip include the module@version prefix.
+func Hash1(files map[string][]byte) (string, error) {
+	sums := make(map[string][sha256.Size]byte, len(files))
+	for name, content := range files {
+		sums[name] = sha256.Sum256(content)
+	}
+	return Hash1Sums(sums)
+}
+
+// Hash1Sums is like Hash1 but takes the SHA-256 of each file instead
+// of its content, so that files can be hashed as they are streamed.
+func Hash1Sums(sums map[string][sha256.Size]byte) (string, error) {
+	names := make([]string, 0, len(sums))
+	for name := range sums {
+		if strings.Contains(name, "\n") {
+			return "", fmt.Errorf("file names with new lines are not supported: %q", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		fmt.Fprintf(h, "%x  %s\n", sums[name], name)
+	}
+	return "h1:" + base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// HashGoMod computes the h1: hash of a go.mod file, as
+// recorded by go.sum lines ending in /go.mod.
+func HashGoMod(content []byte) string {
+	h, _ := Hash1(map[string][]byte{"go.mod": content})
+	return h
+}
+
+// readAll is ioutil.ReadAll with a cap, so that a misbehaving
+// database cannot make us buffer an unbounded response.
+func readAll(r io.Reader, max int64) ([]byte, error) {
+	var buf bytes.Buffer
+	n, err := buf.ReadFrom(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > max {
+		return nil, fmt.Errorf("response larger than %d bytes", max)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,291 @@
+package sumdb
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"marwan.io/moddoc/gocopy/sumdb/note"
+	"marwan.io/moddoc/gocopy/sumdb/tlog"
+)
+
+func TestHashGoMod(t *testing.T) {
+	// from the go.sum of this repository
+	expected := "h1:1lud6UwP+6orDFRuTfBEV8e9/aOM/c4fVVCaMa2zaAs="
+	if got := HashGoMod([]byte("module github.com/gorilla/mux\n")); got != expected {
+		t.Fatalf("expected %v but got %v", expected, got)
+	}
+}
+
+func TestHash1Order(t *testing.T) {
+	a, _ := Hash1(map[string][]byte{"m@v1.0.0/a.go": []byte("a"), "m@v1.0.0/b.go": []byte("b")})
+	b, _ := Hash1(map[string][]byte{"m@v1.0.0/b.go": []byte("b"), "m@v1.0.0/a.go": []byte("a")})
+	if a != b {
+		t.Fatalf("expected the hash to not depend on map order: %v != %v", a, b)
+	}
+	if _, err := Hash1(map[string][]byte{"bad\nname": nil}); err == nil {
+		t.Fatal("expected an error for a file name with a new line")
+	}
+}
+
+// testDB is a checksum database whose tree head is signed by a new key.
+type testDB struct {
+	t      *testing.T
+	signer note.Signer
+	vkey   string
+	tamper func(text string) string
+
+	mu      sync.Mutex
+	records []string
+	stored  []tlog.Hash
+	ids     map[string]int64
+	head    []byte
+}
+
+// newTestDB serves a checksum database of the given records.
+func newTestDB(t *testing.T, records []string, tamper func(text string) string) (*httptest.Server, *testDB) {
+	skey, vkey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := note.NewSigner(skey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := &testDB{t: t, signer: signer, vkey: vkey, tamper: tamper}
+	db.publish(records)
+	return httptest.NewServer(db), db
+}
+
+// publish replaces the log of the database with the given records,
+// which are a fork of the previous log unless they extend it.
+func (db *testDB) publish(records []string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.records, db.stored, db.ids = records, nil, map[string]int64{}
+	for i, rec := range records {
+		hashes, err := tlog.StoredHashes(int64(i), []byte(rec), db.hashReader())
+		if err != nil {
+			db.t.Fatal(err)
+		}
+		db.stored = append(db.stored, hashes...)
+		f := strings.Fields(rec)
+		db.ids["/lookup/"+f[0]+"@"+f[1]] = int64(i)
+	}
+	tree := tlog.Tree{N: int64(len(records))}
+	var err error
+	tree.Hash, err = tlog.TreeHash(tree.N, db.hashReader())
+	if err != nil {
+		db.t.Fatal(err)
+	}
+	db.head, err = note.Sign(&note.Note{Text: string(tlog.FormatTree(tree))}, db.signer)
+	if err != nil {
+		db.t.Fatal(err)
+	}
+}
+
+// hashReader must be called with db.mu held.
+func (db *testDB) hashReader() tlog.HashReader {
+	return tlog.HashReaderFunc(func(indexes []int64) ([]tlog.Hash, error) {
+		hashes := make([]tlog.Hash, len(indexes))
+		for i, x := range indexes {
+			hashes[i] = db.stored[x]
+		}
+		return hashes, nil
+	})
+}
+
+func (db *testDB) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	if strings.HasPrefix(r.URL.Path, "/tile/") {
+		tile, err := tlog.ParseTilePath(r.URL.Path[1:])
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := tlog.ReadTileData(tile, db.hashReader())
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+		return
+	}
+	id, ok := db.ids[r.URL.Path]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	text := db.records[id]
+	if db.tamper != nil {
+		text = db.tamper(text)
+	}
+	rec, err := tlog.FormatRecord(id, []byte(text))
+	if err != nil {
+		db.t.Error(err)
+	}
+	w.Write(append(rec, db.head...))
+}
+
+// testRecords are enough records for the tree to have two levels of tiles.
+func testRecords() []string {
+	return makeRecords(300)
+}
+
+func makeRecords(n int) []string {
+	var records []string
+	for i := 0; i < n; i++ {
+		mod := fmt.Sprintf("example.com/mod%d", i)
+		records = append(records, fmt.Sprintf("%v v1.0.0 h1:zip%d=\n%v v1.0.0/go.mod h1:gomod%d=\n", mod, i, mod, i))
+	}
+	return records
+}
+
+func TestClient(t *testing.T) {
+	srv, tdb := newTestDB(t, testRecords(), nil)
+	defer srv.Close()
+	db, err := NewClient(srv.URL, tdb.vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, i := range []int{0, 42, 299} {
+		sums, err := db.Lookup(ctx, fmt.Sprintf("example.com/mod%d", i), "v1.0.0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sums.Zip != fmt.Sprintf("h1:zip%d=", i) || sums.GoMod != fmt.Sprintf("h1:gomod%d=", i) {
+			t.Fatalf("unexpected sums: %+v", sums)
+		}
+	}
+	_, err = db.Lookup(ctx, "example.com/other", "v1.0.0")
+	if err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound but got %v", err)
+	}
+
+	_, otherKey, err := note.GenerateKey(rand.Reader, "sum.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err = NewClient(srv.URL, otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Lookup(ctx, "example.com/mod1", "v1.0.0"); err == nil {
+		t.Fatal("expected an error for a tree head signed by another key")
+	}
+
+	if _, err := NewClient(srv.URL, ""); err == nil {
+		t.Fatal("expected an error for a database other than sum.golang.org without a key")
+	}
+	if _, err := NewClient("https://sum.golang.org", ""); err != nil {
+		t.Fatalf("expected the key of sum.golang.org by default but got %v", err)
+	}
+}
+
+func TestClientTamperedRecord(t *testing.T) {
+	srv, tdb := newTestDB(t, testRecords(), func(text string) string {
+		return strings.Replace(text, "h1:zip7=", "h1:evil=", 1)
+	})
+	defer srv.Close()
+	db, err := NewClient(srv.URL, tdb.vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = db.Lookup(context.Background(), "example.com/mod7", "v1.0.0")
+	if err == nil || !strings.Contains(err.Error(), "not the one in its tree") {
+		t.Fatalf("expected a record that is not in the tree but got %v", err)
+	}
+}
+
+func TestClientForkedTree(t *testing.T) {
+	srv, tdb := newTestDB(t, makeRecords(300), nil)
+	defer srv.Close()
+	db, err := NewClient(srv.URL, tdb.vkey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, err := db.Lookup(ctx, "example.com/mod1", "v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a tree that extends the verified one is accepted
+	tdb.publish(makeRecords(310))
+	if _, err := db.Lookup(ctx, "example.com/mod305", "v1.0.0"); err != nil {
+		t.Fatalf("expected a grown tree to be accepted but got %v", err)
+	}
+
+	// a tree where a past record changed is a fork, even with more records
+	forked := makeRecords(320)
+	forked[7] = strings.Replace(forked[7], "h1:zip7=", "h1:evil=", 1)
+	tdb.publish(forked)
+	_, err = db.Lookup(ctx, "example.com/mod315", "v1.0.0")
+	if err == nil || !strings.Contains(err.Error(), "not consistent") {
+		t.Fatalf("expected a forked tree to be rejected but got %v", err)
+	}
+
+	// and so is another tree of the same size
+	forked = makeRecords(310)
+	forked[300] = strings.Replace(forked[300], "h1:zip300=", "h1:evil=", 1)
+	tdb.publish(forked)
+	_, err = db.Lookup(ctx, "example.com/mod1", "v1.0.0")
+	if err == nil || !strings.Contains(err.Error(), "not consistent") {
+		t.Fatalf("expected a forked tree of the same size to be rejected but got %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	f, err := ioutil.TempFile("", "go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	fmt.Fprint(f, "example.com/mod v1.0.0 h1:zip=\n\ngo.sum database tree\n5678\n")
+	f.Close()
+	// a signed tree head is not part of an allowlist
+	if _, err := LoadGoSum(f.Name()); err == nil {
+		t.Fatal("expected an error for a malformed go.sum")
+	}
+	gs := GoSum{}
+	gs["example.com/mod@v1.0.0"] = "h1:zip="
+	gs["example.com/mod@v1.0.0/go.mod"] = "h1:gomod="
+	ctx := context.Background()
+	db := Chain(GoSum{}, gs)
+
+	err = Verify(ctx, db, "example.com/mod", "v1.0.0", &Sums{Zip: "h1:zip=", GoMod: "h1:gomod="})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = Verify(ctx, db, "example.com/mod", "v1.0.0", &Sums{Zip: "h1:zip=", GoMod: "h1:other="})
+	if me, ok := err.(*MismatchError); !ok || me.File != "go.mod" {
+		t.Fatalf("expected a go.mod mismatch but got %v", err)
+	}
+	err = Verify(ctx, db, "example.com/mod", "v1.1.0", &Sums{Zip: "h1:zip="})
+	if err != ErrNotFound {
+		t.Fatalf("expected ErrNotFound but got %v", err)
+	}
+	err = Verify(ctx, db, "example.com/mod", "v1.0.0", &Sums{Zip: "h1:zip="})
+	if me, ok := err.(*MissingError); !ok || me.File != "go.mod" || me.Downloaded {
+		t.Fatalf("expected a missing downloaded go.mod hash but got %v", err)
+	}
+
+	zipOnly := GoSum{"example.com/mod@v1.0.0": "h1:zip="}
+	err = Verify(ctx, zipOnly, "example.com/mod", "v1.0.0", &Sums{Zip: "h1:zip=", GoMod: "h1:gomod="})
+	if me, ok := err.(*MissingError); !ok || me.File != "go.mod" || !me.Downloaded {
+		t.Fatalf("expected a go.mod hash missing from the database but got %v", err)
+	}
+	gomodOnly := GoSum{"example.com/mod@v1.0.0/go.mod": "h1:gomod="}
+	err = Verify(ctx, Chain(zipOnly, gomodOnly), "example.com/mod", "v1.0.0", &Sums{Zip: "h1:zip=", GoMod: "h1:gomod="})
+	if err != nil {
+		t.Fatalf("expected the chain to combine the hashes of both databases but got %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package sumdb
+
+import (
+	"marwan.io/moddoc/gocopy/sumdb/tlog"
+)
+
+// tileHeight is the height of the tiles served by sum.golang.org.
+const tileHeight = 8
+
+// maxTiles bounds the verified full tiles that a client keeps.
+const maxTiles = 1024
+
+// tileReader reads the tiles of a client's checksum database for one
+// lookup. Tiles are only kept once tlog has verified them against the
+// signed tree head, and only when they are full: full tiles never
+// change while partial ones grow with the tree.
+type tileReader struct {
+	c   *client
+	get func(path string) ([]byte, error)
+}
+
+func (tr *tileReader) Height() int {
+	return tileHeight
+}
+
+func (tr *tileReader) ReadTiles(tiles []tlog.Tile) ([][]byte, error) {
+	data := make([][]byte, len(tiles))
+	for i, t := range tiles {
+		tr.c.mu.Lock()
+		d, ok := tr.c.tiles[t]
+		tr.c.mu.Unlock()
+		if ok {
+			data[i] = d
+			continue
+		}
+		d, err := tr.readTile(t)
+		if err != nil {
+			return nil, err
+		}
+		data[i] = d
+	}
+	return data, nil
+}
+
+// readTile downloads t. A partial tile is no longer served once the
+// tree has grown past it, in which case it is cut from the full one.
+func (tr *tileReader) readTile(t tlog.Tile) ([]byte, error) {
+	d, err := tr.get(t.Path())
+	if err == nil {
+		return d, nil
+	}
+	full := t
+	full.W = 1 << uint(t.H)
+	if t.W == full.W {
+		return nil, err
+	}
+	d, fullErr := tr.get(full.Path())
+	if fullErr != nil || len(d) < t.W*tlog.HashSize {
+		return nil, err
+	}
+	return d[:t.W*tlog.HashSize], nil
+}
+
+func (tr *tileReader) SaveTiles(tiles []tlog.Tile, data [][]byte) {
+	tr.c.mu.Lock()
+	defer tr.c.mu.Unlock()
+	for i, t := range tiles {
+		if t.W != 1<<uint(t.H) {
+			continue
+		}
+		if len(tr.c.tiles) >= maxTiles {
+			tr.c.tiles = map[tlog.Tile][]byte{}
+		}
+		tr.c.tiles[t] = data[i]
+	}
+}
@@ -0,0 +1,71 @@
+package sumdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// MismatchError is returned by Verify when a computed
+// hash differs from the one in the database.
+type MismatchError struct {
+	Module  string
+	Version string
+	File    string // zip or go.mod
+	Got     string
+	Want    string
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("checksum mismatch for the %v of %v@%v: downloaded %v but the checksum database has %v",
+		e.File, e.Module, e.Version, e.Got, e.Want)
+}
+
+// MissingError is returned by Verify when a hash could
+// not be compared because one of the two sides lacks it.
+type MissingError struct {
+	Module  string
+	Version string
+	File    string // zip or go.mod
+	// Downloaded reports whether the downloaded side has the
+	// hash, in which case the database is the one missing it.
+	Downloaded bool
+}
+
+func (e *MissingError) Error() string {
+	if e.Downloaded {
+		return fmt.Sprintf("the checksum database has no hash for the %v of %v@%v", e.File, e.Module, e.Version)
+	}
+	return fmt.Sprintf("no hash was computed for the downloaded %v of %v@%v", e.File, e.Module, e.Version)
+}
+
+// Verify checks the sums computed from a downloaded module version
+// against the ones db knows. It returns nil only if both the zip and
+// the go.mod hashes were compared and match. Otherwise it returns
+// ErrNotFound if db does not know the module version, a
+// *MismatchError if a hash differs and a *MissingError if a hash is
+// missing on either side.
+func Verify(ctx context.Context, db DB, mod, ver string, got *Sums) error {
+	want, err := db.Lookup(ctx, mod, ver)
+	if err != nil {
+		return err
+	}
+	files := []struct {
+		name      string
+		got, want string
+	}{
+		{"zip", got.Zip, want.Zip},
+		{"go.mod", got.GoMod, want.GoMod},
+	}
+	// a mismatch matters more than a missing hash.
+	for _, f := range files {
+		if f.got != "" && f.want != "" && f.got != f.want {
+			return &MismatchError{Module: mod, Version: ver, File: f.name, Got: f.got, Want: f.want}
+		}
+	}
+	for _, f := range files {
+		if f.got == "" || f.want == "" {
+			return &MissingError{Module: mod, Version: ver, File: f.name, Downloaded: f.got != ""}
+		}
+	}
+	return nil
+}
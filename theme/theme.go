@@ -0,0 +1,293 @@
+// Package theme loads the templates and public assets of moddoc from
+// the embedded files, letting a directory or any other file system of
+// the same layout override them file by file: templates/*.html and
+// public/*.
+package theme
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"text/template/parse"
+	"time"
+)
+
+// Theme holds the parsed templates of moddoc and
+// the file system that serves its public assets.
+type Theme struct {
+	base     http.FileSystem
+	override http.FileSystem
+	funcs    template.FuncMap
+
+	mu    sync.RWMutex
+	tmpl  *template.Template
+	stamp string
+}
+
+// New parses the templates of base, overridden by the ones of dir if
+// it is not empty. It fails if a template does not parse, if a template
+// uses one that is not defined or if there is no index.html template.
+func New(base http.FileSystem, dir string, funcs template.FuncMap) (*Theme, error) {
+	if dir == "" {
+		return NewFS(base, nil, funcs)
+	}
+	return NewFS(base, http.Dir(dir), funcs)
+}
+
+// NewFS is like New but overrides base with the files of override, if
+// not nil, such as the file system of statik of a program embedding its
+// own theme.
+func NewFS(base, override http.FileSystem, funcs template.FuncMap) (*Theme, error) {
+	if override != nil {
+		f, err := override.Open("/")
+		if err != nil {
+			return nil, err
+		}
+		fi, err := f.Stat()
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			return nil, fmt.Errorf("%v is not a directory", fi.Name())
+		}
+	}
+	t := &Theme{base: base, override: override, funcs: funcs}
+	if _, err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Templates returns the current templates.
+func (t *Theme) Templates() *template.Template {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.tmpl
+}
+
+// FileSystem returns the files of the theme,
+// falling back to the embedded ones.
+func (t *Theme) FileSystem() http.FileSystem {
+	if t.override == nil {
+		return t.base
+	}
+	return &overlay{t.override, t.base}
+}
+
+// Reload parses the templates again if the override templates
+// changed since they were last parsed, and reports whether it did.
+// The current templates are kept if the new ones are not valid, and
+// the error is only returned once for a given state of the overrides.
+func (t *Theme) Reload() (bool, error) {
+	stamp, err := t.overrideStamp()
+	if err != nil {
+		return false, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.tmpl != nil && stamp == t.stamp {
+		return false, nil
+	}
+	tmpl, err := t.parse()
+	t.stamp = stamp
+	if err != nil {
+		return false, err
+	}
+	t.tmpl = tmpl
+	return true, nil
+}
+
+// Watch reloads the templates every interval until the context
+// is canceled. Reload errors are reported to onErr, if given,
+// and do not stop the watch.
+func (t *Theme) Watch(ctx context.Context, interval time.Duration, onErr func(error)) error {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if _, err := t.Reload(); err != nil && onErr != nil {
+			onErr(err)
+		}
+	}
+}
+
+func (t *Theme) parse() (*template.Template, error) {
+	files, err := t.templateFiles()
+	if err != nil {
+		return nil, err
+	}
+	root := template.New("root").Funcs(t.funcs)
+	for _, name := range files {
+		f, err := t.FileSystem().Open("/templates/" + name)
+		if err != nil {
+			return nil, err
+		}
+		bts, err := ioutil.ReadAll(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := root.New(name).Parse(string(bts)); err != nil {
+			return nil, err
+		}
+	}
+	if root.Lookup("index.html") == nil {
+		return nil, fmt.Errorf("theme: no index.html template")
+	}
+	if err := checkReferences(root); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+// templateFiles returns the names of the embedded
+// templates and of the override ones.
+func (t *Theme) templateFiles() ([]string, error) {
+	f, err := t.base.Open("/templates")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dir, err := f.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	override, err := t.overrideTemplates()
+	if err != nil {
+		return nil, err
+	}
+	dir = append(dir, override...)
+	seen := map[string]bool{}
+	names := []string{}
+	for _, fi := range dir {
+		if fi.IsDir() || !strings.HasSuffix(fi.Name(), ".html") || seen[fi.Name()] {
+			continue
+		}
+		seen[fi.Name()] = true
+		names = append(names, fi.Name())
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// overrideStamp describes the override templates by their
+// names, sizes and modification times, to notice changes.
+func (t *Theme) overrideStamp() (string, error) {
+	dir, err := t.overrideTemplates()
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, fi := range dir {
+		fmt.Fprintf(&sb, "%s %d %d\n", fi.Name(), fi.Size(), fi.ModTime().UnixNano())
+	}
+	return sb.String(), nil
+}
+
+// overrideTemplates lists the templates directory of the override
+// file system, which does not have to exist, sorted by name.
+func (t *Theme) overrideTemplates() ([]os.FileInfo, error) {
+	if t.override == nil {
+		return nil, nil
+	}
+	f, err := t.override.Open("/templates")
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	dir, err := f.Readdir(-1)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(dir, func(i, j int) bool { return dir[i].Name() < dir[j].Name() })
+	return dir, nil
+}
+
+// checkReferences makes sure that every template
+// invoked by another one is defined.
+func checkReferences(root *template.Template) error {
+	for _, tmpl := range root.Templates() {
+		if tmpl.Tree == nil {
+			continue
+		}
+		var err error
+		walk(tmpl.Tree.Root, func(name string) {
+			if err == nil && root.Lookup(name) == nil {
+				err = fmt.Errorf("%v: template %q is not defined", tmpl.Name(), name)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walk(n parse.Node, used func(name string)) {
+	switch n := n.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, n := range n.Nodes {
+			walk(n, used)
+		}
+	case *parse.IfNode:
+		walk(n.List, used)
+		walk(n.ElseList, used)
+	case *parse.RangeNode:
+		walk(n.List, used)
+		walk(n.ElseList, used)
+	case *parse.WithNode:
+		walk(n.List, used)
+		walk(n.ElseList, used)
+	case *parse.TemplateNode:
+		used(n.Name)
+	}
+}
+
+// overlay opens the files of dir, or of base if dir does not have
+// them. Directories are opened from base so that a partial override
+// does not hide the other files.
+type overlay struct {
+	dir  http.FileSystem
+	base http.FileSystem
+}
+
+func (o *overlay) Open(name string) (http.File, error) {
+	name = path.Clean("/" + name)
+	f, err := o.dir.Open(name)
+	if err != nil {
+		return o.base.Open(name)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return o.base.Open(name)
+	}
+	if !fi.IsDir() {
+		return f, nil
+	}
+	bf, err := o.base.Open(name)
+	if err != nil {
+		return f, nil
+	}
+	f.Close()
+	return bf, nil
+}
@@ -0,0 +1,202 @@
+package theme
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func tempDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "theme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, dir, files)
+	return dir
+}
+
+var baseFiles = map[string]string{
+	"templates/index.html":  `{{ template "Header.html" }}|{{ template "Footer.html" }}`,
+	"templates/Header.html": `base header`,
+	"templates/Footer.html": `base footer`,
+	"public/main.css":       `base css`,
+	"public/main.js":        `base js`,
+}
+
+func execute(t *testing.T, th *Theme) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := th.Templates().Lookup("index.html").Execute(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func readFile(t *testing.T, fs http.FileSystem, name string) string {
+	t.Helper()
+	f, err := fs.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	bts, err := ioutil.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(bts)
+}
+
+func TestOverride(t *testing.T) {
+	base := tempDir(t, baseFiles)
+	defer os.RemoveAll(base)
+	dir := tempDir(t, map[string]string{
+		"templates/Header.html": `company header`,
+		"public/main.css":       `company css`,
+		"public/logo.png":       `logo`,
+	})
+	defer os.RemoveAll(dir)
+
+	th, err := New(http.Dir(base), dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := execute(t, th), "company header|base footer"; got != want {
+		t.Fatalf("expected %q but got %q", want, got)
+	}
+	fs := th.FileSystem()
+	for name, want := range map[string]string{
+		"/public/main.css": "company css",
+		"/public/main.js":  "base js",
+		"/public/logo.png": "logo",
+	} {
+		if got := readFile(t, fs, name); got != want {
+			t.Fatalf("expected %v to be %q but got %q", name, want, got)
+		}
+	}
+}
+
+// subFS serves the files below prefix of fs, like
+// the file system of an embedded theme would.
+type subFS struct {
+	fs     http.FileSystem
+	prefix string
+}
+
+func (s subFS) Open(name string) (http.File, error) {
+	return s.fs.Open(s.prefix + path.Clean("/"+name))
+}
+
+func TestOverrideFS(t *testing.T) {
+	base := tempDir(t, baseFiles)
+	defer os.RemoveAll(base)
+	dir := tempDir(t, map[string]string{
+		"company/templates/Footer.html": `company footer`,
+		"company/public/main.js":        `company js`,
+	})
+	defer os.RemoveAll(dir)
+
+	th, err := NewFS(http.Dir(base), subFS{http.Dir(dir), "/company"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := execute(t, th), "base header|company footer"; got != want {
+		t.Fatalf("expected %q but got %q", want, got)
+	}
+	if got := readFile(t, th.FileSystem(), "/public/main.js"); got != "company js" {
+		t.Fatalf("expected the overridden main.js but got %q", got)
+	}
+
+	_, err = New(http.Dir(base), filepath.Join(dir, "missing"), nil)
+	if !os.IsNotExist(err) {
+		t.Fatalf("expected a missing theme directory to fail but got %v", err)
+	}
+}
+
+var invalidTestCases = []struct {
+	name  string
+	files map[string]string
+	err   string
+}{
+	{"syntax", map[string]string{"templates/Header.html": `{{ if }}`}, "missing value for if"},
+	{"undefined template", map[string]string{"templates/Footer.html": `{{ template "Copyright.html" }}`}, `"Copyright.html" is not defined`},
+	{"undefined function", map[string]string{"templates/Footer.html": `{{ brand }}`}, `"brand" not defined`},
+}
+
+func TestInvalid(t *testing.T) {
+	base := tempDir(t, baseFiles)
+	defer os.RemoveAll(base)
+	for _, tc := range invalidTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := tempDir(t, tc.files)
+			defer os.RemoveAll(dir)
+			_, err := New(http.Dir(base), dir, nil)
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("expected an error containing %q but got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestReload(t *testing.T) {
+	base := tempDir(t, baseFiles)
+	defer os.RemoveAll(base)
+	dir := tempDir(t, map[string]string{"templates/Header.html": `v1`})
+	defer os.RemoveAll(dir)
+
+	th, err := New(http.Dir(base), dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := th.Reload(); reloaded || err != nil {
+		t.Fatalf("expected no reload without changes but got %v, %v", reloaded, err)
+	}
+
+	header := filepath.Join(dir, "templates", "Header.html")
+	later := time.Now().Add(time.Minute)
+	update := func(content string) {
+		writeFiles(t, dir, map[string]string{"templates/Header.html": content})
+		later = later.Add(time.Minute)
+		if err := os.Chtimes(header, later, later); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	update(`v2`)
+	if reloaded, err := th.Reload(); !reloaded || err != nil {
+		t.Fatalf("expected a reload but got %v, %v", reloaded, err)
+	}
+	if got, want := execute(t, th), "v2|base footer"; got != want {
+		t.Fatalf("expected %q but got %q", want, got)
+	}
+
+	update(`{{ end }}`)
+	if _, err := th.Reload(); err == nil {
+		t.Fatal("expected an invalid template to fail the reload")
+	}
+	if reloaded, err := th.Reload(); reloaded || err != nil {
+		t.Fatalf("expected the failure to be reported once but got %v, %v", reloaded, err)
+	}
+	if got, want := execute(t, th), "v2|base footer"; got != want {
+		t.Fatalf("expected the previous templates %q but got %q", want, got)
+	}
+}
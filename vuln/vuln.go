@@ -0,0 +1,257 @@
+// Package vuln reads a local copy of the Go vulnerability database,
+// stored as OSV JSON entries, and answers which module versions and
+// symbols the known vulnerabilities affect.
+package vuln
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"marwan.io/moddoc/gocopy/semver"
+)
+
+// Entry is an OSV entry, with the fields moddoc uses.
+type Entry struct {
+	ID               string      `json:"id"`
+	Aliases          []string    `json:"aliases"`
+	Summary          string      `json:"summary"`
+	Details          string      `json:"details"`
+	Affected         []*Affected `json:"affected"`
+	DatabaseSpecific struct {
+		URL string `json:"url"`
+	} `json:"database_specific"`
+}
+
+// Affected is a module affected by an Entry.
+type Affected struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Ranges            []*Range `json:"ranges"`
+	EcosystemSpecific struct {
+		Imports []*Import `json:"imports"`
+	} `json:"ecosystem_specific"`
+}
+
+// Range is a list of versions where a vulnerability was introduced
+// or fixed. Only the ranges of the SEMVER type are used.
+type Range struct {
+	Type   string   `json:"type"`
+	Events []*Event `json:"events"`
+}
+
+// Event is a version, without the v prefix, where a vulnerability
+// was introduced or fixed. Introduced 0 is the first version ever.
+type Event struct {
+	Introduced string `json:"introduced,omitempty"`
+	Fixed      string `json:"fixed,omitempty"`
+}
+
+// Import is a package of an affected module, with its vulnerable
+// symbols: functions, types and methods written as Type.Method. No
+// symbols means the whole package is affected.
+type Import struct {
+	Path    string   `json:"path"`
+	Symbols []string `json:"symbols"`
+}
+
+// URL returns the page of the entry in the Go vulnerability database.
+func (e *Entry) URL() string {
+	if e.DatabaseSpecific.URL != "" {
+		return e.DatabaseSpecific.URL
+	}
+	return "https://pkg.go.dev/vuln/" + e.ID
+}
+
+// DB is an in-memory vulnerability database, indexed by module path.
+type DB struct {
+	byModule map[string][]*Entry
+	size     int
+}
+
+// Load reads every OSV entry found in dir and its sub directories,
+// skipping the JSON files that are not entries, such as the indexes
+// of the database.
+func Load(dir string) (*DB, error) {
+	db := &DB{byModule: map[string][]*Entry{}}
+	err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() || filepath.Ext(path) != ".json" {
+			return nil
+		}
+		bts, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var e Entry
+		if json.Unmarshal(bts, &e) != nil || e.ID == "" || len(e.Affected) == 0 {
+			return nil
+		}
+		db.add(&e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// New returns a DB of the given entries.
+func New(entries ...*Entry) *DB {
+	db := &DB{byModule: map[string][]*Entry{}}
+	for _, e := range entries {
+		db.add(e)
+	}
+	return db
+}
+
+func (db *DB) add(e *Entry) {
+	seen := map[string]bool{}
+	for _, a := range e.Affected {
+		mod := a.Package.Name
+		if !seen[mod] {
+			seen[mod] = true
+			db.byModule[mod] = append(db.byModule[mod], e)
+		}
+	}
+	db.size++
+}
+
+// Len returns the number of entries in db.
+func (db *DB) Len() int {
+	return db.size
+}
+
+// Vulns returns the entries that affect the module version, sorted by ID.
+func (db *DB) Vulns(mod, ver string) []*Entry {
+	var list []*Entry
+	for _, e := range db.byModule[mod] {
+		if e.Affects(mod, ver) {
+			list = append(list, e)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+// Affects reports whether the entry affects the module version.
+func (e *Entry) Affects(mod, ver string) bool {
+	for _, a := range e.Affected {
+		if a.Package.Name == mod && a.affects(ver) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fixed returns the earliest version, with the v prefix, that
+// fixes the vulnerability for the module at ver, if any.
+func (e *Entry) Fixed(mod, ver string) string {
+	fixed := ""
+	for _, a := range e.Affected {
+		if a.Package.Name != mod {
+			continue
+		}
+		for _, r := range a.Ranges {
+			for _, ev := range r.Events {
+				v := canonical(ev.Fixed)
+				if v != "" && semver.Compare(v, ver) > 0 && (fixed == "" || semver.Compare(v, fixed) < 0) {
+					fixed = v
+				}
+			}
+		}
+	}
+	return fixed
+}
+
+// Symbols returns the affected symbols of the package pkg of the module
+// mod. It returns nil, true if the whole package is affected and
+// nil, false if the package is not affected at all.
+func (e *Entry) Symbols(mod, pkg string) ([]string, bool) {
+	for _, a := range e.Affected {
+		if a.Package.Name != mod {
+			continue
+		}
+		imports := a.EcosystemSpecific.Imports
+		if len(imports) == 0 {
+			return nil, true
+		}
+		for _, imp := range imports {
+			if imp.Path == pkg {
+				return imp.Symbols, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// affects applies the events of the semver ranges in version order:
+// a version is affected from an introduced event until a fixed one.
+func (a *Affected) affects(ver string) bool {
+	for _, r := range a.Ranges {
+		if r.Type != "SEMVER" {
+			continue
+		}
+		type event struct {
+			ver   string
+			fixed bool
+		}
+		var events []event
+		for _, ev := range r.Events {
+			if ev.Introduced != "" {
+				events = append(events, event{canonical(ev.Introduced), false})
+			}
+			if ev.Fixed != "" {
+				events = append(events, event{canonical(ev.Fixed), true})
+			}
+		}
+		sort.SliceStable(events, func(i, j int) bool {
+			return compare(events[i].ver, events[j].ver) < 0
+		})
+		affected := false
+		for _, ev := range events {
+			if compare(ver, ev.ver) < 0 {
+				break
+			}
+			affected = !ev.fixed
+		}
+		if affected {
+			return true
+		}
+	}
+	return false
+}
+
+// canonical adds the v prefix to an OSV version. The
+// introduced version 0 stands for the first version ever,
+// which it returns as the empty string.
+func canonical(v string) string {
+	if v == "" || v == "0" {
+		return ""
+	}
+	if !strings.HasPrefix(v, "v") {
+		v = "v" + v
+	}
+	return v
+}
+
+// compare is semver.Compare where the empty string
+// sorts before every version.
+func compare(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+	return semver.Compare(a, b)
+}
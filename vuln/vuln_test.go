@@ -0,0 +1,96 @@
+package vuln
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const entryJSON = `{
+	"id": "GO-2021-0001",
+	"aliases": ["CVE-2021-0001"],
+	"summary": "Panic on crafted input",
+	"affected": [{
+		"package": {"name": "example.com/lib", "ecosystem": "Go"},
+		"ranges": [{"type": "SEMVER", "events": [
+			{"introduced": "0"}, {"fixed": "1.1.0"},
+			{"introduced": "1.2.0"}, {"fixed": "1.2.3"}
+		]}],
+		"ecosystem_specific": {"imports": [
+			{"path": "example.com/lib/parse", "symbols": ["Parse", "Parser.Next"]}
+		]}
+	}]
+}`
+
+var affectsTestCases = []struct {
+	name     string
+	mod, ver string
+	affected bool
+	fixed    string
+}{
+	{"first version", "example.com/lib", "v0.1.0", true, "v1.1.0"},
+	{"pre-release", "example.com/lib", "v1.1.0-rc.1", true, "v1.1.0"},
+	{"fixed", "example.com/lib", "v1.1.0", false, "v1.2.3"},
+	{"reintroduced", "example.com/lib", "v1.2.0", true, "v1.2.3"},
+	{"fixed again", "example.com/lib", "v1.2.3", false, ""},
+	{"other module", "example.com/other", "v0.1.0", false, ""},
+}
+
+func TestAffects(t *testing.T) {
+	db := loadTestDB(t)
+	if db.Len() != 1 {
+		t.Fatalf("expected 1 entry but got %v", db.Len())
+	}
+	for _, tc := range affectsTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vulns := db.Vulns(tc.mod, tc.ver)
+			if affected := len(vulns) > 0; affected != tc.affected {
+				t.Fatalf("expected affected to be %v but got %v", tc.affected, affected)
+			}
+			if !tc.affected {
+				return
+			}
+			if fixed := vulns[0].Fixed(tc.mod, tc.ver); fixed != tc.fixed {
+				t.Fatalf("expected fixed version %q but got %q", tc.fixed, fixed)
+			}
+		})
+	}
+}
+
+func TestSymbols(t *testing.T) {
+	e := loadTestDB(t).Vulns("example.com/lib", "v1.0.0")[0]
+	symbols, ok := e.Symbols("example.com/lib", "example.com/lib/parse")
+	if !ok || !reflect.DeepEqual(symbols, []string{"Parse", "Parser.Next"}) {
+		t.Fatalf("expected the symbols of the parse package but got %v, %v", symbols, ok)
+	}
+	if _, ok := e.Symbols("example.com/lib", "example.com/lib"); ok {
+		t.Fatal("expected the root package not to be affected")
+	}
+	if e.URL() != "https://pkg.go.dev/vuln/GO-2021-0001" {
+		t.Fatalf("expected the pkg.go.dev URL but got %v", e.URL())
+	}
+}
+
+// loadTestDB writes the test entry next
+// to an index file that Load must skip.
+func loadTestDB(t *testing.T) *DB {
+	dir, err := ioutil.TempDir("", "vuln")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	must := func(err error) {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(os.MkdirAll(filepath.Join(dir, "ID"), 0755))
+	must(os.MkdirAll(filepath.Join(dir, "index"), 0755))
+	must(ioutil.WriteFile(filepath.Join(dir, "ID", "GO-2021-0001.json"), []byte(entryJSON), 0644))
+	must(ioutil.WriteFile(filepath.Join(dir, "index", "modules.json"), []byte(`[{"path": "example.com/lib"}]`), 0644))
+	db, err := Load(dir)
+	must(err)
+	return db
+}